@@ -0,0 +1,159 @@
+package alerts
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type Alerts struct {
+	service alert.Service
+}
+
+type ruleRequest struct {
+	Name            string   `json:"name" binding:"required"`
+	SectionID       *int     `json:"section_id"`
+	Threshold       *float64 `json:"threshold"`
+	DurationSeconds int      `json:"duration_seconds"`
+}
+
+func NewAlerts(s alert.Service) Alerts {
+	return Alerts{s}
+}
+
+func (a *Alerts) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		filter := alert.Filter{Status: ctx.Query("status")}
+
+		if ctx.Query("section_id") != "" {
+			sectionID, err := strconv.Atoi(ctx.Query("section_id"))
+			if err != nil {
+				ctx.JSON(web.DecodeError(http.StatusBadRequest, "section_id must be a valid integer"))
+				return
+			}
+			filter.SectionID = sectionID
+		}
+
+		alerts, err := a.service.GetAll(ctx, filter)
+		if err != nil {
+			ctx.JSON(web.DecodeError(alertStatus(err, 0), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, alerts))
+	}
+}
+
+func (a *Alerts) GetByID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		found, err := a.service.GetByID(ctx, id)
+		if err != nil {
+			ctx.JSON(web.DecodeError(alertStatus(err, id), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, found))
+	}
+}
+
+func (a *Alerts) Acknowledge() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		acknowledged, err := a.service.Acknowledge(ctx, id)
+		if err != nil {
+			ctx.JSON(web.DecodeError(alertStatus(err, id), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, acknowledged))
+	}
+}
+
+func (a *Alerts) Resolve() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		resolved, err := a.service.Resolve(ctx, id)
+		if err != nil {
+			ctx.JSON(web.DecodeError(alertStatus(err, id), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, resolved))
+	}
+}
+
+func (a *Alerts) CreateRule() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req ruleRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		rule, err := a.service.CreateRule(ctx, alert.Rule{Name: req.Name, SectionID: req.SectionID,
+			Threshold: req.Threshold, DurationSeconds: req.DurationSeconds})
+		if err != nil {
+			sectionID := 0
+			if req.SectionID != nil {
+				sectionID = *req.SectionID
+			}
+			if err.Error() == fmt.Sprintf(alert.ERR_SECTION_NOT_FOUND, sectionID) {
+				ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+				return
+			}
+			ctx.JSON(web.DecodeError(alertStatus(err, 0), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusCreated, rule))
+	}
+}
+
+func (a *Alerts) GetRules() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		rules, err := a.service.Rules(ctx)
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, rules))
+	}
+}
+
+func (a *Alerts) DeleteRule() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		err := a.service.DeleteRule(ctx, id)
+		if err != nil {
+			ctx.JSON(web.DecodeError(alertStatus(err, id), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusNoContent, nil))
+	}
+}
+
+func alertStatus(err error, id int) int {
+	switch err.Error() {
+	case fmt.Sprintf(alert.ERR_ALERT_NOT_FOUND, id), fmt.Sprintf(alert.ERR_RULE_NOT_FOUND, id):
+		return http.StatusNotFound
+	case alert.ERR_INVALID_STATUS:
+		return http.StatusBadRequest
+	case alert.ERR_INVALID_DURATION:
+		return http.StatusUnprocessableEntity
+	case alert.ERR_NOT_OPEN, alert.ERR_ALREADY_RESOLVED:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,190 @@
+package alerts_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/alerts"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/stretchr/testify/mock"
+)
+
+const URL_ALERTS = "/api/v1/alerts"
+
+type ExpectedJSON struct {
+	Code int         `json:"code"`
+	Data interface{} `json:"data"`
+}
+
+type ExpectedErrorJSON struct {
+	Code  int    `json:"code"`
+	Error string `json:"error"`
+}
+
+func InitTest(t *testing.T) (*gin.Engine, *mocks.Repository) {
+	mockRepository := mocks.NewRepository(t)
+	handler := alerts.NewAlerts(alert.NewService(mockRepository, nil))
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	engine.GET(URL_ALERTS+"/", handler.GetAll())
+	engine.GET(URL_ALERTS+"/rules", handler.GetRules())
+	engine.POST(URL_ALERTS+"/rules", handler.CreateRule())
+	engine.DELETE(URL_ALERTS+"/rules/:id", handler.DeleteRule())
+	engine.GET(URL_ALERTS+"/:id", handler.GetByID())
+	engine.POST(URL_ALERTS+"/:id/acknowledge", handler.Acknowledge())
+	engine.POST(URL_ALERTS+"/:id/resolve", handler.Resolve())
+
+	return engine, mockRepository
+}
+
+func InitServer(method string, url string, body []byte) (*http.Request, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, url, bytes.NewBuffer(body))
+	req.Header.Add("Content-Type", "application/json")
+
+	return req, httptest.NewRecorder()
+}
+
+func TestGetAll(t *testing.T) {
+	engine, mockRepository := InitTest(t)
+
+	t.Run("get_all_ok", func(t *testing.T) {
+		exp := []alert.Alert{{ID: 5, SectionID: 3, Status: alert.STATUS_OPEN, ProductBatchIDs: []int{7}}}
+		mockRepository.On("GetAll", mock.Anything, alert.Filter{Status: alert.STATUS_OPEN, SectionID: 3}).Return(exp, nil).Once()
+
+		req, w := InitServer(http.MethodGet, URL_ALERTS+"/?status=open&section_id=3", nil)
+		engine.ServeHTTP(w, req)
+
+		expected := ExpectedJSON{200, exp}
+		expJSON, _ := json.Marshal(expected)
+
+		assert.Equal(t, expected.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("get_all_invalid_status", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, URL_ALERTS+"/?status=closed", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{400, alert.ERR_INVALID_STATUS}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("get_all_invalid_section", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, URL_ALERTS+"/?section_id=abc", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAcknowledgeResolve(t *testing.T) {
+	engine, mockRepository := InitTest(t)
+
+	t.Run("acknowledge_ok", func(t *testing.T) {
+		acknowledged := alert.Alert{ID: 5, Status: alert.STATUS_ACKNOWLEDGED, ProductBatchIDs: []int{}}
+		mockRepository.On("GetByID", mock.Anything, 5).Return(alert.Alert{ID: 5, Status: alert.STATUS_OPEN}, nil).Once()
+		mockRepository.On("Acknowledge", mock.Anything, 5, mock.Anything).Return(true, nil).Once()
+		mockRepository.On("GetByID", mock.Anything, 5).Return(acknowledged, nil).Once()
+
+		req, w := InitServer(http.MethodPost, URL_ALERTS+"/5/acknowledge", nil)
+		engine.ServeHTTP(w, req)
+
+		expected := ExpectedJSON{200, acknowledged}
+		expJSON, _ := json.Marshal(expected)
+
+		assert.Equal(t, expected.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("acknowledge_not_open", func(t *testing.T) {
+		mockRepository.On("GetByID", mock.Anything, 6).Return(alert.Alert{ID: 6, Status: alert.STATUS_RESOLVED}, nil).Once()
+		mockRepository.On("Acknowledge", mock.Anything, 6, mock.Anything).Return(false, nil).Once()
+
+		req, w := InitServer(http.MethodPost, URL_ALERTS+"/6/acknowledge", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{409, alert.ERR_NOT_OPEN}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("resolve_not_found", func(t *testing.T) {
+		mockRepository.On("GetByID", mock.Anything, 9).Return(alert.Alert{}, fmt.Errorf(alert.ERR_ALERT_NOT_FOUND, 9)).Once()
+
+		req, w := InitServer(http.MethodPost, URL_ALERTS+"/9/resolve", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{404, "alert with id (9) not found"}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+}
+
+func TestRules(t *testing.T) {
+	engine, mockRepository := InitTest(t)
+
+	t.Run("create_rule_ok", func(t *testing.T) {
+		threshold := -15.0
+		created := alert.Rule{ID: 1, Name: "freezer", Threshold: &threshold, DurationSeconds: 600, CreatedAt: "2026-10-19 10:00:00"}
+		mockRepository.On("CreateRule", mock.Anything, mock.MatchedBy(func(rule alert.Rule) bool {
+			return rule.Name == "freezer" && *rule.Threshold == -15 && rule.DurationSeconds == 600
+		})).Return(created, nil).Once()
+
+		req, w := InitServer(http.MethodPost, URL_ALERTS+"/rules", []byte(`{"name": "freezer", "threshold": -15, "duration_seconds": 600}`))
+		engine.ServeHTTP(w, req)
+
+		expected := ExpectedJSON{201, created}
+		expJSON, _ := json.Marshal(expected)
+
+		assert.Equal(t, expected.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("create_rule_section_not_found", func(t *testing.T) {
+		mockRepository.On("SectionExists", mock.Anything, 9).Return(false, nil).Once()
+
+		req, w := InitServer(http.MethodPost, URL_ALERTS+"/rules", []byte(`{"name": "freezer", "section_id": 9}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("create_rule_without_name", func(t *testing.T) {
+		req, w := InitServer(http.MethodPost, URL_ALERTS+"/rules", []byte(`{"threshold": -15}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("get_rules_ok", func(t *testing.T) {
+		mockRepository.On("Rules", mock.Anything).Return([]alert.Rule{}, nil).Once()
+
+		req, w := InitServer(http.MethodGet, URL_ALERTS+"/rules", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("delete_rule_not_found", func(t *testing.T) {
+		mockRepository.On("DeleteRule", mock.Anything, 9).Return(fmt.Errorf(alert.ERR_RULE_NOT_FOUND, 9)).Once()
+
+		req, w := InitServer(http.MethodDelete, URL_ALERTS+"/rules/9", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	ERROR_ID = "id need to be a valid integer"
+)
+
+type Audit struct {
+	service audit.Service
+}
+
+func NewAudit(s audit.Service) Audit {
+	return Audit{s}
+}
+
+// Search ListAuditLogs godoc
+// @Summary List audit logs
+// @Tags Audit
+// @Description list the changes made on the entities, filtered by entity, id and date range
+// @Accept json
+// @Produce json
+// @Param token header string true "token"
+// @Param entity query string false "Entity name"
+// @Param id query int false "Entity ID"
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date (YYYY-MM-DD)"
+// @Failure 400 {object} web.Response
+// @Success 200 {object} web.Response
+// @Router /api/v1/audit [GET]
+func (a Audit) Search(c *gin.Context) {
+	filter := audit.Filter{
+		Entity: c.Query("entity"),
+		From:   c.Query("from"),
+		To:     c.Query("to"),
+	}
+
+	if id := c.Query("id"); id != "" {
+		entityID, err := strconv.Atoi(id)
+		if err != nil || entityID < 1 {
+			c.JSON(web.DecodeError(http.StatusBadRequest, ERROR_ID))
+			return
+		}
+		filter.EntityID = entityID
+	}
+
+	logs, err := a.service.Search(c.Request.Context(), filter)
+	if err != nil {
+		if err.Error() == audit.ERROR_INVALID_DATE {
+			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+			return
+		}
+		c.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusOK, logs))
+}
@@ -0,0 +1,196 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const URL_AUDIT = "/api/v1/audit/"
+
+type entity struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func InitServer(method string, url string, body []byte) (*http.Request, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, url, bytes.NewBuffer(body))
+	req.Header.Add("Content-Type", "application/json")
+
+	return req, httptest.NewRecorder()
+}
+
+func TestSearch(t *testing.T) {
+	gin.SetMode("release")
+
+	t.Run("search_ok", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.GET(URL_AUDIT, handler.NewAudit(mockService).Search)
+
+		logs := []audit.Log{{ID: 1, Actor: "maria", Entity: "sections", EntityID: 2, Action: audit.ACTION_DELETE,
+			Before: json.RawMessage(`{"id":2}`), CreatedAt: "2022-08-01 10:00:00"}}
+		mockService.On("Search", mock.Anything, audit.Filter{Entity: "sections", EntityID: 2, From: "2022-08-01"}).
+			Return(logs, nil)
+
+		req, w := InitServer(http.MethodGet, URL_AUDIT+"?entity=sections&id=2&from=2022-08-01", nil)
+		router.ServeHTTP(w, req)
+
+		_, exp := web.NewResponse(http.StatusOK, logs)
+		expJSON, _ := json.Marshal(exp)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("search_invalid_id", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.GET(URL_AUDIT, handler.NewAudit(mockService).Search)
+
+		req, w := InitServer(http.MethodGet, URL_AUDIT+"?id=abc", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("search_invalid_date", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.GET(URL_AUDIT, handler.NewAudit(mockService).Search)
+
+		mockService.On("Search", mock.Anything, audit.Filter{To: "ontem"}).
+			Return([]audit.Log{}, errors.New(audit.ERROR_INVALID_DATE))
+
+		req, w := InitServer(http.MethodGet, URL_AUDIT+"?to=ontem", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("search_fail", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.GET(URL_AUDIT, handler.NewAudit(mockService).Search)
+
+		mockService.On("Search", mock.Anything, audit.Filter{}).Return([]audit.Log{}, errors.New("sql: database is closed"))
+
+		req, w := InitServer(http.MethodGet, URL_AUDIT, nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode("release")
+	current := entity{ID: 7, Name: "old"}
+	find := func(c *gin.Context, id int) (interface{}, error) {
+		if id != current.ID {
+			return nil, errors.New("not found")
+		}
+		return current, nil
+	}
+
+	t.Run("record_create", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.POST("/entities", handler.Middleware(mockService, "entities", find), func(c *gin.Context) {
+			c.JSON(web.NewResponse(http.StatusCreated, entity{ID: 8, Name: "new"}))
+		})
+
+		mockService.On("Record", mock.Anything, "maria", "entities", 8, audit.ACTION_CREATE, nil,
+			json.RawMessage(`{"id":8,"name":"new"}`)).Return(audit.Log{ID: 1}, nil)
+
+		req, w := InitServer(http.MethodPost, "/entities", []byte(`{"name":"new"}`))
+		req.Header.Set(handler.ACTOR_HEADER, "maria")
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("record_update", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.PATCH("/entities/:id", handler.Middleware(mockService, "entities", find), func(c *gin.Context) {
+			c.JSON(web.NewResponse(http.StatusOK, entity{ID: 7, Name: "new"}))
+		})
+
+		mockService.On("Record", mock.Anything, handler.ANONYMOUS_ACTOR, "entities", 7, audit.ACTION_UPDATE, current,
+			json.RawMessage(`{"id":7,"name":"new"}`)).Return(audit.Log{ID: 1}, nil)
+
+		req, w := InitServer(http.MethodPatch, "/entities/7", []byte(`{"name":"new"}`))
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"code":200,"data":{"id":7,"name":"new"}}`, w.Body.String())
+	})
+
+	t.Run("record_post_on_id_as_update", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.POST("/entities/:id/transitions", handler.Middleware(mockService, "entities", find), func(c *gin.Context) {
+			c.JSON(web.NewResponse(http.StatusOK, entity{ID: 7, Name: "moved"}))
+		})
+
+		mockService.On("Record", mock.Anything, handler.ANONYMOUS_ACTOR, "entities", 7, audit.ACTION_UPDATE, current,
+			json.RawMessage(`{"id":7,"name":"moved"}`)).Return(audit.Log{ID: 1}, nil)
+
+		req, w := InitServer(http.MethodPost, "/entities/7/transitions", []byte(`{"status":"moved"}`))
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("record_delete", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.DELETE("/entities/:id", handler.Middleware(mockService, "entities", find), func(c *gin.Context) {
+			c.JSON(web.NewResponse(http.StatusNoContent, ""))
+		})
+
+		mockService.On("Record", mock.Anything, handler.ANONYMOUS_ACTOR, "entities", 7, audit.ACTION_DELETE, current, nil).
+			Return(audit.Log{}, errors.New("sql: rows not affected"))
+
+		req, w := InitServer(http.MethodDelete, "/entities/7", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("skip_failed_request", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.DELETE("/entities/:id", handler.Middleware(mockService, "entities", find), func(c *gin.Context) {
+			c.JSON(web.DecodeError(http.StatusNotFound, "not found"))
+		})
+
+		req, w := InitServer(http.MethodDelete, "/entities/9", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("skip_read_request", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		router := gin.New()
+		router.GET("/entities/:id", handler.Middleware(mockService, "entities", find), func(c *gin.Context) {
+			c.JSON(web.NewResponse(http.StatusOK, current))
+		})
+
+		req, w := InitServer(http.MethodGet, "/entities/7", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
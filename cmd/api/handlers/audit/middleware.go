@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ACTOR_HEADER names the user that made the change. The API doesn't
+	// authenticate it, so it can only be trusted when the API is reached
+	// through a gateway that authenticates the user and sets the header,
+	// dropping any value sent by the client.
+	ACTOR_HEADER    = "X-User"
+	ANONYMOUS_ACTOR = "anonymous"
+)
+
+var actions = map[string]string{
+	http.MethodPost:   audit.ACTION_CREATE,
+	http.MethodPut:    audit.ACTION_UPDATE,
+	http.MethodPatch:  audit.ACTION_UPDATE,
+	http.MethodDelete: audit.ACTION_DELETE,
+}
+
+// Finder loads the current state of the entity with the given id, so the
+// middleware can record how it looked before being changed.
+type Finder func(c *gin.Context, id int) (interface{}, error)
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (r responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
+
+// Middleware records an audit log for every successful POST, PUT, PATCH and
+// DELETE request on the routes it is attached to. A POST on a route with an
+// id, like a status transition, updates the entity with that id. The state
+// after the change is taken from the `data` field of the response.
+func Middleware(s audit.Service, entity string, find Finder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		action, ok := actions[c.Request.Method]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		id, _ := strconv.Atoi(c.Param("id"))
+		if action == audit.ACTION_CREATE && id > 0 {
+			action = audit.ACTION_UPDATE
+		}
+
+		var before interface{}
+		if action != audit.ACTION_CREATE && find != nil && id > 0 {
+			if current, err := find(c, id); err == nil {
+				before = current
+			}
+		}
+
+		recorder := responseRecorder{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = recorder
+
+		c.Next()
+
+		if recorder.Status() >= http.StatusMultipleChoices {
+			return
+		}
+
+		var after interface{}
+		if action != audit.ACTION_DELETE {
+			var res struct {
+				Data json.RawMessage `json:"data"`
+			}
+			if err := json.Unmarshal(recorder.body.Bytes(), &res); err == nil && len(res.Data) > 0 {
+				after = res.Data
+				if id == 0 {
+					var created struct {
+						ID int `json:"id"`
+					}
+					json.Unmarshal(res.Data, &created)
+					id = created.ID
+				}
+			}
+		}
+
+		_, err := s.Record(c.Request.Context(), actor(c), entity, id, action, before, after)
+		if err != nil {
+			log.Printf("audit: could not record %s of %s %d: %v", action, entity, id, err)
+		}
+	}
+}
+
+func actor(c *gin.Context) string {
+	if user := c.GetHeader(ACTOR_HEADER); user != "" {
+		return user
+	}
+	return ANONYMOUS_ACTOR
+}
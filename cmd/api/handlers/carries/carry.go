@@ -1,14 +1,44 @@
 package carries
 
 import (
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/domain"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
+// DefaultRadiusKm is the radius of the near_locality search when radius_km is
+// not informed.
+const DefaultRadiusKm = 50.0
+
+type requestCarry struct {
+	Cid        string `json:"cid" binding:"required"`
+	Name       string `json:"company_name" binding:"required"`
+	Address    string `json:"address" binding:"required"`
+	Telephone  string `json:"telephone" binding:"required"`
+	LocalityID int    `json:"locality_id" binding:"required"`
+}
+
+type requestCoverage struct {
+	LocalityIDs []int `json:"locality_ids"`
+	ProvinceIDs []int `json:"province_ids"`
+}
+
+type requestRateCard struct {
+	BasePrice             *float64 `json:"base_price" binding:"required,gte=0"`
+	PricePerKg            *float64 `json:"price_per_kg" binding:"required,gte=0"`
+	PricePerKm            *float64 `json:"price_per_km" binding:"required,gte=0"`
+	RefrigeratedSurcharge *float64 `json:"refrigerated_surcharge" binding:"required,gte=0"`
+}
+
 type Carry struct {
 	service usecases.ServiceCarry
 }
@@ -35,3 +65,317 @@ func (c Carry) CreateCarry(ctx *gin.Context) {
 	ctx.JSON(web.NewResponse(http.StatusCreated, carry))
 
 }
+
+// GetAll lists every carry, the ones of the locality_id query, the ones that
+// deliver to the serves_locality query, or the ones within radius_km of the
+// near_locality query.
+func (c Carry) GetAll(ctx *gin.Context) {
+	if ctx.Query("serves_locality") != "" {
+		localityID, err := strconv.Atoi(ctx.Query("serves_locality"))
+
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusBadRequest, "o `serves_locality` deve ser um número"))
+			return
+		}
+
+		carries, err := c.service.GetServing(ctx.Request.Context(), localityID)
+
+		if err != nil {
+			if err.Error() == locality.ERR_LOCALITY_NOT_FOUND {
+				ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+				return
+			}
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, carries))
+		return
+	}
+
+	if ctx.Query("locality_id") != "" {
+		localityID, err := strconv.Atoi(ctx.Query("locality_id"))
+
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusBadRequest, "o `locality_id` deve ser um número"))
+			return
+		}
+
+		carries, err := c.service.GetByLocality(localityID)
+
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, carries))
+		return
+	}
+
+	if ctx.Query("near_locality") == "" {
+		carries, err := c.service.GetAll()
+
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, carries))
+		return
+	}
+
+	localityID, err := strconv.Atoi(ctx.Query("near_locality"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "o `near_locality` deve ser um número"))
+		return
+	}
+
+	radiusKm := DefaultRadiusKm
+
+	if ctx.Query("radius_km") != "" {
+		radiusKm, err = strconv.ParseFloat(ctx.Query("radius_km"), 64)
+
+		if err != nil || radiusKm <= 0 {
+			ctx.JSON(web.DecodeError(http.StatusBadRequest, "o `radius_km` deve ser um número maior que zero"))
+			return
+		}
+	}
+
+	near, err := c.service.GetNear(ctx.Request.Context(), localityID, radiusKm)
+
+	if err != nil {
+		switch err.Error() {
+		case locality.ERR_LOCALITY_NOT_FOUND:
+			ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		case locality.ERR_LOCALITY_NOT_LOCATED:
+			ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		default:
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, near))
+}
+
+func (c Carry) GetByID(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "o id passado não é um número"))
+		return
+	}
+
+	carry, err := c.service.GetByID(id)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, carry))
+}
+
+func (c Carry) GetByCid(ctx *gin.Context) {
+	carry, err := c.service.GetByCid(ctx.Param("cid"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, carry))
+}
+
+func (c Carry) UpdateCarry(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "o id passado não é um número"))
+		return
+	}
+
+	carry, err := c.service.GetByID(id)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	patch, err := io.ReadAll(ctx.Request.Body)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	var req requestCarry
+
+	if err := mergepatch.Apply(carry, patch, &req); err != nil {
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	carry, err = c.service.UpdateCarry(domain.Carry{
+		ID:         id,
+		Cid:        req.Cid,
+		Name:       req.Name,
+		Address:    req.Address,
+		Telephone:  req.Telephone,
+		LocalityID: req.LocalityID,
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case usecases.ERR_CARRY_NOT_FOUND:
+			ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		case usecases.ERR_CID_IN_USE:
+			ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+		default:
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, carry))
+}
+
+func (c Carry) DeleteCarry(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "o id passado não é um número"))
+		return
+	}
+
+	err = c.service.DeleteCarry(id)
+
+	if err != nil {
+		switch err.Error() {
+		case usecases.ERR_CARRY_IN_USE:
+			ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+		case usecases.ERR_CARRY_NOT_FOUND:
+			ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		default:
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusNoContent, nil))
+}
+
+func (c Carry) GetCoverage(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "o id passado não é um número"))
+		return
+	}
+
+	coverage, err := c.service.GetCoverage(id)
+
+	if err != nil {
+		c.decodeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, coverage))
+}
+
+// SetCoverage replaces the localities and provinces the carry delivers to.
+func (c Carry) SetCoverage(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "o id passado não é um número"))
+		return
+	}
+
+	var req requestCoverage
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	coverage, err := c.service.SetCoverage(ctx.Request.Context(), domain.Coverage{
+		CarryID:     id,
+		LocalityIDs: req.LocalityIDs,
+		ProvinceIDs: req.ProvinceIDs,
+	})
+
+	if err != nil {
+		c.decodeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, coverage))
+}
+
+func (c Carry) GetRateCard(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "o id passado não é um número"))
+		return
+	}
+
+	rateCard, err := c.service.GetRateCard(id)
+
+	if err != nil {
+		c.decodeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, rateCard))
+}
+
+func (c Carry) SaveRateCard(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "o id passado não é um número"))
+		return
+	}
+
+	var req requestRateCard
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	rateCard, err := c.service.SaveRateCard(domain.RateCard{
+		CarryID:               id,
+		BasePrice:             *req.BasePrice,
+		PricePerKg:            *req.PricePerKg,
+		PricePerKm:            *req.PricePerKm,
+		RefrigeratedSurcharge: *req.RefrigeratedSurcharge,
+	})
+
+	if err != nil {
+		c.decodeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, rateCard))
+}
+
+func (c Carry) decodeError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case usecases.ERR_CARRY_NOT_FOUND, usecases.ERR_RATE_CARD_NOT_FOUND, locality.ERR_LOCALITY_NOT_FOUND,
+		province.ERR_PROVINCE_NOT_FOUND:
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+	case usecases.ERR_INVALID_RATE_CARD:
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+	default:
+		ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+	}
+}
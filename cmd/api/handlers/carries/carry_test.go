@@ -14,6 +14,8 @@ import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/domain"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases/mock/mock_repository_carry"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases/mock/mock_service_carry"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -43,7 +45,7 @@ type carryResponseBody struct {
 func Test_CreateCarry(t *testing.T) {
 
 	repository := mock_repository_carry.NewRepositoryCarry(t)
-	service := usecases.NewServiceCarry(repository)
+	service := usecases.NewServiceCarry(repository, nil, nil)
 	controller := carries.NewCarry(service)
 	server := gin.Default()
 
@@ -120,3 +122,566 @@ func Test_CreateCarry(t *testing.T) {
 	})
 
 }
+
+func Test_GetAll(t *testing.T) {
+
+	service := mock_service_carry.NewServiceCarry(t)
+	controller := carries.NewCarry(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.GET(URLcarry, controller.GetAll)
+
+	t.Run("Deve retornar um status code 200 com todas as Carries.", func(t *testing.T) {
+
+		service.On("GetAll").Return([]domain.Carry{makeValidDBCarry()}, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry, nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "CID#5")
+	})
+
+	t.Run("Deve retornar um status code 500, se a consulta falhar.", func(t *testing.T) {
+
+		service.On("GetAll").Return(nil, errors.New("erro ao executar a query")).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry, nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 200 com as Carries próximas da locality.", func(t *testing.T) {
+
+		near := []domain.NearCarry{{Carry: makeValidDBCarry(), DistanceKm: 12.5}}
+
+		service.On("GetNear", mock.Anything, 1, 20.0).Return(near, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"?near_locality=1&radius_km=20", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "\"distance_km\":12.5")
+	})
+
+	t.Run("Deve usar o raio padrão quando o radius_km não for informado.", func(t *testing.T) {
+
+		service.On("GetNear", mock.Anything, 1, carries.DefaultRadiusKm).Return([]domain.NearCarry{}, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"?near_locality=1", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 400, se o near_locality ou o radius_km forem inválidos.", func(t *testing.T) {
+
+		for _, query := range []string{"?near_locality=abc", "?near_locality=1&radius_km=-5", "?near_locality=1&radius_km=x"} {
+			rr := httptest.NewRecorder()
+
+			req, _ := http.NewRequest(http.MethodGet, URLcarry+query, nil)
+
+			server.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		}
+	})
+
+	t.Run("Deve retornar um status code 404, se a locality não existir.", func(t *testing.T) {
+
+		service.On("GetNear", mock.Anything, 9, carries.DefaultRadiusKm).Return(nil, errors.New(locality.ERR_LOCALITY_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"?near_locality=9", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 422, se a locality não tiver coordenadas.", func(t *testing.T) {
+
+		service.On("GetNear", mock.Anything, 2, carries.DefaultRadiusKm).Return(nil, errors.New(locality.ERR_LOCALITY_NOT_LOCATED)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"?near_locality=2", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
+
+func Test_GetAllByLocality(t *testing.T) {
+
+	service := mock_service_carry.NewServiceCarry(t)
+	controller := carries.NewCarry(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.GET(URLcarry, controller.GetAll)
+
+	t.Run("Deve retornar um status code 200 com as Carries da locality.", func(t *testing.T) {
+
+		service.On("GetByLocality", 2).Return([]domain.Carry{makeValidDBCarry()}, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"?locality_id=2", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "CID#5")
+	})
+
+	t.Run("Deve retornar um status code 400, se o locality_id não for um número.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"?locality_id=abc", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func Test_GetByID(t *testing.T) {
+
+	service := mock_service_carry.NewServiceCarry(t)
+	controller := carries.NewCarry(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.GET(URLcarry+"/cid/:cid", controller.GetByCid)
+	server.GET(URLcarry+"/:id", controller.GetByID)
+
+	t.Run("Deve retornar um status code 200 com a Carry do id.", func(t *testing.T) {
+
+		service.On("GetByID", 1).Return(makeValidDBCarry(), nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"/1", nil)
+
+		server.ServeHTTP(rr, req)
+
+		respBody := carryResponseBody{}
+
+		json.Unmarshal(rr.Body.Bytes(), &respBody)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, makeValidDBCarry(), respBody.Data)
+	})
+
+	t.Run("Deve retornar um status code 400, se o id não for um número.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"/abc", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 404, se a Carry não existir.", func(t *testing.T) {
+
+		service.On("GetByID", 9).Return(domain.Carry{}, errors.New(usecases.ERR_CARRY_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"/9", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 200 com a Carry do cid.", func(t *testing.T) {
+
+		service.On("GetByCid", "CID5").Return(makeValidDBCarry(), nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"/cid/CID5", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 404, se o cid não existir.", func(t *testing.T) {
+
+		service.On("GetByCid", "CID9").Return(domain.Carry{}, errors.New(usecases.ERR_CARRY_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"/cid/CID9", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func Test_UpdateCarry(t *testing.T) {
+
+	service := mock_service_carry.NewServiceCarry(t)
+	controller := carries.NewCarry(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.PATCH(URLcarry+"/:id", controller.UpdateCarry)
+
+	t.Run("Deve retornar um status code 200, aplicando o merge patch na Carry.", func(t *testing.T) {
+
+		updated := makeValidDBCarry()
+		updated.Telephone = "88888888"
+
+		service.On("GetByID", 1).Return(makeValidDBCarry(), nil).Once()
+		service.On("UpdateCarry", updated).Return(updated, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodPatch, URLcarry+"/1", strings.NewReader(`{"telephone": "88888888"}`))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "88888888")
+	})
+
+	t.Run("Deve retornar um status code 404, se a Carry não existir.", func(t *testing.T) {
+
+		service.On("GetByID", 9).Return(domain.Carry{}, errors.New(usecases.ERR_CARRY_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodPatch, URLcarry+"/9", strings.NewReader(`{"telephone": "88888888"}`))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 422, se o patch remover um campo obrigatório.", func(t *testing.T) {
+
+		service.On("GetByID", 1).Return(makeValidDBCarry(), nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodPatch, URLcarry+"/1", strings.NewReader(`{"cid": null}`))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 409, se o cid já estiver em uso.", func(t *testing.T) {
+
+		updated := makeValidDBCarry()
+		updated.Cid = "CID#6"
+
+		service.On("GetByID", 1).Return(makeValidDBCarry(), nil).Once()
+		service.On("UpdateCarry", updated).Return(domain.Carry{}, errors.New(usecases.ERR_CID_IN_USE)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodPatch, URLcarry+"/1", strings.NewReader(`{"cid": "CID#6"}`))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func Test_DeleteCarry(t *testing.T) {
+
+	service := mock_service_carry.NewServiceCarry(t)
+	controller := carries.NewCarry(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.DELETE(URLcarry+"/:id", controller.DeleteCarry)
+
+	t.Run("Deve retornar um status code 204, se a Carry for removida.", func(t *testing.T) {
+
+		service.On("DeleteCarry", 1).Return(nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodDelete, URLcarry+"/1", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 409, se a Carry tiver shipments.", func(t *testing.T) {
+
+		service.On("DeleteCarry", 1).Return(errors.New(usecases.ERR_CARRY_IN_USE)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodDelete, URLcarry+"/1", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 404, se a Carry não existir.", func(t *testing.T) {
+
+		service.On("DeleteCarry", 9).Return(errors.New(usecases.ERR_CARRY_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodDelete, URLcarry+"/9", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 400, se o id não for um número.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodDelete, URLcarry+"/abc", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func Test_GetAllServing(t *testing.T) {
+
+	service := mock_service_carry.NewServiceCarry(t)
+	controller := carries.NewCarry(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.GET(URLcarry, controller.GetAll)
+
+	t.Run("Deve retornar um status code 200 com as Carries que atendem a locality.", func(t *testing.T) {
+
+		serving := []domain.CarryRate{{Carry: makeValidDBCarry(), RateCard: domain.RateCard{CarryID: 1, BasePrice: 10}}}
+
+		service.On("GetServing", mock.Anything, 2).Return(serving, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"?serves_locality=2", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"base_price":10`)
+	})
+
+	t.Run("Deve retornar um status code 404, se a locality não existir.", func(t *testing.T) {
+
+		service.On("GetServing", mock.Anything, 9).Return([]domain.CarryRate{}, errors.New(locality.ERR_LOCALITY_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"?serves_locality=9", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 400, se o serves_locality não for um número.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"?serves_locality=abc", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func Test_Coverage(t *testing.T) {
+
+	service := mock_service_carry.NewServiceCarry(t)
+	controller := carries.NewCarry(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.GET(URLcarry+"/:id/coverage", controller.GetCoverage)
+	server.PUT(URLcarry+"/:id/coverage", controller.SetCoverage)
+
+	coverage := domain.Coverage{CarryID: 1, LocalityIDs: []int{2}, ProvinceIDs: []int{5}}
+
+	t.Run("Deve retornar um status code 200 com a cobertura da Carry.", func(t *testing.T) {
+
+		service.On("GetCoverage", 1).Return(coverage, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"/1/coverage", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"province_ids":[5]`)
+	})
+
+	t.Run("Deve retornar um status code 404, se a Carry não existir.", func(t *testing.T) {
+
+		service.On("GetCoverage", 9).Return(domain.Coverage{}, errors.New(usecases.ERR_CARRY_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"/9/coverage", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 200, se a cobertura for salva.", func(t *testing.T) {
+
+		service.On("SetCoverage", mock.Anything, coverage).Return(coverage, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodPut, URLcarry+"/1/coverage", strings.NewReader(`{"locality_ids": [2], "province_ids": [5]}`))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 404, se uma locality da cobertura não existir.", func(t *testing.T) {
+
+		service.On("SetCoverage", mock.Anything, domain.Coverage{CarryID: 1, LocalityIDs: []int{99}}).
+			Return(domain.Coverage{}, errors.New(locality.ERR_LOCALITY_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodPut, URLcarry+"/1/coverage", strings.NewReader(`{"locality_ids": [99]}`))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 422, se o corpo for inválido.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodPut, URLcarry+"/1/coverage", strings.NewReader(`{"locality_ids": "2"}`))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
+
+func Test_RateCard(t *testing.T) {
+
+	service := mock_service_carry.NewServiceCarry(t)
+	controller := carries.NewCarry(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.GET(URLcarry+"/:id/rate-card", controller.GetRateCard)
+	server.PUT(URLcarry+"/:id/rate-card", controller.SaveRateCard)
+
+	rateCard := domain.RateCard{CarryID: 1, BasePrice: 10, PricePerKg: 1.5, PricePerKm: 0, RefrigeratedSurcharge: 25}
+
+	t.Run("Deve retornar um status code 200 com a tabela de preços.", func(t *testing.T) {
+
+		service.On("GetRateCard", 1).Return(rateCard, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"/1/rate-card", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"refrigerated_surcharge":25`)
+	})
+
+	t.Run("Deve retornar um status code 404, se a Carry não tiver tabela de preços.", func(t *testing.T) {
+
+		service.On("GetRateCard", 1).Return(domain.RateCard{}, errors.New(usecases.ERR_RATE_CARD_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLcarry+"/1/rate-card", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 200, se a tabela de preços for salva.", func(t *testing.T) {
+
+		service.On("SaveRateCard", rateCard).Return(rateCard, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		body := `{"base_price": 10, "price_per_kg": 1.5, "price_per_km": 0, "refrigerated_surcharge": 25}`
+		req, _ := http.NewRequest(http.MethodPut, URLcarry+"/1/rate-card", strings.NewReader(body))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 422, se faltar um valor.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodPut, URLcarry+"/1/rate-card", strings.NewReader(`{"base_price": 10}`))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 422, se um valor for negativo.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		body := `{"base_price": -1, "price_per_kg": 1.5, "price_per_km": 0, "refrigerated_surcharge": 25}`
+		req, _ := http.NewRequest(http.MethodPut, URLcarry+"/1/rate-card", strings.NewReader(body))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type requestCountry struct {
+	CountryName string `json:"country_name" binding:"required"`
+}
+
+type Country struct {
+	service country.Service
+}
+
+func NewCountry(s country.Service) *Country {
+	return &Country{service: s}
+}
+
+func (c *Country) GetAll(ctx *gin.Context) {
+	countryList, err := c.service.GetAll(ctx)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, countryList))
+}
+
+func (c *Country) GetById(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+		return
+	}
+
+	result, err := c.service.GetById(ctx, id)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, result))
+}
+
+func (c *Country) Create(ctx *gin.Context) {
+	var req requestCountry
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		if errField := validateCountryFields(req); errField != nil {
+			err = errField
+		}
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	newCountry, err := c.service.Create(ctx, req.CountryName)
+
+	if err != nil {
+		switch err.Error() {
+		case country.ERR_UNIQUE_COUNTRY_NAME:
+			ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+			return
+		default:
+			ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+			return
+		}
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusCreated, newCountry))
+}
+
+func (c *Country) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+		return
+	}
+
+	current, err := c.service.GetById(ctx, id)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	patch, err := io.ReadAll(ctx.Request.Body)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	var req requestCountry
+
+	if err := mergepatch.Apply(current, patch, &req); err != nil {
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		if errField := validateCountryFields(req); errField != nil {
+			err = errField
+		}
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	result, err := c.service.Update(ctx, id, req.CountryName)
+
+	if err != nil {
+		switch err.Error() {
+		case country.ERR_UNIQUE_COUNTRY_NAME:
+			ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+			return
+		default:
+			ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+			return
+		}
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, result))
+}
+
+func (c *Country) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+		return
+	}
+
+	err = c.service.Delete(ctx, id)
+
+	if err != nil {
+		switch err.Error() {
+		case country.ERR_COUNTRY_IN_USE:
+			ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+			return
+		default:
+			ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+			return
+		}
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusNoContent, nil))
+}
+
+func validateCountryFields(req requestCountry) error {
+	if req.CountryName == "" {
+		return errors.New("invalid input in field country_name")
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	URL_COUNTRY = "/api/v1/countries/"
+)
+
+func initCountryServer(t *testing.T) (*gin.Engine, *mocks.Service) {
+	mockService := mocks.NewService(t)
+	handlerCountry := NewCountry(mockService)
+
+	server := gin.Default()
+	countryGroup := server.Group(URL_COUNTRY)
+	countryGroup.GET("/", handlerCountry.GetAll)
+	countryGroup.GET("/:id", handlerCountry.GetById)
+	countryGroup.POST("/", handlerCountry.Create)
+	countryGroup.PATCH("/:id", handlerCountry.Update)
+	countryGroup.DELETE("/:id", handlerCountry.Delete)
+
+	return server, mockService
+}
+
+func TestCountry_GetAll(t *testing.T) {
+	t.Run("Deve retornar status 200", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("GetAll", mock.Anything).Return([]country.Country{{Id: 1, CountryName: "Brasil"}}, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_COUNTRY, "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve retornar status 500", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("GetAll", mock.Anything).Return([]country.Country{}, fmt.Errorf("error"))
+
+		req, rr := createRequestTest(http.MethodGet, URL_COUNTRY, "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 500, rr.Code)
+	})
+}
+
+func TestCountry_GetById(t *testing.T) {
+	t.Run("Deve retornar status 200", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("GetById", mock.Anything, 1).Return(country.Country{Id: 1, CountryName: "Brasil"}, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_COUNTRY+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve retornar status 400 quando o id for inválido", func(t *testing.T) {
+		server, _ := initCountryServer(t)
+
+		req, rr := createRequestTest(http.MethodGet, URL_COUNTRY+"abc", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 400, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("GetById", mock.Anything, 9).Return(country.Country{}, fmt.Errorf(country.ERR_COUNTRY_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodGet, URL_COUNTRY+"9", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+}
+
+func TestCountry_Create(t *testing.T) {
+	t.Run("Deve retornar status 201 quando sucesso", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("Create", mock.Anything, "Brasil").Return(country.Country{Id: 1, CountryName: "Brasil"}, nil)
+
+		dataJson, _ := json.Marshal(requestCountry{CountryName: "Brasil"})
+		req, rr := createRequestTest(http.MethodPost, URL_COUNTRY, string(dataJson))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 201, rr.Code)
+	})
+
+	t.Run("Deve retornar status 422 quando faltar o country_name", func(t *testing.T) {
+		server, _ := initCountryServer(t)
+
+		req, rr := createRequestTest(http.MethodPost, URL_COUNTRY, `{}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 409 quando o country_name já existir", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("Create", mock.Anything, "Brasil").Return(country.Country{}, fmt.Errorf(country.ERR_UNIQUE_COUNTRY_NAME))
+
+		dataJson, _ := json.Marshal(requestCountry{CountryName: "Brasil"})
+		req, rr := createRequestTest(http.MethodPost, URL_COUNTRY, string(dataJson))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+
+	t.Run("Deve retornar status 400 quando erro", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("Create", mock.Anything, "Brasil").Return(country.Country{}, fmt.Errorf("error"))
+
+		dataJson, _ := json.Marshal(requestCountry{CountryName: "Brasil"})
+		req, rr := createRequestTest(http.MethodPost, URL_COUNTRY, string(dataJson))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 400, rr.Code)
+	})
+}
+
+func TestCountry_Update(t *testing.T) {
+	t.Run("Deve retornar status 200 quando sucesso", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("GetById", mock.Anything, 1).Return(country.Country{Id: 1, CountryName: "Brasil"}, nil)
+		mockService.On("Update", mock.Anything, 1, "Brazil").Return(country.Country{Id: 1, CountryName: "Brazil"}, nil)
+
+		req, rr := createRequestTest(http.MethodPatch, URL_COUNTRY+"1", `{"country_name": "Brazil"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando o country não existir", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("GetById", mock.Anything, 9).Return(country.Country{}, fmt.Errorf(country.ERR_COUNTRY_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodPatch, URL_COUNTRY+"9", `{"country_name": "Brazil"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+
+	t.Run("Deve retornar status 422 quando remover o country_name", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("GetById", mock.Anything, 1).Return(country.Country{Id: 1, CountryName: "Brasil"}, nil)
+
+		req, rr := createRequestTest(http.MethodPatch, URL_COUNTRY+"1", `{"country_name": null}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 409 quando o country_name já existir", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("GetById", mock.Anything, 1).Return(country.Country{Id: 1, CountryName: "Brasil"}, nil)
+		mockService.On("Update", mock.Anything, 1, "Argentina").Return(country.Country{}, fmt.Errorf(country.ERR_UNIQUE_COUNTRY_NAME))
+
+		req, rr := createRequestTest(http.MethodPatch, URL_COUNTRY+"1", `{"country_name": "Argentina"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+}
+
+func TestCountry_Delete(t *testing.T) {
+	t.Run("Deve retornar status 204 quando sucesso", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("Delete", mock.Anything, 1).Return(nil)
+
+		req, rr := createRequestTest(http.MethodDelete, URL_COUNTRY+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 204, rr.Code)
+	})
+
+	t.Run("Deve retornar status 409 quando o country possuir provinces", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("Delete", mock.Anything, 1).Return(fmt.Errorf(country.ERR_COUNTRY_IN_USE))
+
+		req, rr := createRequestTest(http.MethodDelete, URL_COUNTRY+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando o country não existir", func(t *testing.T) {
+		server, mockService := initCountryServer(t)
+		mockService.On("Delete", mock.Anything, 9).Return(fmt.Errorf(country.ERR_COUNTRY_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodDelete, URL_COUNTRY+"9", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+}
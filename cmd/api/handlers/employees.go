@@ -2,11 +2,13 @@ package handlers
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/employee"
 	inboundorders "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/inbound_orders"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
 
 	"github.com/gin-gonic/gin"
@@ -114,9 +116,19 @@ func (e *Employee) Update() gin.HandlerFunc {
 			c.JSON(web.DecodeError(http.StatusBadRequest, ERROR_ID))
 			return
 		}
+		current, err := e.employeeService.GetById(id)
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+			return
+		}
+		patch, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+			return
+		}
 		var validate *validator.Validate = validator.New()
-		var req employee.Employee
-		if err := c.Bind(&req); err != nil {
+		var req employeeRequest
+		if err := mergepatch.Apply(current, patch, &req); err != nil {
 			c.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
 			return
 		}
@@ -124,7 +136,7 @@ func (e *Employee) Update() gin.HandlerFunc {
 		errValidate := validate.Struct(req)
 		if errValidate != nil {
 			if _, ok := errValidate.(*validator.InvalidValidationError); ok {
-				c.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+				c.JSON(web.DecodeError(http.StatusNotFound, errValidate.Error()))
 				return
 			}
 			for _, errValidate := range errValidate.(validator.ValidationErrors) {
@@ -135,12 +147,17 @@ func (e *Employee) Update() gin.HandlerFunc {
 				}
 			}
 		}
-		employee, err := e.employeeService.Update(req, id)
+		emp, err := e.employeeService.Update(employee.Employee{ID: req.ID, CardNumber: req.CardNumber,
+			FirstName: req.FirstName, LastName: req.LastName, WareHouseID: req.WareHouseID}, id)
 		if err != nil {
+			if err.Error() == fmt.Sprintf(employee.ERROR_UNIQUE_CARD_NUMBER, req.CardNumber) {
+				c.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+				return
+			}
 			c.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
 			return
 		}
-		c.JSON(web.NewResponse(http.StatusOK, employee))
+		c.JSON(web.NewResponse(http.StatusOK, emp))
 	}
 }
 
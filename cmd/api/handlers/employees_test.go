@@ -3,6 +3,7 @@ package handlers_test
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -168,3 +169,80 @@ func TestEmployeeDelete(t *testing.T) {
 		assert.Equal(t, resp.Error, "")
 	})
 }
+
+func TestEmployeeUpdate(t *testing.T) {
+	t.Run("update_ok", func(t *testing.T) {
+		mockEmpService := mockEmp.NewServices(t)
+		mockIOService := mockIo.NewServices(t)
+		handlerEmployee := handler.NewEmployee(mockEmpService, mockIOService)
+		server := gin.Default()
+		employeeRouterGroup := server.Group(URL_EMPLOYEES)
+		emps := createEmployeesArray()
+		expected := emps[0]
+		expected.CardNumber = 456
+		req, rr := createEmployeeRequestTest(http.MethodPatch, URL_EMPLOYEES+"1", `{"card_number_id": 456}`)
+
+		mockEmpService.On("GetById", 1).Return(emps[0], nil)
+		mockEmpService.On("Update", expected, 1).Return(expected, nil)
+		employeeRouterGroup.PATCH("/:id", handlerEmployee.Update())
+		server.ServeHTTP(rr, req)
+		resp := responseEmployee{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusOK, rr.Code, resp.Code)
+		assert.Equal(t, expected, resp.Data)
+		assert.Equal(t, resp.Error, "")
+	})
+	t.Run("update_non_existent", func(t *testing.T) {
+		mockEmpService := mockEmp.NewServices(t)
+		mockIOService := mockIo.NewServices(t)
+		handlerEmployee := handler.NewEmployee(mockEmpService, mockIOService)
+		server := gin.Default()
+		employeeRouterGroup := server.Group(URL_EMPLOYEES)
+		req, rr := createEmployeeRequestTest(http.MethodPatch, URL_EMPLOYEES+"9", `{"first_name": "Novo"}`)
+
+		mockEmpService.On("GetById", 9).Return(employee.Employee{}, fmt.Errorf("funcionario nao existe"))
+		employeeRouterGroup.PATCH("/:id", handlerEmployee.Update())
+		server.ServeHTTP(rr, req)
+		resp := responseEmployee{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusNotFound, rr.Code, resp.Code)
+		assert.Equal(t, resp.Error, "funcionario nao existe")
+	})
+	t.Run("update_remove_mandatory_field", func(t *testing.T) {
+		mockEmpService := mockEmp.NewServices(t)
+		mockIOService := mockIo.NewServices(t)
+		handlerEmployee := handler.NewEmployee(mockEmpService, mockIOService)
+		server := gin.Default()
+		employeeRouterGroup := server.Group(URL_EMPLOYEES)
+		req, rr := createEmployeeRequestTest(http.MethodPatch, URL_EMPLOYEES+"1", `{"last_name": null}`)
+
+		mockEmpService.On("GetById", 1).Return(createEmployeesArray()[0], nil)
+		employeeRouterGroup.PATCH("/:id", handlerEmployee.Update())
+		server.ServeHTTP(rr, req)
+		resp := responseEmployee{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, resp.Code)
+		assert.Equal(t, resp.Error, "LastName is mandatory")
+	})
+	t.Run("update_card_number_conflict", func(t *testing.T) {
+		mockEmpService := mockEmp.NewServices(t)
+		mockIOService := mockIo.NewServices(t)
+		handlerEmployee := handler.NewEmployee(mockEmpService, mockIOService)
+		server := gin.Default()
+		employeeRouterGroup := server.Group(URL_EMPLOYEES)
+		emps := createEmployeesArray()
+		expected := emps[0]
+		expected.CardNumber = 321
+		req, rr := createEmployeeRequestTest(http.MethodPatch, URL_EMPLOYEES+"1", `{"card_number_id": 321}`)
+
+		mockEmpService.On("GetById", 1).Return(emps[0], nil)
+		mockEmpService.On("Update", expected, 1).Return(employee.Employee{},
+			fmt.Errorf(employee.ERROR_UNIQUE_CARD_NUMBER, 321))
+		employeeRouterGroup.PATCH("/:id", handlerEmployee.Update())
+		server.ServeHTTP(rr, req)
+		resp := responseEmployee{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusConflict, rr.Code, resp.Code)
+		assert.Equal(t, resp.Error, "funcionario com cartão n: 321 ja existe no banco de dados")
+	})
+}
@@ -2,11 +2,18 @@ package handlers
 
 import (
 	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
 	"github.com/gin-gonic/gin"
-	"net/http"
-	"strconv"
+	"github.com/gin-gonic/gin/binding"
 )
 
 const (
@@ -14,10 +21,37 @@ const (
 )
 
 type requestLocality struct {
-	ZipCode      string `json:"zip_code" binding:"required"`
-	LocalityName string `json:"locality_name" binding:"required"`
-	ProvinceName string `json:"province_name" binding:"required"`
-	CountryName  string `json:"country_name" binding:"required"`
+	ZipCode      string   `json:"zip_code" binding:"required"`
+	LocalityName string   `json:"locality_name" binding:"required"`
+	ProvinceID   int      `json:"province_id" binding:"required"`
+	CountryID    int      `json:"country_id"`
+	Latitude     *float64 `json:"latitude"`
+	Longitude    *float64 `json:"longitude"`
+}
+
+// requestLocalityCreate leaves locality_name and province_id optional, they
+// are filled from the zip code dataset when omitted.
+type requestLocalityCreate struct {
+	ZipCode      string   `json:"zip_code" binding:"required"`
+	LocalityName string   `json:"locality_name"`
+	ProvinceID   int      `json:"province_id"`
+	CountryID    int      `json:"country_id"`
+	Latitude     *float64 `json:"latitude"`
+	Longitude    *float64 `json:"longitude"`
+}
+
+// sellersReport and carriesReport keep the responses of /reportSellers and
+// /reportCarries as they were before the unified report.
+type sellersReport struct {
+	LocalityID   int    `json:"locality_id"`
+	LocalityName string `json:"locality_name"`
+	SellersCount int    `json:"sellers_count"`
+}
+
+type carriesReport struct {
+	LocalityID   int    `json:"locality_id"`
+	LocalityName string `json:"locality_name"`
+	CarriesCount int    `json:"carries_count"`
 }
 
 type Locality struct {
@@ -28,6 +62,48 @@ func NewLocality(s locality.Service) *Locality {
 	return &Locality{service: s}
 }
 
+// Report answers with the sellers, carriers, warehouses and buyers of the
+// localities in the id query (comma separated), or of every locality. Both
+// answers are lists.
+func (l *Locality) Report(ctx *gin.Context) {
+	ids, ok := ctx.GetQuery("id")
+
+	if !ok || ids == "" {
+		reportList, err := l.service.ReportAll(ctx)
+
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, reportList))
+		return
+	}
+
+	reportList := []locality.Report{}
+
+	for _, stringID := range strings.Split(ids, ",") {
+		id, err := strconv.Atoi(stringID)
+
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+			return
+		}
+
+		report, err := l.service.Report(ctx, id)
+
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+			return
+		}
+
+		reportList = append(reportList, report)
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, reportList))
+}
+
+// ReportSellers answers with the sellers of the locality in the id query.
 func (l *Locality) ReportSellers(ctx *gin.Context) {
 	id, ok := ctx.GetQuery("id")
 
@@ -43,35 +119,78 @@ func (l *Locality) ReportSellers(ctx *gin.Context) {
 		return
 	}
 
-	reportSeller, err := l.service.ReportSellers(ctx, idConvertido)
+	report, err := l.service.Report(ctx, idConvertido)
 
 	if err != nil {
 		ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
 		return
 	}
 
-	ctx.JSON(web.NewResponse(http.StatusOK, reportSeller))
+	ctx.JSON(web.NewResponse(http.StatusOK, sellersReport{report.LocalityID, report.LocalityName, report.SellersCount}))
 }
 
-func (l *Locality) Create(ctx *gin.Context) {
-	var req requestLocality
+// ReportCarries answers with the carriers of the localities in the id query
+// (comma separated), or of every locality with carriers.
+func (l *Locality) ReportCarries(ctx *gin.Context) {
+	ids := ctx.Query("id")
 
-	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, validateLocalityFields(req).Error()))
+	results := []carriesReport{}
+
+	if ids == "" {
+		reportList, err := l.service.ReportAll(ctx)
+
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, "erro ao acessar o banco de dados"))
+			return
+		}
+
+		for _, report := range reportList {
+			if report.CarriersCount > 0 {
+				results = append(results, carriesReport{report.LocalityID, report.LocalityName, report.CarriersCount})
+			}
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, results))
 		return
 	}
 
-	newLocality, err := l.service.Create(ctx, req.ZipCode, req.LocalityName, req.ProvinceName, req.CountryName)
+	for _, stringID := range strings.Split(ids, ",") {
+		id, err := strconv.Atoi(stringID)
 
-	if err != nil {
-		switch err.Error() {
-		case ERR_UNIQUE_ZIPCODE_VALUE:
-			ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusBadRequest, "id fornecido é inválido!"))
 			return
-		default:
-			ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+		}
+
+		report, err := l.service.Report(ctx, id)
+
+		if err != nil || report.CarriersCount == 0 {
+			ctx.JSON(web.DecodeError(http.StatusNotFound, "a localidade não foi encontrada!"))
 			return
 		}
+
+		results = append(results, carriesReport{report.LocalityID, report.LocalityName, report.CarriersCount})
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, results))
+}
+
+func (l *Locality) Create(ctx *gin.Context) {
+	var req requestLocalityCreate
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		if req.ZipCode == "" {
+			err = errors.New("invalid input in field zip_code")
+		}
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	newLocality, err := l.service.Create(ctx, req.ZipCode, req.LocalityName, req.ProvinceID, req.CountryID, req.Latitude, req.Longitude)
+
+	if err != nil {
+		ctx.JSON(localityErrorStatus(err))
+		return
 	}
 
 	ctx.JSON(web.NewResponse(http.StatusCreated, newLocality))
@@ -89,6 +208,131 @@ func (l *Locality) GetAll(ctx *gin.Context) {
 	ctx.JSON(web.NewResponse(http.StatusOK, localityList))
 }
 
+// Lookup resolves the registered locality of a zip code.
+func (l *Locality) Lookup(ctx *gin.Context) {
+	zipCode := ctx.Query("zip")
+
+	if zipCode == "" {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "zip is mandatory"))
+		return
+	}
+
+	result, err := l.service.Lookup(ctx, zipCode)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, result))
+}
+
+func (l *Locality) GetById(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+		return
+	}
+
+	result, err := l.service.GetById(ctx, id)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, result))
+}
+
+func (l *Locality) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+		return
+	}
+
+	current, err := l.service.GetById(ctx, id)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	patch, err := io.ReadAll(ctx.Request.Body)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	// country_id is only checked against the province when the patch sends it.
+	original := requestLocality{ZipCode: current.ZipCode, LocalityName: current.LocalityName, ProvinceID: current.ProvinceID,
+		Latitude: current.Latitude, Longitude: current.Longitude}
+
+	var req requestLocality
+
+	if err := mergepatch.Apply(original, patch, &req); err != nil {
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		if errField := validateLocalityFields(req); errField != nil {
+			err = errField
+		}
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	result, err := l.service.Update(ctx, id, req.ZipCode, req.LocalityName, req.ProvinceID, req.CountryID, req.Latitude, req.Longitude)
+
+	if err != nil {
+		ctx.JSON(localityErrorStatus(err))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, result))
+}
+
+func (l *Locality) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+		return
+	}
+
+	err = l.service.Delete(ctx, id)
+
+	if err != nil {
+		switch err.Error() {
+		case locality.ERR_LOCALITY_IN_USE:
+			ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+			return
+		default:
+			ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+			return
+		}
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusNoContent, nil))
+}
+
+func localityErrorStatus(err error) (int, web.Response) {
+	switch err.Error() {
+	case ERR_UNIQUE_ZIPCODE_VALUE, locality.ERR_PROVINCE_NOT_IN_COUNTRY:
+		return web.DecodeError(http.StatusConflict, err.Error())
+	case province.ERR_PROVINCE_NOT_FOUND, zipcode.ERR_ZIP_CODE_NOT_FOUND:
+		return web.DecodeError(http.StatusNotFound, err.Error())
+	case locality.ERR_PROVINCE_NOT_RESOLVED, zipcode.ERR_INVALID_ZIP_CODE, locality.ERR_INVALID_COORDINATES:
+		return web.DecodeError(http.StatusUnprocessableEntity, err.Error())
+	default:
+		return web.DecodeError(http.StatusBadRequest, err.Error())
+	}
+}
+
 func validateLocalityFields(req requestLocality) error {
 
 	if req.ZipCode == "" {
@@ -97,12 +341,8 @@ func validateLocalityFields(req requestLocality) error {
 	if req.LocalityName == "" {
 		return errors.New("invalid input in field locality_name")
 	}
-	if req.ProvinceName == "" {
-		return errors.New("invalid input in field province_name")
-	}
-
-	if req.CountryName == "" {
-		return errors.New("invalid input in field country_name")
+	if req.ProvinceID == 0 {
+		return errors.New("invalid input in field province_id")
 	}
 	return nil
 }
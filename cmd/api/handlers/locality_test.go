@@ -8,6 +8,8 @@ import (
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,66 +19,296 @@ const (
 	URL_LOCALITY = "/api/v1/localities/"
 )
 
-func TestLocality_ReportSellers(t *testing.T) {
-	t.Run("Deve retornar status 200", func(t *testing.T) {
-		mockService := mocks.NewService(t)
-		handlerLocality := NewLocality(mockService)
+var noCoordinate *float64
 
-		reportSeller := locality.ReportSeller{LocalityID: 1, LocalityName: "Gru", SellersCount: 3}
-		dataJson, _ := json.Marshal(reportSeller)
+func initLocalityServer(t *testing.T) (*gin.Engine, *mocks.Service) {
+	mockService := mocks.NewService(t)
+	handlerLocality := NewLocality(mockService)
 
-		mockService.On("ReportSellers", mock.Anything, 1).Return(reportSeller, nil)
+	server := gin.Default()
+	serverLocalityGroup := server.Group(URL_LOCALITY)
+	serverLocalityGroup.GET("/report", handlerLocality.Report)
+	serverLocalityGroup.GET("/reportSellers", handlerLocality.ReportSellers)
+	serverLocalityGroup.GET("/reportCarries", handlerLocality.ReportCarries)
+	serverLocalityGroup.GET("/lookup", handlerLocality.Lookup)
+	serverLocalityGroup.GET("/:id", handlerLocality.GetById)
+	serverLocalityGroup.PATCH("/:id", handlerLocality.Update)
+	serverLocalityGroup.DELETE("/:id", handlerLocality.Delete)
 
-		server := gin.Default()
-		serverLocalityGroup := server.Group(URL_LOCALITY)
-		serverLocalityGroup.GET("/reportSellers", handlerLocality.ReportSellers)
+	return server, mockService
+}
+
+func TestLocality_Report(t *testing.T) {
+	t.Run("Deve retornar status 200 com o report de uma locality", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		report := locality.Report{LocalityID: 1, LocalityName: "Gru", SellersCount: 3, CarriersCount: 1}
+		mockService.On("Report", mock.Anything, 1).Return(report, nil)
 
-		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"reportSellers?id=1", string(dataJson))
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"report?id=1", "")
 		server.ServeHTTP(rr, req)
 
+		var body struct{ Data []locality.Report }
+		json.Unmarshal(rr.Body.Bytes(), &body)
+
 		assert.Equal(t, 200, rr.Code)
+		assert.Equal(t, []locality.Report{report}, body.Data)
 	})
 
-	t.Run("Deve retornar status 400", func(t *testing.T) {
-		mockService := mocks.NewService(t)
-		handlerLocality := NewLocality(mockService)
+	t.Run("Deve retornar status 200 com o report de varias localities", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
 
-		mockService.On("ReportSellers", mock.Anything, 1).Return(locality.ReportSeller{}, fmt.Errorf("error"))
+		mockService.On("Report", mock.Anything, 1).Return(locality.Report{LocalityID: 1}, nil)
+		mockService.On("Report", mock.Anything, 2).Return(locality.Report{LocalityID: 2}, nil)
 
-		server := gin.Default()
-		serverLocalityGroup := server.Group(URL_LOCALITY)
-		serverLocalityGroup.GET("/reportSellers", handlerLocality.ReportSellers)
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"report?id=1,2", "")
+		server.ServeHTTP(rr, req)
+
+		var body struct{ Data []locality.Report }
+		json.Unmarshal(rr.Body.Bytes(), &body)
+
+		assert.Equal(t, 200, rr.Code)
+		assert.Len(t, body.Data, 2)
+	})
+
+	t.Run("Deve retornar status 200 com o report de todas as localities", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("ReportAll", mock.Anything).Return([]locality.Report{{LocalityID: 1}, {LocalityID: 2}}, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"report", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve retornar status 500 quando erro no report de todas as localities", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("ReportAll", mock.Anything).Return([]locality.Report{}, fmt.Errorf("error"))
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"report", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 500, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando a locality não existir", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("Report", mock.Anything, 1).Return(locality.Report{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"report?id=1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+
+	t.Run("Deve retornar status 400 quando erro do conversao id", func(t *testing.T) {
+		server, _ := initLocalityServer(t)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"report?id=a", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 400, rr.Code)
+	})
+}
+
+func TestLocality_ReportSellers(t *testing.T) {
+	t.Run("Deve retornar status 200 com os sellers de uma locality", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("Report", mock.Anything, 1).Return(locality.Report{LocalityID: 1, LocalityName: "Gru",
+			SellersCount: 3, CarriersCount: 1}, nil)
 
 		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"reportSellers?id=1", "")
 		server.ServeHTTP(rr, req)
 
+		assert.Equal(t, 200, rr.Code)
+		assert.JSONEq(t, `{"code":200,"data":{"locality_id":1,"locality_name":"Gru","sellers_count":3}}`, rr.Body.String())
+	})
+
+	t.Run("Deve retornar status 400 sem o id", func(t *testing.T) {
+		server, _ := initLocalityServer(t)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"reportSellers", "")
+		server.ServeHTTP(rr, req)
+
 		assert.Equal(t, 400, rr.Code)
 	})
+}
 
-	t.Run("Deve retornar status 400 quando erro do parametro da url", func(t *testing.T) {
-		mockService := mocks.NewService(t)
-		handlerLocality := NewLocality(mockService)
+func TestLocality_ReportCarries(t *testing.T) {
+	t.Run("Deve retornar status 200 com os carriers das localities", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
 
-		server := gin.Default()
-		serverLocalityGroup := server.Group(URL_LOCALITY)
-		serverLocalityGroup.GET("/reportSellers", handlerLocality.ReportSellers)
-		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"reportSellers?err=1", "")
+		mockService.On("Report", mock.Anything, 1).Return(locality.Report{LocalityID: 1, LocalityName: "Gru",
+			SellersCount: 3, CarriersCount: 2}, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"reportCarries?id=1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+		assert.JSONEq(t, `{"code":200,"data":[{"locality_id":1,"locality_name":"Gru","carries_count":2}]}`, rr.Body.String())
+	})
+
+	t.Run("Deve retornar somente as localities com carriers", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("ReportAll", mock.Anything).Return([]locality.Report{
+			{LocalityID: 1, LocalityName: "Gru", CarriersCount: 2}, {LocalityID: 2, LocalityName: "Osasco"},
+		}, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"reportCarries", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+		assert.JSONEq(t, `{"code":200,"data":[{"locality_id":1,"locality_name":"Gru","carries_count":2}]}`, rr.Body.String())
+	})
+
+	t.Run("Deve retornar status 404 quando a locality não tiver carriers", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("Report", mock.Anything, 2).Return(locality.Report{LocalityID: 2, LocalityName: "Osasco"}, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"reportCarries?id=2", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+}
+
+func TestLocality_GetById(t *testing.T) {
+	t.Run("Deve retornar status 200", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("GetById", mock.Anything, 1).
+			Return(locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1}, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve retornar status 400 quando o id for inválido", func(t *testing.T) {
+		server, _ := initLocalityServer(t)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"a", "")
 		server.ServeHTTP(rr, req)
 
 		assert.Equal(t, 400, rr.Code)
 	})
 
-	t.Run("Deve retornar status 500 quando erro do conversao id", func(t *testing.T) {
-		mockService := mocks.NewService(t)
-		handlerLocality := NewLocality(mockService)
+	t.Run("Deve retornar status 404", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
 
-		server := gin.Default()
-		serverLocalityGroup := server.Group(URL_LOCALITY)
-		serverLocalityGroup.GET("/reportSellers", handlerLocality.ReportSellers)
-		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"reportSellers?id=a", "")
+		mockService.On("GetById", mock.Anything, 9).Return(locality.Locality{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"9", "")
 		server.ServeHTTP(rr, req)
 
-		assert.Equal(t, 500, rr.Code)
+		assert.Equal(t, 404, rr.Code)
+	})
+}
+
+func TestLocality_Update(t *testing.T) {
+	current := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1, ProvinceName: "SP", CountryID: 1, CountryName: "BRA"}
+
+	t.Run("Deve retornar status 200 quando sucesso", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("GetById", mock.Anything, 1).Return(current, nil)
+		mockService.On("Update", mock.Anything, 1, "6700", "Guarulhos", 1, 0, noCoordinate, noCoordinate).
+			Return(locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Guarulhos", ProvinceID: 1}, nil)
+
+		req, rr := createRequestTest(http.MethodPatch, URL_LOCALITY+"1", `{"locality_name": "Guarulhos"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve enviar o country_id quando ele estiver no patch", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("GetById", mock.Anything, 1).Return(current, nil)
+		mockService.On("Update", mock.Anything, 1, "6700", "Gru", 2, 2, noCoordinate, noCoordinate).
+			Return(locality.Locality{}, fmt.Errorf(locality.ERR_PROVINCE_NOT_IN_COUNTRY))
+
+		req, rr := createRequestTest(http.MethodPatch, URL_LOCALITY+"1", `{"province_id": 2, "country_id": 2}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando a locality não existir", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("GetById", mock.Anything, 9).Return(locality.Locality{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodPatch, URL_LOCALITY+"9", `{"locality_name": "Guarulhos"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+
+	t.Run("Deve retornar status 422 quando remover o zip_code", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("GetById", mock.Anything, 1).Return(current, nil)
+
+		req, rr := createRequestTest(http.MethodPatch, URL_LOCALITY+"1", `{"zip_code": null}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 409 quando o zipcode já existir", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("GetById", mock.Anything, 1).Return(current, nil)
+		mockService.On("Update", mock.Anything, 1, "9999", "Gru", 1, 0, noCoordinate, noCoordinate).
+			Return(locality.Locality{}, fmt.Errorf(ERR_UNIQUE_ZIPCODE_VALUE))
+
+		req, rr := createRequestTest(http.MethodPatch, URL_LOCALITY+"1", `{"zip_code": "9999"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+}
+
+func TestLocality_Delete(t *testing.T) {
+	t.Run("Deve retornar status 204 quando sucesso", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("Delete", mock.Anything, 1).Return(nil)
+
+		req, rr := createRequestTest(http.MethodDelete, URL_LOCALITY+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 204, rr.Code)
+	})
+
+	t.Run("Deve retornar status 409 quando a locality estiver em uso", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("Delete", mock.Anything, 1).Return(fmt.Errorf(locality.ERR_LOCALITY_IN_USE))
+
+		req, rr := createRequestTest(http.MethodDelete, URL_LOCALITY+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando a locality não existir", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("Delete", mock.Anything, 9).Return(fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodDelete, URL_LOCALITY+"9", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
 	})
 }
 
@@ -85,10 +317,10 @@ func TestLocality_Create(t *testing.T) {
 		mockService := mocks.NewService(t)
 		handlerLocality := NewLocality(mockService)
 
-		inputLocality := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
+		inputLocality := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1, ProvinceName: "SP", CountryID: 1, CountryName: "BRA"}
 		dataJson, _ := json.Marshal(inputLocality)
 
-		mockService.On("Create", mock.Anything, inputLocality.ZipCode, inputLocality.LocalityName, inputLocality.ProvinceName, inputLocality.CountryName).
+		mockService.On("Create", mock.Anything, inputLocality.ZipCode, inputLocality.LocalityName, inputLocality.ProvinceID, inputLocality.CountryID, noCoordinate, noCoordinate).
 			Return(locality.Locality{}, fmt.Errorf("zip_code already exists"))
 
 		server := gin.Default()
@@ -105,10 +337,10 @@ func TestLocality_Create(t *testing.T) {
 		mockService := mocks.NewService(t)
 		handlerLocality := NewLocality(mockService)
 
-		inputLocality := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
+		inputLocality := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1, ProvinceName: "SP", CountryID: 1, CountryName: "BRA"}
 		dataJson, _ := json.Marshal(inputLocality)
 
-		mockService.On("Create", mock.Anything, inputLocality.ZipCode, inputLocality.LocalityName, inputLocality.ProvinceName, inputLocality.CountryName).
+		mockService.On("Create", mock.Anything, inputLocality.ZipCode, inputLocality.LocalityName, inputLocality.ProvinceID, inputLocality.CountryID, noCoordinate, noCoordinate).
 			Return(locality.Locality{}, fmt.Errorf("error"))
 
 		server := gin.Default()
@@ -125,7 +357,7 @@ func TestLocality_Create(t *testing.T) {
 		mockService := mocks.NewService(t)
 		handlerLocality := NewLocality(mockService)
 
-		inputLocality := locality.Locality{Id: 1, LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
+		inputLocality := locality.Locality{Id: 1, LocalityName: "Gru", ProvinceID: 1}
 		dataJson, _ := json.Marshal(inputLocality)
 
 		server := gin.Default()
@@ -138,14 +370,54 @@ func TestLocality_Create(t *testing.T) {
 		assert.Equal(t, 422, rr.Code)
 	})
 
+	t.Run("Deve retornar status 404 quando a province não existir", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerLocality := NewLocality(mockService)
+
+		inputLocality := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 9}
+		dataJson, _ := json.Marshal(inputLocality)
+
+		mockService.On("Create", mock.Anything, inputLocality.ZipCode, inputLocality.LocalityName, inputLocality.ProvinceID, inputLocality.CountryID, noCoordinate, noCoordinate).
+			Return(locality.Locality{}, fmt.Errorf(province.ERR_PROVINCE_NOT_FOUND))
+
+		server := gin.Default()
+		serverLocalityGroup := server.Group(URL_LOCALITY)
+		serverLocalityGroup.POST("/", handlerLocality.Create)
+
+		req, rr := createRequestTest(http.MethodPost, URL_LOCALITY, string(dataJson))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+
+	t.Run("Deve retornar status 409 quando a province não pertencer ao country", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerLocality := NewLocality(mockService)
+
+		inputLocality := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1, CountryID: 2}
+		dataJson, _ := json.Marshal(inputLocality)
+
+		mockService.On("Create", mock.Anything, inputLocality.ZipCode, inputLocality.LocalityName, inputLocality.ProvinceID, inputLocality.CountryID, noCoordinate, noCoordinate).
+			Return(locality.Locality{}, fmt.Errorf(locality.ERR_PROVINCE_NOT_IN_COUNTRY))
+
+		server := gin.Default()
+		serverLocalityGroup := server.Group(URL_LOCALITY)
+		serverLocalityGroup.POST("/", handlerLocality.Create)
+
+		req, rr := createRequestTest(http.MethodPost, URL_LOCALITY, string(dataJson))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+
 	t.Run("Deve retornar status 201 quando sucesso", func(t *testing.T) {
 		mockService := mocks.NewService(t)
 		handlerLocality := NewLocality(mockService)
 
-		inputLocality := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
+		inputLocality := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1, ProvinceName: "SP", CountryID: 1, CountryName: "BRA"}
 		dataJson, _ := json.Marshal(inputLocality)
 
-		mockService.On("Create", mock.Anything, inputLocality.ZipCode, inputLocality.LocalityName, inputLocality.ProvinceName, inputLocality.CountryName).
+		mockService.On("Create", mock.Anything, inputLocality.ZipCode, inputLocality.LocalityName, inputLocality.ProvinceID, inputLocality.CountryID, noCoordinate, noCoordinate).
 			Return(inputLocality, nil)
 
 		server := gin.Default()
@@ -159,10 +431,132 @@ func TestLocality_Create(t *testing.T) {
 	})
 }
 
+func TestLocality_CreateFromZipCode(t *testing.T) {
+	t.Run("Deve retornar status 201 quando somente o zip_code for enviado", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerLocality := NewLocality(mockService)
+
+		expected := locality.Locality{Id: 1, ZipCode: "07010000", LocalityName: "Guarulhos", ProvinceID: 1, ProvinceName: "São Paulo", CountryID: 1, CountryName: "Brasil"}
+
+		mockService.On("Create", mock.Anything, "07010-000", "", 0, 0, noCoordinate, noCoordinate).Return(expected, nil)
+
+		server := gin.Default()
+		serverLocalityGroup := server.Group(URL_LOCALITY)
+		serverLocalityGroup.POST("/", handlerLocality.Create)
+
+		req, rr := createRequestTest(http.MethodPost, URL_LOCALITY, `{"zip_code": "07010-000"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 201, rr.Code)
+	})
+
+	t.Run("Deve retornar status 422 quando o zip_code estiver fora do formato", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerLocality := NewLocality(mockService)
+
+		mockService.On("Create", mock.Anything, "6700", "Gru", 1, 0, noCoordinate, noCoordinate).Return(locality.Locality{}, fmt.Errorf(zipcode.ERR_INVALID_ZIP_CODE))
+
+		server := gin.Default()
+		serverLocalityGroup := server.Group(URL_LOCALITY)
+		serverLocalityGroup.POST("/", handlerLocality.Create)
+
+		req, rr := createRequestTest(http.MethodPost, URL_LOCALITY, `{"zip_code": "6700", "locality_name": "Gru", "province_id": 1}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 422 quando a province do zip_code não estiver cadastrada", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerLocality := NewLocality(mockService)
+
+		mockService.On("Create", mock.Anything, "07010000", "", 0, 0, noCoordinate, noCoordinate).Return(locality.Locality{}, fmt.Errorf(locality.ERR_PROVINCE_NOT_RESOLVED))
+
+		server := gin.Default()
+		serverLocalityGroup := server.Group(URL_LOCALITY)
+		serverLocalityGroup.POST("/", handlerLocality.Create)
+
+		req, rr := createRequestTest(http.MethodPost, URL_LOCALITY, `{"zip_code": "07010000"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando o zip_code não estiver no dataset", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerLocality := NewLocality(mockService)
+
+		mockService.On("Create", mock.Anything, "07010000", "", 0, 0, noCoordinate, noCoordinate).Return(locality.Locality{}, fmt.Errorf(zipcode.ERR_ZIP_CODE_NOT_FOUND))
+
+		server := gin.Default()
+		serverLocalityGroup := server.Group(URL_LOCALITY)
+		serverLocalityGroup.POST("/", handlerLocality.Create)
+
+		req, rr := createRequestTest(http.MethodPost, URL_LOCALITY, `{"zip_code": "07010000"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+}
+
+func TestLocality_CreateWithCoordinates(t *testing.T) {
+	t.Run("Deve retornar status 422 quando as coordenadas forem inválidas", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerLocality := NewLocality(mockService)
+
+		mockService.On("Create", mock.Anything, "6700", "Gru", 1, 0, mock.Anything, mock.Anything).
+			Return(locality.Locality{}, fmt.Errorf(locality.ERR_INVALID_COORDINATES))
+
+		server := gin.Default()
+		serverLocalityGroup := server.Group(URL_LOCALITY)
+		serverLocalityGroup.POST("/", handlerLocality.Create)
+
+		req, rr := createRequestTest(http.MethodPost, URL_LOCALITY, `{"zip_code": "6700", "locality_name": "Gru", "province_id": 1, "latitude": 123}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+}
+
+func TestLocality_Lookup(t *testing.T) {
+	t.Run("Deve retornar status 200 com a locality do zip", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		expected := locality.Locality{Id: 1, ZipCode: "07010000", LocalityName: "Guarulhos", ProvinceID: 1, ProvinceName: "São Paulo", CountryID: 1, CountryName: "Brasil"}
+
+		mockService.On("Lookup", mock.Anything, "07010-000").Return(expected, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"lookup?zip=07010-000", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve retornar status 400 quando o zip não for enviado", func(t *testing.T) {
+		server, _ := initLocalityServer(t)
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"lookup", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 400, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando o zip não tiver locality", func(t *testing.T) {
+		server, mockService := initLocalityServer(t)
+
+		mockService.On("Lookup", mock.Anything, "99999").Return(locality.Locality{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodGet, URL_LOCALITY+"lookup?zip=99999", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+}
+
 func TestLocality_GetAll(t *testing.T) {
 
 	t.Run("Deve retornar status 200", func(t *testing.T) {
-		mockService := mocks.NewRepository(t)
+		mockService := mocks.NewService(t)
 		handlerLocality := NewLocality(mockService)
 
 		localityList := []locality.Locality{{
@@ -184,7 +578,7 @@ func TestLocality_GetAll(t *testing.T) {
 	})
 
 	t.Run("Deve retornar status 404", func(t *testing.T) {
-		mockService := mocks.NewRepository(t)
+		mockService := mocks.NewService(t)
 		handlerLocality := NewLocality(mockService)
 
 		localityList := []locality.Locality{{
@@ -209,24 +603,21 @@ func TestLocality_GetAll(t *testing.T) {
 
 func TestLocality_ValidateLocalityFields(t *testing.T) {
 	t.Run("Deve retornar a mensagem de erro do campo inválido", func(t *testing.T) {
-		localityZipCode := requestLocality{ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
-		localityLocalityName := requestLocality{ZipCode: "6700", LocalityName: "", ProvinceName: "SP", CountryName: "BRA"}
-		localityProvinceName := requestLocality{ZipCode: "6700", LocalityName: "Gru", ProvinceName: "", CountryName: "BRA"}
-		localityCountryName := requestLocality{ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: ""}
+		localityZipCode := requestLocality{ZipCode: "", LocalityName: "Gru", ProvinceID: 1}
+		localityLocalityName := requestLocality{ZipCode: "6700", LocalityName: "", ProvinceID: 1}
+		localityProvinceID := requestLocality{ZipCode: "6700", LocalityName: "Gru", ProvinceID: 0}
 
 		errLocalityZipCode := validateLocalityFields(localityZipCode)
 		errLocalityLocalityName := validateLocalityFields(localityLocalityName)
-		errLocalityProvinceName := validateLocalityFields(localityProvinceName)
-		errLocalityCountryName := validateLocalityFields(localityCountryName)
+		errLocalityProvinceID := validateLocalityFields(localityProvinceID)
 
-		assert.NotEqual(t, "invalid input in field zip_code", errLocalityZipCode)
-		assert.NotEqual(t, "invalid input in field locality_name", errLocalityLocalityName)
-		assert.NotEqual(t, "invalid input in field province_name", errLocalityProvinceName)
-		assert.NotEqual(t, "invalid input in field country_name", errLocalityCountryName)
+		assert.EqualError(t, errLocalityZipCode, "invalid input in field zip_code")
+		assert.EqualError(t, errLocalityLocalityName, "invalid input in field locality_name")
+		assert.EqualError(t, errLocalityProvinceID, "invalid input in field province_id")
 	})
 
 	t.Run("Deve retornar nil quando não houver erro", func(t *testing.T) {
-		localityOk := requestLocality{ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
+		localityOk := requestLocality{ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1, CountryID: 1}
 		errLocalityOk := validateLocalityFields(localityOk)
 
 		assert.Nil(t, errLocalityOk)
@@ -1,8 +1,11 @@
 package product_batches
 
 import (
+	"bytes"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	productbatch "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
@@ -13,6 +16,36 @@ type ProductBatch struct {
 	service productbatch.Services
 }
 
+type allocationRequest struct {
+	ProductID       int `json:"product_id" binding:"required"`
+	Quantity        int `json:"quantity" binding:"required,gt=0"`
+	BuyerID         int `json:"buyer_id"`
+	PurchaseOrderID int `json:"purchase_order_id"`
+}
+
+type slottingRequest struct {
+	ProductID   int `json:"product_id" binding:"required"`
+	Quantity    int `json:"quantity" binding:"required,gt=0"`
+	WarehouseID int `json:"warehouse_id" binding:"required"`
+}
+
+type pickRequest struct {
+	ProductID       int                 `json:"product_id" binding:"required"`
+	BuyerID         int                 `json:"buyer_id"`
+	PurchaseOrderID int                 `json:"purchase_order_id"`
+	Picks           []productbatch.Pick `json:"picks" binding:"required,min=1,dive"`
+}
+
+type batchUpdateRequest struct {
+	CurQuantity    *int `json:"current_quantity"`
+	CurTemperature *int `json:"current_temperature"`
+	SectionID      *int `json:"section_id"`
+}
+
+type shelfLifeRequest struct {
+	MinimumDays *int `json:"minimum_days" binding:"required"`
+}
+
 func NewProductBatch(p productbatch.Services) ProductBatch {
 	return ProductBatch{p}
 }
@@ -27,7 +60,15 @@ func (p *ProductBatch) Create() gin.HandlerFunc {
 
 		pb, err := p.service.Create(ctx, req)
 		if err != nil {
-			ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+			switch err.Error() {
+			case fmt.Sprintf(productbatch.ERR_SECTION_NOT_FOUND, req.SectionID),
+				fmt.Sprintf(productbatch.ERR_PRODUCT_NOT_FOUND, req.ProductTypeID):
+				ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+			case productbatch.ERR_INVALID_QUANTITY:
+				ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+			default:
+				ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+			}
 			return
 		}
 
@@ -37,27 +78,345 @@ func (p *ProductBatch) Create() gin.HandlerFunc {
 
 func (p *ProductBatch) Report() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		var repID interface{}
-		var err error
+		var reports []productbatch.Report
+
+		format := ctx.DefaultQuery("format", "json")
+		if format != "json" && format != "csv" {
+			ctx.JSON(web.DecodeError(http.StatusBadRequest, "format must be json or csv"))
+			return
+		}
 
 		id, _ := strconv.Atoi(ctx.Query("id"))
 		if id == 0 {
-			repID, err = p.service.Report(ctx)
+			var filter productbatch.ReportFilter
 
+			params := []struct {
+				name  string
+				value *int
+			}{{"warehouse_id", &filter.WarehouseID}, {"product_type_id", &filter.ProductTypeID}}
+
+			for _, param := range params {
+				if ctx.Query(param.name) == "" {
+					continue
+				}
+
+				number, err := strconv.Atoi(ctx.Query(param.name))
+				if err != nil {
+					ctx.JSON(web.DecodeError(http.StatusBadRequest, fmt.Sprintf("%s must be a valid integer", param.name)))
+					return
+				}
+				*param.value = number
+			}
+
+			rep, err := p.service.Report(ctx, filter)
 			if err != nil {
 				ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
 				return
 			}
+			reports = rep
 
 		} else {
-			repID, err = p.service.ReportByID(ctx, id)
+			rep, err := p.service.ReportByID(ctx, id)
+			if err != nil {
+				ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+				return
+			}
+
+			if format == "json" {
+				ctx.JSON(web.NewResponse(http.StatusOK, rep))
+				return
+			}
+			reports = []productbatch.Report{rep}
+		}
+
+		if format == "csv" {
+			var buf bytes.Buffer
+			if err := productbatch.WriteReportCSV(&buf, reports); err != nil {
+				ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+				return
+			}
+
+			ctx.Header("Content-Disposition", "attachment; filename=sections_report.csv")
+			ctx.Data(http.StatusOK, "text/csv", buf.Bytes())
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, reports))
+	}
+}
+
+func (p *ProductBatch) GetByID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		pb, err := p.service.GetByID(ctx, id)
+		if err != nil {
+			ctx.JSON(web.DecodeError(batchStatus(err, id), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, pb))
+	}
+}
+
+func (p *ProductBatch) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		filter := productbatch.BatchFilter{From: ctx.Query("from"), To: ctx.Query("to")}
+
+		params := []struct {
+			name  string
+			value *int
+		}{{"product_id", &filter.ProductID}, {"section_id", &filter.SectionID}}
+
+		for _, param := range params {
+			if ctx.Query(param.name) == "" {
+				continue
+			}
+
+			number, err := strconv.Atoi(ctx.Query(param.name))
+			if err != nil {
+				ctx.JSON(web.DecodeError(http.StatusBadRequest, fmt.Sprintf("%s must be a valid integer", param.name)))
+				return
+			}
+			*param.value = number
+		}
+
+		batches, err := p.service.GetAll(ctx, filter)
+		if err != nil {
+			ctx.JSON(web.DecodeError(batchStatus(err, 0), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, batches))
+	}
+}
+
+func (p *ProductBatch) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		var req batchUpdateRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		pb, err := p.service.Update(ctx, id, productbatch.BatchUpdate{CurQuantity: req.CurQuantity,
+			CurTemperature: req.CurTemperature, SectionID: req.SectionID})
+		if err != nil {
+			if req.SectionID != nil && err.Error() == fmt.Sprintf(productbatch.ERR_SECTION_NOT_FOUND, *req.SectionID) {
+				ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+				return
+			}
+			ctx.JSON(web.DecodeError(batchStatus(err, id), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, pb))
+	}
+}
+
+func (p *ProductBatch) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		if err := p.service.Delete(ctx, id); err != nil {
+			ctx.JSON(web.DecodeError(batchStatus(err, id), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusNoContent, nil))
+	}
+}
+
+func (p *ProductBatch) Allocate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req allocationRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		allocation, err := p.service.Allocate(ctx, productbatch.Allocation{ProductID: req.ProductID,
+			BuyerID: req.BuyerID, PurchaseOrderID: req.PurchaseOrderID, Quantity: req.Quantity})
+		if err != nil {
+			ctx.JSON(web.DecodeError(allocationStatus(err, nil), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, allocation))
+	}
+}
+
+func (p *ProductBatch) ConfirmPick() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req pickRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		allocation, err := p.service.ConfirmPick(ctx, productbatch.Allocation{ProductID: req.ProductID,
+			BuyerID: req.BuyerID, PurchaseOrderID: req.PurchaseOrderID, Picks: req.Picks})
+		if err != nil {
+			ctx.JSON(web.DecodeError(allocationStatus(err, req.Picks), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, allocation))
+	}
+}
+
+func (p *ProductBatch) GetShelfLife() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		shelfLife, err := p.service.GetShelfLife(ctx, id)
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, shelfLife))
+	}
+}
+
+func (p *ProductBatch) SetShelfLife() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
 
+		var req shelfLifeRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		shelfLife, err := p.service.SetShelfLife(ctx, productbatch.ShelfLife{BuyerID: id, MinimumDays: *req.MinimumDays})
+		if err != nil {
+			ctx.JSON(web.DecodeError(allocationStatus(err, nil), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, shelfLife))
+	}
+}
+
+func (p *ProductBatch) Expiring() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		filter := productbatch.ExpiringFilter{Days: productbatch.EXPIRING_DAYS}
+
+		params := []struct {
+			name  string
+			value *int
+		}{{"days", &filter.Days}, {"warehouse_id", &filter.WarehouseID}, {"section_id", &filter.SectionID}}
+
+		for _, param := range params {
+			if ctx.Query(param.name) == "" {
+				continue
+			}
+
+			number, err := strconv.Atoi(ctx.Query(param.name))
 			if err != nil {
+				ctx.JSON(web.DecodeError(http.StatusBadRequest, fmt.Sprintf("%s must be a valid integer", param.name)))
+				return
+			}
+			*param.value = number
+		}
+
+		batches, err := p.service.Expiring(ctx, filter)
+		if err != nil {
+			if err.Error() == productbatch.ERR_INVALID_DAYS {
+				ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+				return
+			}
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, batches))
+	}
+}
+
+func (p *ProductBatch) CompatibleSections() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		productID, err := strconv.Atoi(ctx.Query("product_id"))
+		if err != nil {
+			ctx.JSON(web.DecodeError(http.StatusBadRequest, "product_id must be a valid integer"))
+			return
+		}
+
+		sections, err := p.service.CompatibleSections(ctx, productID)
+		if err != nil {
+			if err.Error() == fmt.Sprintf(productbatch.ERR_PRODUCT_NOT_FOUND, productID) {
 				ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
 				return
 			}
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, sections))
+	}
+}
+
+func (p *ProductBatch) Recommend() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req slottingRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		recommendations, err := p.service.Recommend(ctx, productbatch.Slotting{ProductID: req.ProductID,
+			Quantity: req.Quantity, WarehouseID: req.WarehouseID})
+		if err != nil {
+			switch err.Error() {
+			case fmt.Sprintf(productbatch.ERR_PRODUCT_NOT_FOUND, req.ProductID),
+				fmt.Sprintf(productbatch.ERR_WAREHOUSE_NOT_FOUND, req.WarehouseID):
+				ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+			default:
+				ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			}
+			return
 		}
 
-		ctx.JSON(web.NewResponse(http.StatusOK, repID))
+		ctx.JSON(web.NewResponse(http.StatusOK, recommendations))
 	}
 }
+
+func batchStatus(err error, id int) int {
+	if strings.HasPrefix(err.Error(), productbatch.ERR_INCOMPATIBLE) {
+		return http.StatusConflict
+	}
+
+	switch err.Error() {
+	case fmt.Sprintf(productbatch.ERR_BATCH_NOT_FOUND, id):
+		return http.StatusNotFound
+	case productbatch.ERR_INVALID_DATE:
+		return http.StatusBadRequest
+	case productbatch.ERR_INVALID_QUANTITY:
+		return http.StatusUnprocessableEntity
+	case productbatch.ERR_BATCH_NOT_EMPTY, productbatch.ERR_SECTION_FULL, productbatch.ERR_BELOW_RESERVED:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func allocationStatus(err error, picks []productbatch.Pick) int {
+	switch err.Error() {
+	case productbatch.ERR_INVALID_QUANTITY, productbatch.ERR_NO_PICKS, productbatch.ERR_INVALID_SHELF_LIFE:
+		return http.StatusUnprocessableEntity
+	case productbatch.ERR_NOT_ENOUGH_STOCK:
+		return http.StatusConflict
+	}
+
+	for _, pick := range picks {
+		if err.Error() == fmt.Sprintf(productbatch.ERR_PICK_EXCEEDS_STOCK, pick.ProductBatchID) ||
+			err.Error() == fmt.Sprintf(productbatch.ERR_PICK_NOT_ALLOWED, pick.ProductBatchID) {
+			return http.StatusConflict
+		}
+	}
+
+	return http.StatusInternalServerError
+}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/product_batches"
 	productbatch "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch"
@@ -85,6 +87,10 @@ func TestBatchCreate(t *testing.T) {
 
 	engine.POST(URL_PRODUCTS_BATCH, pb.Create())
 
+	storageSection := productbatch.StorageSection{ID: 1, ProductTypeID: 1, MinTemperature: -25, CurTemperature: -18, MaxCapacity: 500}
+	mockRepository.On("GetStorageProduct", mock.Anything, mock.Anything).Return(productbatch.StorageProduct{ID: 1, ProductTypeID: 1, FreezingTemperature: -18}, nil)
+	mockRepository.On("GetStorageSection", mock.Anything, mock.Anything).Return(storageSection, nil)
+
 	t.Run("create_ok", func(t *testing.T) {
 		mockRepository.On("GetByBatchNum", mock.Anything, mock.Anything).Return(productbatch.ProductBatch{}, errors.New(""))
 		mockRepository.On("Create", mock.Anything, exp).Return(exp, nil)
@@ -151,6 +157,38 @@ func TestBatchCreate(t *testing.T) {
 		assert.Equal(t, exp.Code, w.Code)
 		assert.Equal(t, string(expectedJSON), w.Body.String())
 	})
+
+	t.Run("create_fail_section_full", func(t *testing.T) {
+		exp.SectionID = 2
+		exp.ProductTypeID = 1
+
+		mockRepository.On("GetByBatchNum", mock.Anything, mock.Anything).Return(productbatch.ProductBatch{}, errors.New(""))
+		mockRepository.On("Create", mock.Anything, exp).Return(productbatch.ProductBatch{}, errors.New(productbatch.ERR_SECTION_FULL))
+
+		expected, _ := json.Marshal(exp)
+		req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH, expected)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{409, productbatch.ERR_SECTION_FULL}
+		expectedJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expectedJSON), w.Body.String())
+	})
+
+	t.Run("create_fail_section_not_found", func(t *testing.T) {
+		exp.SectionID = 98
+		exp.ProductTypeID = 1
+
+		mockRepository.On("GetByBatchNum", mock.Anything, mock.Anything).Return(productbatch.ProductBatch{}, errors.New(""))
+		mockRepository.On("Create", mock.Anything, exp).Return(productbatch.ProductBatch{}, fmt.Errorf(productbatch.ERR_SECTION_NOT_FOUND, 98))
+
+		expected, _ := json.Marshal(exp)
+		req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH, expected)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
 }
 
 func TestBatchReport(t *testing.T) {
@@ -160,7 +198,7 @@ func TestBatchReport(t *testing.T) {
 	engine.GET(URL_SECTION_REPORT, pb.Report())
 
 	t.Run("report_all_ok", func(t *testing.T) {
-		mockRepository.On("Report", mock.Anything).Return(exp, nil).Once()
+		mockRepository.On("Report", mock.Anything, productbatch.ReportFilter{}).Return(exp, nil).Once()
 		req, w := InitServer(http.MethodGet, URL_SECTION_REPORT, nil)
 
 		engine.ServeHTTP(w, req)
@@ -173,7 +211,7 @@ func TestBatchReport(t *testing.T) {
 	})
 
 	t.Run("report_all_fail_db", func(t *testing.T) {
-		mockRepository.On("Report", mock.Anything).Return([]productbatch.Report{}, errors.New("sql: connection failed"))
+		mockRepository.On("Report", mock.Anything, productbatch.ReportFilter{}).Return([]productbatch.Report{}, errors.New("sql: connection failed")).Once()
 		req, w := InitServer(http.MethodGet, URL_SECTION_REPORT, nil)
 
 		engine.ServeHTTP(w, req)
@@ -186,6 +224,69 @@ func TestBatchReport(t *testing.T) {
 	})
 }
 
+func TestBatchReportFilters(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+
+	engine.GET(URL_SECTION_REPORT, pb.Report())
+
+	t.Run("report_filtered_utilization", func(t *testing.T) {
+		filter := productbatch.ReportFilter{WarehouseID: 2, ProductTypeID: 4}
+		rep := []productbatch.Report{{SecID: 1, SecNum: 22, ProdCount: 5, TotalQuantity: 150, DistinctProducts: 3,
+			EarliestDueDate: "2022-04-04", MaxCapacity: 200}}
+
+		mockRepository.On("Report", mock.Anything, filter).Return(rep, nil).Once()
+		req, w := InitServer(http.MethodGet, URL_SECTION_REPORT+"?warehouse_id=2&product_type_id=4", nil)
+
+		engine.ServeHTTP(w, req)
+
+		var body struct {
+			Data []productbatch.Report `json:"data"`
+		}
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, nil, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, 75.0, body.Data[0].Utilization)
+	})
+
+	t.Run("report_csv", func(t *testing.T) {
+		rep := []productbatch.Report{{SecID: 1, SecNum: 22, ProdCount: 5, TotalQuantity: 150, DistinctProducts: 3,
+			EarliestDueDate: "2022-04-04", MaxCapacity: 200}}
+
+		mockRepository.On("Report", mock.Anything, productbatch.ReportFilter{}).Return(rep, nil).Once()
+		req, w := InitServer(http.MethodGet, URL_SECTION_REPORT+"?format=csv", nil)
+
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+		assert.Equal(t, "section_id,section_number,products_count,total_quantity,distinct_products,earliest_due_date,"+
+			"maximum_capacity,utilization_percentage\n1,22,5,150,3,2022-04-04,200,75.00\n", w.Body.String())
+	})
+
+	t.Run("report_invalid_format", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, URL_SECTION_REPORT+"?format=xml", nil)
+
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{400, "format must be json or csv"}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("report_invalid_warehouse", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, URL_SECTION_REPORT+"?warehouse_id=abc", nil)
+
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{400, "warehouse_id must be a valid integer"}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+}
+
 func TestBatchReportID(t *testing.T) {
 	engine, mockRepository, pb := InitTest(t)
 	exp := CreateReportArray()[0]
@@ -205,14 +306,448 @@ func TestBatchReportID(t *testing.T) {
 	})
 
 	t.Run("report_fail_not_found", func(t *testing.T) {
-		mockRepository.On("ReportByID", mock.Anything, 99).Return(productbatch.Report{}, errors.New("sql: no rows in result set"))
+		mockRepository.On("ReportByID", mock.Anything, 99).Return(productbatch.Report{}, fmt.Errorf(productbatch.ERR_SECTION_NOT_FOUND, 99))
 		req, w := InitServer(http.MethodGet, URL_SECTION_REPORT+"?id=99", nil)
 		engine.ServeHTTP(w, req)
 
-		exp := ExpectedErrorJSON{404, "sql: no rows in result set"}
+		exp := ExpectedErrorJSON{404, fmt.Sprintf(productbatch.ERR_SECTION_NOT_FOUND, 99)}
 		ExpectedJSON, _ := json.Marshal(exp)
 
 		assert.Equal(t, exp.Code, w.Code)
 		assert.Equal(t, string(ExpectedJSON), w.Body.String())
 	})
 }
+
+func TestBatchAllocate(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+
+	engine.POST(URL_PRODUCTS_BATCH+"/allocations", pb.Allocate())
+
+	t.Run("allocate_ok", func(t *testing.T) {
+		mockRepository.On("GetShelfLife", mock.Anything, 3).Return(productbatch.ShelfLife{BuyerID: 3}, nil).Once()
+		mockRepository.On("AllocatableBatches", mock.Anything, 1, 0, mock.Anything).Return([]productbatch.BatchStock{
+			{ID: 2, BatchNumber: 222, SectionID: 3, DueDate: "2022-09-01 00:00:00", Available: 10},
+		}, nil).Once()
+
+		req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH+"/allocations", []byte(`{"product_id": 1, "buyer_id": 3, "quantity": 4}`))
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedJSON{200, productbatch.Allocation{ProductID: 1, BuyerID: 3, Quantity: 4, Picks: []productbatch.Pick{
+			{ProductBatchID: 2, BatchNumber: 222, SectionID: 3, DueDate: "2022-09-01 00:00:00", Quantity: 4},
+		}}}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("allocate_not_enough_stock", func(t *testing.T) {
+		mockRepository.On("GetShelfLife", mock.Anything, 3).Return(productbatch.ShelfLife{BuyerID: 3}, nil).Once()
+		mockRepository.On("AllocatableBatches", mock.Anything, 1, 0, mock.Anything).Return([]productbatch.BatchStock{}, nil).Once()
+
+		req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH+"/allocations", []byte(`{"product_id": 1, "buyer_id": 3, "quantity": 4}`))
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{409, productbatch.ERR_NOT_ENOUGH_STOCK}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("allocate_fail_bind", func(t *testing.T) {
+		req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH+"/allocations", []byte(`{"product_id": 1, "quantity": 0}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestBatchConfirmPick(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+
+	engine.POST(URL_PRODUCTS_BATCH+"/allocations/confirm", pb.ConfirmPick())
+
+	t.Run("confirm_pick_ok", func(t *testing.T) {
+		mockRepository.On("GetShelfLife", mock.Anything, 3).Return(productbatch.ShelfLife{BuyerID: 3, MinimumDays: 10}, nil).Once()
+		mockRepository.On("ConfirmPicks", mock.Anything, 1, 7, time.Now().AddDate(0, 0, 10).Format("2006-01-02"),
+			[]productbatch.Pick{{ProductBatchID: 2, Quantity: 4}}).Return(nil).Once()
+
+		req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH+"/allocations/confirm",
+			[]byte(`{"product_id": 1, "buyer_id": 3, "purchase_order_id": 7, "picks": [{"product_batch_id": 2, "quantity": 4}]}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("confirm_pick_exceeds_stock", func(t *testing.T) {
+		mockRepository.On("GetShelfLife", mock.Anything, 0).Return(productbatch.ShelfLife{}, nil).Once()
+		mockRepository.On("ConfirmPicks", mock.Anything, 1, 0, mock.Anything, mock.Anything).Return(fmt.Errorf(productbatch.ERR_PICK_EXCEEDS_STOCK, 2)).Once()
+
+		req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH+"/allocations/confirm",
+			[]byte(`{"product_id": 1, "picks": [{"product_batch_id": 2, "quantity": 400}]}`))
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{409, fmt.Sprintf(productbatch.ERR_PICK_EXCEEDS_STOCK, 2)}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("confirm_pick_not_allowed", func(t *testing.T) {
+		mockRepository.On("GetShelfLife", mock.Anything, 0).Return(productbatch.ShelfLife{}, nil).Once()
+		mockRepository.On("ConfirmPicks", mock.Anything, 1, 0, mock.Anything, mock.Anything).Return(fmt.Errorf(productbatch.ERR_PICK_NOT_ALLOWED, 2)).Once()
+
+		req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH+"/allocations/confirm",
+			[]byte(`{"product_id": 1, "picks": [{"product_batch_id": 2, "quantity": 4}]}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("confirm_pick_fail_bind", func(t *testing.T) {
+		req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH+"/allocations/confirm", []byte(`{"product_id": 1, "picks": []}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestBatchExpiring(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+	exp := []productbatch.ExpiringBatch{
+		{ID: 2, BatchNumber: 222, ProductID: 1, SectionID: 3, WarehouseID: 1, DueDate: "2022-08-01 00:00:00",
+			CurQuantity: 10, Expired: true, DaysLeft: -2},
+	}
+
+	engine.GET(URL_PRODUCTS_BATCH+"/expiring", pb.Expiring())
+
+	t.Run("expiring_ok", func(t *testing.T) {
+		mockRepository.On("Expiring", mock.Anything, mock.Anything, mock.Anything,
+			productbatch.ExpiringFilter{Days: 3, WarehouseID: 1, SectionID: 3}).Return(exp, nil).Once()
+
+		req, w := InitServer(http.MethodGet, URL_PRODUCTS_BATCH+"/expiring?days=3&warehouse_id=1&section_id=3", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedJSON{200, exp}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("expiring_default_days", func(t *testing.T) {
+		mockRepository.On("Expiring", mock.Anything, mock.Anything, mock.Anything,
+			productbatch.ExpiringFilter{Days: productbatch.EXPIRING_DAYS}).Return([]productbatch.ExpiringBatch{}, nil).Once()
+
+		req, w := InitServer(http.MethodGet, URL_PRODUCTS_BATCH+"/expiring", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("expiring_invalid_query", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, URL_PRODUCTS_BATCH+"/expiring?warehouse_id=abc", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{400, "warehouse_id must be a valid integer"}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("expiring_negative_days", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, URL_PRODUCTS_BATCH+"/expiring?days=-1", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestBatchGetByID(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+	exp := productbatch.ProductBatch{ID: 1, BatchNumber: 111, CurQuantity: 200, ProductTypeID: 1, SectionID: 1}
+
+	engine.GET(URL_PRODUCTS_BATCH+"/:id", pb.GetByID())
+
+	t.Run("get_by_id_ok", func(t *testing.T) {
+		mockRepository.On("GetByID", mock.Anything, 1).Return(exp, nil).Once()
+
+		req, w := InitServer(http.MethodGet, URL_PRODUCTS_BATCH+"/1", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedJSON{200, exp}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("get_by_id_not_found", func(t *testing.T) {
+		mockRepository.On("GetByID", mock.Anything, 99).Return(productbatch.ProductBatch{},
+			fmt.Errorf(productbatch.ERR_BATCH_NOT_FOUND, 99)).Once()
+
+		req, w := InitServer(http.MethodGet, URL_PRODUCTS_BATCH+"/99", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestBatchGetAll(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+
+	engine.GET(URL_PRODUCTS_BATCH, pb.GetAll())
+
+	t.Run("get_all_ok", func(t *testing.T) {
+		mockRepository.On("GetAll", mock.Anything, productbatch.BatchFilter{ProductID: 1, SectionID: 2,
+			From: "2022-04-01 00:00:00", To: "2022-04-30 23:59:59"}).Return([]productbatch.ProductBatch{}, nil).Once()
+
+		req, w := InitServer(http.MethodGet, URL_PRODUCTS_BATCH+"?product_id=1&section_id=2&from=2022-04-01&to=2022-04-30", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("get_all_invalid_date", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, URL_PRODUCTS_BATCH+"?from=01/04/2022", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{400, productbatch.ERR_INVALID_DATE}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("get_all_invalid_product", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, URL_PRODUCTS_BATCH+"?product_id=abc", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestBatchUpdate(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+	saved := productbatch.ProductBatch{ID: 1, BatchNumber: 111, CurQuantity: 200, CurTemperature: 20, ProductTypeID: 1, SectionID: 1}
+
+	engine.PATCH(URL_PRODUCTS_BATCH+"/:id", pb.Update())
+
+	mockRepository.On("GetStorageProduct", mock.Anything, 1).Return(productbatch.StorageProduct{ID: 1, ProductTypeID: 1, FreezingTemperature: -18}, nil)
+	mockRepository.On("GetStorageSection", mock.Anything, mock.Anything).Return(productbatch.StorageSection{ProductTypeID: 1, MinTemperature: -25, CurTemperature: -18}, nil)
+
+	t.Run("update_ok", func(t *testing.T) {
+		exp := saved
+		exp.CurTemperature = 18
+		mockRepository.On("GetByID", mock.Anything, 1).Return(saved, nil).Once()
+		mockRepository.On("Update", mock.Anything, 1, productbatch.BatchUpdate{CurTemperature: &exp.CurTemperature}).Return(exp, nil).Once()
+
+		req, w := InitServer(http.MethodPatch, URL_PRODUCTS_BATCH+"/1", []byte(`{"current_temperature": 18}`))
+		engine.ServeHTTP(w, req)
+
+		expected := ExpectedJSON{200, exp}
+		expJSON, _ := json.Marshal(expected)
+
+		assert.Equal(t, expected.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("update_negative_quantity", func(t *testing.T) {
+		mockRepository.On("GetByID", mock.Anything, 1).Return(saved, nil).Once()
+
+		req, w := InitServer(http.MethodPatch, URL_PRODUCTS_BATCH+"/1", []byte(`{"current_quantity": -5}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("update_below_reserved", func(t *testing.T) {
+		quantity := 10
+		mockRepository.On("GetByID", mock.Anything, 1).Return(saved, nil).Once()
+		mockRepository.On("Update", mock.Anything, 1, productbatch.BatchUpdate{CurQuantity: &quantity}).Return(productbatch.ProductBatch{}, errors.New(productbatch.ERR_BELOW_RESERVED)).Once()
+
+		req, w := InitServer(http.MethodPatch, URL_PRODUCTS_BATCH+"/1", []byte(`{"current_quantity": 10}`))
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{409, productbatch.ERR_BELOW_RESERVED}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("update_move_section_full", func(t *testing.T) {
+		sectionID := 3
+		mockRepository.On("GetByID", mock.Anything, 1).Return(saved, nil).Once()
+		mockRepository.On("Update", mock.Anything, 1, productbatch.BatchUpdate{SectionID: &sectionID}).Return(productbatch.ProductBatch{}, errors.New(productbatch.ERR_SECTION_FULL)).Once()
+
+		req, w := InitServer(http.MethodPatch, URL_PRODUCTS_BATCH+"/1", []byte(`{"section_id": 3}`))
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{409, productbatch.ERR_SECTION_FULL}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("update_move_section_not_found", func(t *testing.T) {
+		sectionID := 99
+		mockRepository.On("GetByID", mock.Anything, 1).Return(saved, nil).Once()
+		mockRepository.On("Update", mock.Anything, 1, productbatch.BatchUpdate{SectionID: &sectionID}).Return(productbatch.ProductBatch{}, fmt.Errorf(productbatch.ERR_SECTION_NOT_FOUND, 99)).Once()
+
+		req, w := InitServer(http.MethodPatch, URL_PRODUCTS_BATCH+"/1", []byte(`{"section_id": 99}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestBatchDelete(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+
+	engine.DELETE(URL_PRODUCTS_BATCH+"/:id", pb.Delete())
+
+	t.Run("delete_ok", func(t *testing.T) {
+		mockRepository.On("GetByID", mock.Anything, 1).Return(productbatch.ProductBatch{ID: 1}, nil).Once()
+		mockRepository.On("Delete", mock.Anything, 1).Return(nil).Once()
+
+		req, w := InitServer(http.MethodDelete, URL_PRODUCTS_BATCH+"/1", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("delete_not_empty", func(t *testing.T) {
+		mockRepository.On("GetByID", mock.Anything, 1).Return(productbatch.ProductBatch{ID: 1, CurQuantity: 3}, nil).Once()
+
+		req, w := InitServer(http.MethodDelete, URL_PRODUCTS_BATCH+"/1", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{409, productbatch.ERR_BATCH_NOT_EMPTY}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+}
+
+func TestBatchCompatibleSections(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+	freezer := productbatch.StorageSection{ID: 1, SectionNumber: 10, WarehouseID: 1, ProductTypeID: 1,
+		MinTemperature: -25, CurTemperature: -18, MaxCapacity: 500}
+	chiller := productbatch.StorageSection{ID: 4, SectionNumber: 40, WarehouseID: 1, ProductTypeID: 1,
+		MinTemperature: 0, CurTemperature: 5, MaxCapacity: 500}
+
+	engine.GET("/api/v1/sections/compatible", pb.CompatibleSections())
+
+	t.Run("compatible_ok", func(t *testing.T) {
+		mockRepository.On("GetStorageProduct", mock.Anything, 1).Return(productbatch.StorageProduct{ID: 1, ProductTypeID: 1, FreezingTemperature: -18}, nil).Once()
+		mockRepository.On("StorageSections", mock.Anything).Return([]productbatch.StorageSection{freezer, chiller}, nil).Once()
+
+		req, w := InitServer(http.MethodGet, "/api/v1/sections/compatible?product_id=1", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedJSON{200, []productbatch.StorageSection{freezer}}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("compatible_product_not_found", func(t *testing.T) {
+		mockRepository.On("GetStorageProduct", mock.Anything, 9).Return(productbatch.StorageProduct{}, fmt.Errorf(productbatch.ERR_PRODUCT_NOT_FOUND, 9)).Once()
+
+		req, w := InitServer(http.MethodGet, "/api/v1/sections/compatible?product_id=9", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("compatible_missing_product", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, "/api/v1/sections/compatible", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{400, "product_id must be a valid integer"}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+}
+
+func TestBatchRecommend(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+	freezer := productbatch.StorageSection{ID: 1, SectionNumber: 10, WarehouseID: 1, ProductTypeID: 1,
+		MinTemperature: -25, CurTemperature: -18, MaxCapacity: 500}
+
+	engine.POST("/api/v1/sections/recommend", pb.Recommend())
+
+	t.Run("recommend_ok", func(t *testing.T) {
+		mockRepository.On("GetStorageProduct", mock.Anything, 1).Return(productbatch.StorageProduct{ID: 1, ProductTypeID: 1, FreezingTemperature: -18}, nil).Once()
+		mockRepository.On("WarehouseExists", mock.Anything, 1).Return(true, nil).Once()
+		mockRepository.On("WarehouseSections", mock.Anything, 1).Return([]productbatch.StorageSection{freezer}, nil).Once()
+		mockRepository.On("ProductSections", mock.Anything, 1).Return([]int{1}, nil).Once()
+
+		req, w := InitServer(http.MethodPost, "/api/v1/sections/recommend", []byte(`{"product_id": 1, "quantity": 50, "warehouse_id": 1}`))
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedJSON{200, []productbatch.Recommendation{{Section: freezer, FreeCapacity: 500, Suitable: true,
+			Score: 97, TemperatureFit: 1, CapacityFit: 0.9, Proximity: 1}}}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("recommend_product_not_found", func(t *testing.T) {
+		mockRepository.On("GetStorageProduct", mock.Anything, 9).Return(productbatch.StorageProduct{}, fmt.Errorf(productbatch.ERR_PRODUCT_NOT_FOUND, 9)).Once()
+
+		req, w := InitServer(http.MethodPost, "/api/v1/sections/recommend", []byte(`{"product_id": 9, "quantity": 50, "warehouse_id": 1}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("recommend_warehouse_not_found", func(t *testing.T) {
+		mockRepository.On("GetStorageProduct", mock.Anything, 1).Return(productbatch.StorageProduct{ID: 1, ProductTypeID: 1, FreezingTemperature: -18}, nil).Once()
+		mockRepository.On("WarehouseExists", mock.Anything, 9).Return(false, nil).Once()
+
+		req, w := InitServer(http.MethodPost, "/api/v1/sections/recommend", []byte(`{"product_id": 1, "quantity": 50, "warehouse_id": 9}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("recommend_invalid_quantity", func(t *testing.T) {
+		req, w := InitServer(http.MethodPost, "/api/v1/sections/recommend", []byte(`{"product_id": 1, "quantity": -5, "warehouse_id": 1}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestBatchCreateIncompatible(t *testing.T) {
+	engine, mockRepository, pb := InitTest(t)
+	batch := productbatch.ProductBatch{BatchNumber: 112, CurQuantity: 10, ProductTypeID: 1, SectionID: 4}
+
+	engine.POST(URL_PRODUCTS_BATCH, pb.Create())
+
+	mockRepository.On("GetByBatchNum", mock.Anything, 112).Return(productbatch.ProductBatch{}, errors.New(""))
+	mockRepository.On("GetStorageProduct", mock.Anything, 1).Return(productbatch.StorageProduct{ID: 1, ProductTypeID: 1, FreezingTemperature: -18}, nil)
+	mockRepository.On("GetStorageSection", mock.Anything, 4).Return(productbatch.StorageSection{ID: 4, ProductTypeID: 1, CurTemperature: 5}, nil)
+
+	body, _ := json.Marshal(batch)
+	req, w := InitServer(http.MethodPost, URL_PRODUCTS_BATCH, body)
+	engine.ServeHTTP(w, req)
+
+	exp := ExpectedErrorJSON{409, productbatch.ERR_INCOMPATIBLE + ": the recommended freezing temperature of the product " +
+		"(-18.00) is out of the temperatures of the section (0 to 5)"}
+	expJSON, _ := json.Marshal(exp)
+
+	assert.Equal(t, exp.Code, w.Code)
+	assert.Equal(t, string(expJSON), w.Body.String())
+}
@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	producttype "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_type"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ProductType struct {
+	service producttype.Service
+}
+
+func NewProductType(p producttype.Service) *ProductType {
+	return &ProductType{service: p}
+}
+
+func validateProductType(c *gin.Context, req producttype.ProductType) bool {
+	var validate *validator.Validate = validator.New()
+	errValidate := validate.Struct(req)
+	if errValidate == nil {
+		return true
+	}
+	if _, ok := errValidate.(*validator.InvalidValidationError); ok {
+		c.JSON(web.DecodeError(http.StatusNotFound, errValidate.Error()))
+		return false
+	}
+	for _, errValidate := range errValidate.(validator.ValidationErrors) {
+		s := fmt.Sprintf("%s is mandatory", errValidate.Field())
+		c.JSON(web.DecodeError(http.StatusUnprocessableEntity, s))
+		break
+	}
+	return false
+}
+
+// productTypeStatus maps the product type errors to their status code, any
+// other error being a failure of the database.
+func productTypeStatus(err error, id int) int {
+	switch err.Error() {
+	case fmt.Sprintf(producttype.ERROR_PRODUCT_TYPE_NOT_FOUND, id):
+		return http.StatusNotFound
+	case producttype.ERROR_PRODUCT_TYPE_IN_USE:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// StoreProductTypes godoc
+// @Summary Store product types
+// @Tags ProductTypes
+// @Description store product types
+// @Accept json
+// @Produce json
+// @Param productType body producttype.ProductType true "Product type to store"
+// @Failure 400 {object} web.Response
+// @Failure 422 {object} web.Response "Missing some mandatory field"
+// @Success 201 {object} web.Response
+// @Router /api/v1/productTypes [POST]
+func (pt *ProductType) Store() gin.HandlerFunc {
+	fn := func(c *gin.Context) {
+		var req producttype.ProductType
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+			return
+		}
+		if !validateProductType(c, req) {
+			return
+		}
+		p, err := pt.service.Store(c.Request.Context(), req)
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+		c.JSON(web.NewResponse(http.StatusCreated, p))
+	}
+	return fn
+}
+
+// ListProductTypes godoc
+// @Summary List product types
+// @Tags ProductTypes
+// @Description get product types
+// @Accept json
+// @Produce json
+// @Failure 500 {object} web.Response
+// @Success 200 {object} web.Response
+// @Router /api/v1/productTypes [GET]
+func (pt *ProductType) GetAll() gin.HandlerFunc {
+	fn := func(c *gin.Context) {
+		p, err := pt.service.GetAll(c.Request.Context())
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+			return
+		}
+		c.JSON(web.NewResponse(http.StatusOK, p))
+	}
+	return fn
+}
+
+// ListProductTypesById godoc
+// @Summary List product types by ID
+// @Tags ProductTypes
+// @Description list product types by ID
+// @Accept json
+// @Produce json
+// @Param some_id path int true "Some ID"
+// @Failure 400 {object} web.Response "We need ID"
+// @Failure 404 {object} web.Response "Can not find ID"
+// @Failure 500 {object} web.Response
+// @Success 200 {object} web.Response
+// @Router /api/v1/productTypes/{some_id} [GET]
+func (pt *ProductType) GetById() gin.HandlerFunc {
+	fn := func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, ERROR_ID))
+			return
+		}
+		p, err := pt.service.GetById(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(web.DecodeError(productTypeStatus(err, id), err.Error()))
+			return
+		}
+		c.JSON(web.NewResponse(http.StatusOK, p))
+	}
+	return fn
+}
+
+// UpdateProductTypes godoc
+// @Summary Update product types by ID
+// @Tags ProductTypes
+// @Description update product types applying a JSON merge patch (RFC 7396)
+// @Accept json
+// @Produce json
+// @Param some_id path int true "Some ID"
+// @Param productType body producttype.ProductType true "Product type to update"
+// @Failure 400 {object} web.Response "We need ID"
+// @Failure 404 {object} web.Response "Can not find ID"
+// @Failure 500 {object} web.Response
+// @Failure 422 {object} web.Response "Missing some mandatory field"
+// @Success 200 {object} web.Response
+// @Router /api/v1/productTypes/{some_id} [PATCH]
+func (pt *ProductType) Update() gin.HandlerFunc {
+	fn := func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, ERROR_ID))
+			return
+		}
+		current, err := pt.service.GetById(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(web.DecodeError(productTypeStatus(err, id), err.Error()))
+			return
+		}
+		patch, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+			return
+		}
+		var req producttype.ProductType
+		if err := mergepatch.Apply(current, patch, &req); err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+			return
+		}
+		req.ID = id
+		if !validateProductType(c, req) {
+			return
+		}
+		p, err := pt.service.Update(c.Request.Context(), req, id)
+		if err != nil {
+			c.JSON(web.DecodeError(productTypeStatus(err, id), err.Error()))
+			return
+		}
+		c.JSON(web.NewResponse(http.StatusOK, p))
+	}
+	return fn
+}
+
+// DeleteProductTypes godoc
+// @Summary Delete product types by ID
+// @Tags ProductTypes
+// @Description delete product types not used by any product or section
+// @Accept json
+// @Produce json
+// @Param some_id path int true "Some ID"
+// @Failure 400 {object} web.Response "We need ID"
+// @Failure 404 {object} web.Response "Can not find ID"
+// @Failure 500 {object} web.Response
+// @Failure 409 {object} web.Response "Product type in use"
+// @Success 204 {object} web.Response
+// @Router /api/v1/productTypes/{some_id} [DELETE]
+func (pt *ProductType) Delete() gin.HandlerFunc {
+	fn := func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, ERROR_ID))
+			return
+		}
+		err = pt.service.Delete(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(web.DecodeError(productTypeStatus(err, id), err.Error()))
+			return
+		}
+		c.JSON(web.NewResponse(http.StatusNoContent, ""))
+	}
+	return fn
+}
+
+// ReportProductTypes godoc
+// @Summary Report products and sections by product type
+// @Tags ProductTypes
+// @Description list the products and sections that use the product type
+// @Accept json
+// @Produce json
+// @Param some_id path int true "Some ID"
+// @Failure 400 {object} web.Response "We need ID"
+// @Failure 404 {object} web.Response "Can not find ID"
+// @Failure 500 {object} web.Response
+// @Success 200 {object} web.Response
+// @Router /api/v1/productTypes/{some_id}/report [GET]
+func (pt *ProductType) GetReport() gin.HandlerFunc {
+	fn := func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, ERROR_ID))
+			return
+		}
+		report, err := pt.service.GetReport(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(web.DecodeError(productTypeStatus(err, id), err.Error()))
+			return
+		}
+		c.JSON(web.NewResponse(http.StatusOK, report))
+	}
+	return fn
+}
@@ -0,0 +1,257 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	producttype "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_type"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_type/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	URL_PRODUCT_TYPES = "/api/v1/productTypes/"
+)
+
+type responseProductType struct {
+	Code  int
+	Data  producttype.ProductType
+	Error string
+}
+
+type responseProductTypeReport struct {
+	Code  int
+	Data  producttype.Report
+	Error string
+}
+
+func initProductTypeServer(t *testing.T) (*gin.Engine, *mocks.Service) {
+	mockService := mocks.NewService(t)
+	handlerProductType := handler.NewProductType(mockService)
+	server := gin.Default()
+	productTypeRouterGroup := server.Group(URL_PRODUCT_TYPES)
+	productTypeRouterGroup.POST("/", handlerProductType.Store())
+	productTypeRouterGroup.GET("/", handlerProductType.GetAll())
+	productTypeRouterGroup.GET("/:id", handlerProductType.GetById())
+	productTypeRouterGroup.GET("/:id/report", handlerProductType.GetReport())
+	productTypeRouterGroup.PATCH("/:id", handlerProductType.Update())
+	productTypeRouterGroup.DELETE("/:id", handlerProductType.Delete())
+	return server, mockService
+}
+
+func TestProductTypeStore(t *testing.T) {
+	t.Run("create_ok", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		pt := producttype.ProductType{ID: 1, Description: "frios"}
+		mockService.On("Store", context.Background(), producttype.ProductType{Description: "frios"}).Return(pt, nil)
+		req, rr := createProductRequestTest(http.MethodPost, URL_PRODUCT_TYPES, `{"description": "frios"}`)
+		server.ServeHTTP(rr, req)
+		resp := responseProductType{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusCreated, rr.Code, resp.Code)
+		assert.Equal(t, pt, resp.Data)
+		assert.Equal(t, "", resp.Error)
+	})
+	t.Run("create_missing_description", func(t *testing.T) {
+		server, _ := initProductTypeServer(t)
+		req, rr := createProductRequestTest(http.MethodPost, URL_PRODUCT_TYPES, `{}`)
+		server.ServeHTTP(rr, req)
+		resp := responseProductType{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, resp.Code)
+		assert.Equal(t, "Description is mandatory", resp.Error)
+	})
+	t.Run("create_error_bind", func(t *testing.T) {
+		server, _ := initProductTypeServer(t)
+		req, rr := createProductRequestTest(http.MethodPost, URL_PRODUCT_TYPES, `{"description": 1}`)
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+	t.Run("create_fail_to_save", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("Store", context.Background(), producttype.ProductType{Description: "frios"}).Return(
+			producttype.ProductType{}, fmt.Errorf("fail to save"))
+		req, rr := createProductRequestTest(http.MethodPost, URL_PRODUCT_TYPES, `{"description": "frios"}`)
+		server.ServeHTTP(rr, req)
+		resp := responseProductType{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusInternalServerError, rr.Code, resp.Code)
+		assert.Equal(t, "fail to save", resp.Error)
+	})
+}
+
+func TestProductTypeGet(t *testing.T) {
+	t.Run("find_all", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		pts := []producttype.ProductType{{ID: 1, Description: "frios"}, {ID: 2, Description: "congelados"}}
+		mockService.On("GetAll", context.Background()).Return(pts, nil)
+		req, rr := createProductRequestTest(http.MethodGet, URL_PRODUCT_TYPES, "")
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"code":200,"data":[{"id":1,"description":"frios"},{"id":2,"description":"congelados"}]}`, rr.Body.String())
+	})
+	t.Run("find_all_fail", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("GetAll", context.Background()).Return(nil, fmt.Errorf("fail"))
+		req, rr := createProductRequestTest(http.MethodGet, URL_PRODUCT_TYPES, "")
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+	t.Run("find_by_id_existent", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		pt := producttype.ProductType{ID: 1, Description: "frios"}
+		mockService.On("GetById", context.Background(), 1).Return(pt, nil)
+		req, rr := createProductRequestTest(http.MethodGet, URL_PRODUCT_TYPES+"1", "")
+		server.ServeHTTP(rr, req)
+		resp := responseProductType{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusOK, rr.Code, resp.Code)
+		assert.Equal(t, pt, resp.Data)
+	})
+	t.Run("find_by_id_non_existent", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("GetById", context.Background(), 9).Return(producttype.ProductType{}, fmt.Errorf("product type 9 not found"))
+		req, rr := createProductRequestTest(http.MethodGet, URL_PRODUCT_TYPES+"9", "")
+		server.ServeHTTP(rr, req)
+		resp := responseProductType{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusNotFound, rr.Code, resp.Code)
+		assert.Equal(t, "product type 9 not found", resp.Error)
+	})
+	t.Run("find_by_id_non_number", func(t *testing.T) {
+		server, _ := initProductTypeServer(t)
+		req, rr := createProductRequestTest(http.MethodGet, URL_PRODUCT_TYPES+"non_number", "")
+		server.ServeHTTP(rr, req)
+		resp := responseProductType{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusBadRequest, rr.Code, resp.Code)
+		assert.Equal(t, handler.ERROR_ID, resp.Error)
+	})
+}
+
+func TestProductTypeUpdate(t *testing.T) {
+	t.Run("update_ok", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		pt := producttype.ProductType{ID: 1, Description: "resfriados"}
+		mockService.On("GetById", context.Background(), 1).Return(producttype.ProductType{ID: 1, Description: "frios"}, nil)
+		mockService.On("Update", context.Background(), pt, 1).Return(pt, nil)
+		req, rr := createProductRequestTest(http.MethodPatch, URL_PRODUCT_TYPES+"1", `{"description": "resfriados"}`)
+		server.ServeHTTP(rr, req)
+		resp := responseProductType{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusOK, rr.Code, resp.Code)
+		assert.Equal(t, pt, resp.Data)
+	})
+	t.Run("update_non_existent", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("GetById", context.Background(), 9).Return(producttype.ProductType{}, fmt.Errorf("product type 9 not found"))
+		req, rr := createProductRequestTest(http.MethodPatch, URL_PRODUCT_TYPES+"9", `{"description": "resfriados"}`)
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+	t.Run("update_remove_description", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("GetById", context.Background(), 1).Return(producttype.ProductType{ID: 1, Description: "frios"}, nil)
+		req, rr := createProductRequestTest(http.MethodPatch, URL_PRODUCT_TYPES+"1", `{"description": null}`)
+		server.ServeHTTP(rr, req)
+		resp := responseProductType{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, resp.Code)
+		assert.Equal(t, "Description is mandatory", resp.Error)
+	})
+	t.Run("update_invalid_patch", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("GetById", context.Background(), 1).Return(producttype.ProductType{ID: 1, Description: "frios"}, nil)
+		req, rr := createProductRequestTest(http.MethodPatch, URL_PRODUCT_TYPES+"1", `[]`)
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+	t.Run("update_id_non_number", func(t *testing.T) {
+		server, _ := initProductTypeServer(t)
+		req, rr := createProductRequestTest(http.MethodPatch, URL_PRODUCT_TYPES+"non_number", `{}`)
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestProductTypeDelete(t *testing.T) {
+	t.Run("delete_ok", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("Delete", context.Background(), 1).Return(nil)
+		req, rr := createProductRequestTest(http.MethodDelete, URL_PRODUCT_TYPES+"1", "")
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+	t.Run("delete_in_use", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("Delete", context.Background(), 1).Return(fmt.Errorf(producttype.ERROR_PRODUCT_TYPE_IN_USE))
+		req, rr := createProductRequestTest(http.MethodDelete, URL_PRODUCT_TYPES+"1", "")
+		server.ServeHTTP(rr, req)
+		resp := responseProductType{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusConflict, rr.Code, resp.Code)
+		assert.Equal(t, producttype.ERROR_PRODUCT_TYPE_IN_USE, resp.Error)
+	})
+	t.Run("delete_non_existent", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("Delete", context.Background(), 9).Return(fmt.Errorf("product type 9 not found"))
+		req, rr := createProductRequestTest(http.MethodDelete, URL_PRODUCT_TYPES+"9", "")
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+	t.Run("delete_fail", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("Delete", context.Background(), 1).Return(fmt.Errorf("fail to count"))
+		req, rr := createProductRequestTest(http.MethodDelete, URL_PRODUCT_TYPES+"1", "")
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+	t.Run("delete_id_non_number", func(t *testing.T) {
+		server, _ := initProductTypeServer(t)
+		req, rr := createProductRequestTest(http.MethodDelete, URL_PRODUCT_TYPES+"non_number", "")
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestProductTypeReport(t *testing.T) {
+	t.Run("report_ok", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		report := producttype.Report{ID: 1, Description: "frios", ProductsCount: 1, SectionsCount: 1,
+			Products: []producttype.ReportProduct{{ID: 1, ProductCode: "01", Description: "leite"}},
+			Sections: []producttype.ReportSection{{ID: 1, SectionNumber: 40, WarehouseID: 1}}}
+		mockService.On("GetReport", context.Background(), 1).Return(report, nil)
+		req, rr := createProductRequestTest(http.MethodGet, URL_PRODUCT_TYPES+"1/report", "")
+		server.ServeHTTP(rr, req)
+		resp := responseProductTypeReport{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusOK, rr.Code, resp.Code)
+		assert.Equal(t, report, resp.Data)
+	})
+	t.Run("report_non_existent", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("GetReport", context.Background(), 9).Return(producttype.Report{}, fmt.Errorf("product type 9 not found"))
+		req, rr := createProductRequestTest(http.MethodGet, URL_PRODUCT_TYPES+"9/report", "")
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+	t.Run("report_fail", func(t *testing.T) {
+		server, mockService := initProductTypeServer(t)
+		mockService.On("GetReport", context.Background(), 1).Return(producttype.Report{}, fmt.Errorf("fail"))
+		req, rr := createProductRequestTest(http.MethodGet, URL_PRODUCT_TYPES+"1/report", "")
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+	t.Run("report_id_non_number", func(t *testing.T) {
+		server, _ := initProductTypeServer(t)
+		req, rr := createProductRequestTest(http.MethodGet, URL_PRODUCT_TYPES+"non_number/report", "")
+		server.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -2,10 +2,12 @@ package handlers
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
 	products "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
 
 	"github.com/gin-gonic/gin"
@@ -157,7 +159,7 @@ func (prod *Product) GetById() gin.HandlerFunc {
 // UpdateProducts godoc
 // @Summary Update products by ID
 // @Tags Products
-// @Description update products
+// @Description update products applying a JSON merge patch (RFC 7396)
 // @Accept json
 // @Produce json
 // @Param token header string true "token"
@@ -176,9 +178,19 @@ func (prod *Product) Update() gin.HandlerFunc {
 			c.JSON(web.DecodeError(http.StatusBadRequest, ERROR_ID))
 			return
 		}
+		current, err := prod.service.GetById(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+			return
+		}
+		patch, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+			return
+		}
 		var validate *validator.Validate = validator.New()
 		var req products.Product
-		if err := c.ShouldBindJSON(&req); err != nil {
+		if err := mergepatch.Apply(current, patch, &req); err != nil {
 			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
 			return
 		}
@@ -186,7 +198,7 @@ func (prod *Product) Update() gin.HandlerFunc {
 		errValidate := validate.Struct(req)
 		if errValidate != nil {
 			if _, ok := errValidate.(*validator.InvalidValidationError); ok {
-				c.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+				c.JSON(web.DecodeError(http.StatusNotFound, errValidate.Error()))
 				return
 			}
 			for _, errValidate := range errValidate.(validator.ValidationErrors) {
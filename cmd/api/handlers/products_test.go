@@ -332,21 +332,11 @@ func TestProductUpdate(t *testing.T) {
 			ProductTypeId:                  1,
 			SellerId:                       1,
 		}
-		expected := `{"id": 1,
-			"product_code": "01",
-			"description": "requeijao",
-			"width": 0.1,
-			"height": 0.1,
-			"length": 0.1,
-			"net_weight": 0.1,
-			"expiration_rate": 0.1,
-			"recommended_freezing_temperature": 1.1,
-			"freezing_rate": 1.1,
-			"product_type_id": 1,
-			"seller_id": 1}`
 		req, rr := createProductRequestTest(
-			http.MethodPatch, URL_PRODUCTS+"1", expected)
+			http.MethodPatch, URL_PRODUCTS+"1", `{"description": "requeijao"}`)
 		mockService.On("Update", context.Background(), ps, 1).Return(ps, nil)
+		mockService.On("GetById", context.Background(), 1).Return(
+			createProductsArray()[0], nil)
 		productRouterGroup.PATCH("/:id", handlerProduct.Update())
 		server.ServeHTTP(rr, req)
 		resp := responseProduct{}
@@ -390,6 +380,8 @@ func TestProductUpdate(t *testing.T) {
 			http.MethodPatch, URL_PRODUCTS+"1", expected)
 		mockService.On("Update", context.Background(), ps, 1).Return(
 			products.Product{}, fmt.Errorf("fail to save"))
+		mockService.On("GetById", context.Background(), 1).Return(
+			createProductsArray()[0], nil)
 		productRouterGroup.PATCH("/:id", handlerProduct.Update())
 		server.ServeHTTP(rr, req)
 		resp := responseProduct{}
@@ -433,6 +425,8 @@ func TestProductUpdate(t *testing.T) {
 			http.MethodPatch, URL_PRODUCTS+"1", expected)
 		mockService.On("Update", context.Background(), ps, 1).Return(
 			products.Product{}, fmt.Errorf(products.ERROR_UNIQUE_PRODUCT_CODE))
+		mockService.On("GetById", context.Background(), 1).Return(
+			createProductsArray()[0], nil)
 		productRouterGroup.PATCH("/:id", handlerProduct.Update())
 		server.ServeHTTP(rr, req)
 		resp := responseProduct{}
@@ -463,6 +457,8 @@ func TestProductUpdate(t *testing.T) {
 			URL_PRODUCTS+"1",
 			expected)
 		bindError := "json: cannot unmarshal string into Go struct field Product.width of type float64"
+		mockService.On("GetById", context.Background(), 1).Return(
+			createProductsArray()[0], nil)
 		productRouterGroup.PATCH("/:id", handlerProduct.Update())
 		server.ServeHTTP(rr, req)
 		resp := responseProduct{}
@@ -515,6 +511,8 @@ func TestProductUpdate(t *testing.T) {
 			"product_type_id": 0,
 			"seller_id": 0}`
 		req, rr := createProductRequestTest(http.MethodPatch, URL_PRODUCTS+"1", expected)
+		mockService.On("GetById", context.Background(), 1).Return(
+			createProductsArray()[0], nil)
 		productRouterGroup.PATCH("/:id", handlerProduct.Update())
 		server.ServeHTTP(rr, req)
 		resp := responseProduct{}
@@ -523,6 +521,40 @@ func TestProductUpdate(t *testing.T) {
 		assert.Equal(t, products.Product{}, resp.Data)
 		assert.Equal(t, resp.Error, handler.ERROR_PRODUCT_CODE)
 	})
+	t.Run("update_non_existent", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerProduct := handler.NewProduct(mockService)
+		server := gin.Default()
+		productRouterGroup := server.Group(URL_PRODUCTS)
+		req, rr := createProductRequestTest(
+			http.MethodPatch, URL_PRODUCTS+"1", `{"description": "requeijao"}`)
+		mockService.On("GetById", context.Background(), 1).Return(
+			products.Product{}, fmt.Errorf("produto 1 não encontrado"))
+		productRouterGroup.PATCH("/:id", handlerProduct.Update())
+		server.ServeHTTP(rr, req)
+		resp := responseProduct{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusNotFound, rr.Code, resp.Code)
+		assert.Equal(t, products.Product{}, resp.Data)
+		assert.Equal(t, resp.Error, "produto 1 não encontrado")
+	})
+	t.Run("update_remove_mandatory_field", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerProduct := handler.NewProduct(mockService)
+		server := gin.Default()
+		productRouterGroup := server.Group(URL_PRODUCTS)
+		req, rr := createProductRequestTest(
+			http.MethodPatch, URL_PRODUCTS+"1", `{"description": null}`)
+		mockService.On("GetById", context.Background(), 1).Return(
+			createProductsArray()[0], nil)
+		productRouterGroup.PATCH("/:id", handlerProduct.Update())
+		server.ServeHTTP(rr, req)
+		resp := responseProduct{}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code, resp.Code)
+		assert.Equal(t, products.Product{}, resp.Data)
+		assert.Equal(t, resp.Error, handler.ERROR_DESCRIPTION)
+	})
 }
 
 func TestProductDelete(t *testing.T) {
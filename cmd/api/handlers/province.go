@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type requestProvince struct {
+	ProvinceName string `json:"province_name" binding:"required"`
+	CountryID    int    `json:"country_id" binding:"required"`
+}
+
+type Province struct {
+	service province.Service
+}
+
+func NewProvince(s province.Service) *Province {
+	return &Province{service: s}
+}
+
+func (p *Province) GetAll(ctx *gin.Context) {
+	provinceList, err := p.service.GetAll(ctx)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, provinceList))
+}
+
+func (p *Province) GetById(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+		return
+	}
+
+	result, err := p.service.GetById(ctx, id)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, result))
+}
+
+func (p *Province) Create(ctx *gin.Context) {
+	var req requestProvince
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		if errField := validateProvinceFields(req); errField != nil {
+			err = errField
+		}
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	newProvince, err := p.service.Create(ctx, req.ProvinceName, req.CountryID)
+
+	if err != nil {
+		ctx.JSON(provinceErrorStatus(err))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusCreated, newProvince))
+}
+
+func (p *Province) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+		return
+	}
+
+	current, err := p.service.GetById(ctx, id)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		return
+	}
+
+	patch, err := io.ReadAll(ctx.Request.Body)
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	var req requestProvince
+
+	if err := mergepatch.Apply(current, patch, &req); err != nil {
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		if errField := validateProvinceFields(req); errField != nil {
+			err = errField
+		}
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	result, err := p.service.Update(ctx, id, req.ProvinceName, req.CountryID)
+
+	if err != nil {
+		ctx.JSON(provinceErrorStatus(err))
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, result))
+}
+
+func (p *Province) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+
+	if err != nil {
+		ctx.JSON(web.DecodeError(http.StatusBadRequest, "id must be a number"))
+		return
+	}
+
+	err = p.service.Delete(ctx, id)
+
+	if err != nil {
+		switch err.Error() {
+		case province.ERR_PROVINCE_IN_USE, province.ERR_PROVINCE_COVERED:
+			ctx.JSON(web.DecodeError(http.StatusConflict, err.Error()))
+			return
+		default:
+			ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+			return
+		}
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusNoContent, nil))
+}
+
+func provinceErrorStatus(err error) (int, web.Response) {
+	switch err.Error() {
+	case country.ERR_COUNTRY_NOT_FOUND:
+		return web.DecodeError(http.StatusNotFound, err.Error())
+	case province.ERR_UNIQUE_PROVINCE_NAME:
+		return web.DecodeError(http.StatusConflict, err.Error())
+	default:
+		return web.DecodeError(http.StatusBadRequest, err.Error())
+	}
+}
+
+func validateProvinceFields(req requestProvince) error {
+	if req.ProvinceName == "" {
+		return errors.New("invalid input in field province_name")
+	}
+	if req.CountryID == 0 {
+		return errors.New("invalid input in field country_id")
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	URL_PROVINCE = "/api/v1/provinces/"
+)
+
+func initProvinceServer(t *testing.T) (*gin.Engine, *mocks.Service) {
+	mockService := mocks.NewService(t)
+	handlerProvince := NewProvince(mockService)
+
+	server := gin.Default()
+	provinceGroup := server.Group(URL_PROVINCE)
+	provinceGroup.GET("/", handlerProvince.GetAll)
+	provinceGroup.GET("/:id", handlerProvince.GetById)
+	provinceGroup.POST("/", handlerProvince.Create)
+	provinceGroup.PATCH("/:id", handlerProvince.Update)
+	provinceGroup.DELETE("/:id", handlerProvince.Delete)
+
+	return server, mockService
+}
+
+func TestProvince_GetAll(t *testing.T) {
+	t.Run("Deve retornar status 200", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("GetAll", mock.Anything).Return([]province.Province{{Id: 1, ProvinceName: "SP", CountryID: 1}}, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_PROVINCE, "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve retornar status 500", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("GetAll", mock.Anything).Return([]province.Province{}, fmt.Errorf("error"))
+
+		req, rr := createRequestTest(http.MethodGet, URL_PROVINCE, "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 500, rr.Code)
+	})
+}
+
+func TestProvince_GetById(t *testing.T) {
+	t.Run("Deve retornar status 200", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("GetById", mock.Anything, 1).Return(province.Province{Id: 1, ProvinceName: "SP", CountryID: 1}, nil)
+
+		req, rr := createRequestTest(http.MethodGet, URL_PROVINCE+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("GetById", mock.Anything, 9).Return(province.Province{}, fmt.Errorf(province.ERR_PROVINCE_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodGet, URL_PROVINCE+"9", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+}
+
+func TestProvince_Create(t *testing.T) {
+	t.Run("Deve retornar status 201 quando sucesso", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("Create", mock.Anything, "SP", 1).Return(province.Province{Id: 1, ProvinceName: "SP", CountryID: 1}, nil)
+
+		dataJson, _ := json.Marshal(requestProvince{ProvinceName: "SP", CountryID: 1})
+		req, rr := createRequestTest(http.MethodPost, URL_PROVINCE, string(dataJson))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 201, rr.Code)
+	})
+
+	t.Run("Deve retornar status 422 quando faltar o country_id", func(t *testing.T) {
+		server, _ := initProvinceServer(t)
+
+		req, rr := createRequestTest(http.MethodPost, URL_PROVINCE, `{"province_name": "SP"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando o country não existir", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("Create", mock.Anything, "SP", 9).Return(province.Province{}, fmt.Errorf(country.ERR_COUNTRY_NOT_FOUND))
+
+		dataJson, _ := json.Marshal(requestProvince{ProvinceName: "SP", CountryID: 9})
+		req, rr := createRequestTest(http.MethodPost, URL_PROVINCE, string(dataJson))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+
+	t.Run("Deve retornar status 409 quando o province_name já existir no country", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("Create", mock.Anything, "SP", 1).Return(province.Province{}, fmt.Errorf(province.ERR_UNIQUE_PROVINCE_NAME))
+
+		dataJson, _ := json.Marshal(requestProvince{ProvinceName: "SP", CountryID: 1})
+		req, rr := createRequestTest(http.MethodPost, URL_PROVINCE, string(dataJson))
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+}
+
+func TestProvince_Update(t *testing.T) {
+	current := province.Province{Id: 1, ProvinceName: "SP", CountryID: 1, CountryName: "Brasil"}
+
+	t.Run("Deve retornar status 200 quando sucesso", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("GetById", mock.Anything, 1).Return(current, nil)
+		mockService.On("Update", mock.Anything, 1, "São Paulo", 1).
+			Return(province.Province{Id: 1, ProvinceName: "São Paulo", CountryID: 1}, nil)
+
+		req, rr := createRequestTest(http.MethodPatch, URL_PROVINCE+"1", `{"province_name": "São Paulo"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 200, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando a province não existir", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("GetById", mock.Anything, 9).Return(province.Province{}, fmt.Errorf(province.ERR_PROVINCE_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodPatch, URL_PROVINCE+"9", `{"province_name": "São Paulo"}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+
+	t.Run("Deve retornar status 422 quando remover o country_id", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("GetById", mock.Anything, 1).Return(current, nil)
+
+		req, rr := createRequestTest(http.MethodPatch, URL_PROVINCE+"1", `{"country_id": null}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando o country não existir", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("GetById", mock.Anything, 1).Return(current, nil)
+		mockService.On("Update", mock.Anything, 1, "SP", 9).Return(province.Province{}, fmt.Errorf(country.ERR_COUNTRY_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodPatch, URL_PROVINCE+"1", `{"country_id": 9}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+}
+
+func TestProvince_Delete(t *testing.T) {
+	t.Run("Deve retornar status 204 quando sucesso", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("Delete", mock.Anything, 1).Return(nil)
+
+		req, rr := createRequestTest(http.MethodDelete, URL_PROVINCE+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 204, rr.Code)
+	})
+
+	t.Run("Deve retornar status 409 quando a province possuir localities", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("Delete", mock.Anything, 1).Return(fmt.Errorf(province.ERR_PROVINCE_IN_USE))
+
+		req, rr := createRequestTest(http.MethodDelete, URL_PROVINCE+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+
+	t.Run("Deve retornar status 409 quando a province for coberta por carriers", func(t *testing.T) {
+		server, mockService := initProvinceServer(t)
+		mockService.On("Delete", mock.Anything, 1).Return(fmt.Errorf(province.ERR_PROVINCE_COVERED))
+
+		req, rr := createRequestTest(http.MethodDelete, URL_PROVINCE+"1", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 409, rr.Code)
+	})
+
+	t.Run("Deve retornar status 400 quando o id for inválido", func(t *testing.T) {
+		server, _ := initProvinceServer(t)
+
+		req, rr := createRequestTest(http.MethodDelete, URL_PROVINCE+"abc", "")
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 400, rr.Code)
+	})
+}
@@ -0,0 +1,83 @@
+package section_readings
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	sectionreading "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section_reading"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type SectionReadings struct {
+	service sectionreading.Service
+}
+
+type readingRequest struct {
+	Temperature *float64 `json:"temperature" binding:"required"`
+	RecordedAt  string   `json:"recorded_at"`
+}
+
+type readingsRequest struct {
+	Readings []readingRequest `json:"readings" binding:"required,dive"`
+}
+
+func NewSectionReadings(s sectionreading.Service) SectionReadings {
+	return SectionReadings{s}
+}
+
+// Record stores the readings sent by the sensors of a section.
+func (s *SectionReadings) Record() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		var req readingsRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		readings := make([]sectionreading.Reading, 0, len(req.Readings))
+		for _, reading := range req.Readings {
+			readings = append(readings, sectionreading.Reading{Temperature: *reading.Temperature, RecordedAt: reading.RecordedAt})
+		}
+
+		created, err := s.service.Record(ctx, id, readings)
+		if err != nil {
+			ctx.JSON(web.DecodeError(readingStatus(err, id), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusCreated, created))
+	}
+}
+
+// History returns the readings of a section summarized by interval.
+func (s *SectionReadings) History() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id, _ := strconv.Atoi(ctx.Param("id"))
+
+		samples, err := s.service.History(ctx, id, sectionreading.HistoryFilter{From: ctx.Query("from"),
+			To: ctx.Query("to"), Interval: ctx.Query("interval")})
+		if err != nil {
+			ctx.JSON(web.DecodeError(readingStatus(err, id), err.Error()))
+			return
+		}
+
+		ctx.JSON(web.NewResponse(http.StatusOK, samples))
+	}
+}
+
+func readingStatus(err error, id int) int {
+	switch err.Error() {
+	case fmt.Sprintf(sectionreading.ERR_SECTION_NOT_FOUND, id):
+		return http.StatusNotFound
+	case sectionreading.ERR_INVALID_DATE, sectionreading.ERR_INVALID_RANGE, sectionreading.ERR_INVALID_INTERVAL:
+		return http.StatusBadRequest
+	case sectionreading.ERR_NO_READINGS, fmt.Sprintf(sectionreading.ERR_TOO_MANY_READINGS, sectionreading.MAX_READINGS):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,139 @@
+package section_readings_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/section_readings"
+	sectionreading "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section_reading"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section_reading/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/stretchr/testify/mock"
+)
+
+const URL_READINGS = "/api/v1/sections/:id/readings"
+
+type ExpectedJSON struct {
+	Code int         `json:"code"`
+	Data interface{} `json:"data"`
+}
+
+type ExpectedErrorJSON struct {
+	Code  int    `json:"code"`
+	Error string `json:"error"`
+}
+
+func InitTest(t *testing.T) (*gin.Engine, *mocks.Repository) {
+	mockRepository := mocks.NewRepository(t)
+	handler := section_readings.NewSectionReadings(sectionreading.NewService(mockRepository))
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+	engine.POST(URL_READINGS, handler.Record())
+	engine.GET(URL_READINGS, handler.History())
+
+	return engine, mockRepository
+}
+
+func InitServer(method string, url string, body []byte) (*http.Request, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, url, bytes.NewBuffer(body))
+	req.Header.Add("Content-Type", "application/json")
+
+	return req, httptest.NewRecorder()
+}
+
+func TestRecord(t *testing.T) {
+	engine, mockRepository := InitTest(t)
+
+	t.Run("record_ok", func(t *testing.T) {
+		readings := []sectionreading.Reading{{Temperature: -18.5, RecordedAt: "2026-10-19 10:00:00"}}
+		exp := []sectionreading.Reading{{ID: 1, SectionID: 3, Temperature: -18.5, RecordedAt: "2026-10-19 10:00:00"}}
+		mockRepository.On("Create", mock.Anything, 3, readings).Return(exp, nil).Once()
+
+		req, w := InitServer(http.MethodPost, "/api/v1/sections/3/readings",
+			[]byte(`{"readings": [{"temperature": -18.5, "recorded_at": "2026-10-19 10:00:00"}]}`))
+		engine.ServeHTTP(w, req)
+
+		expected := ExpectedJSON{201, exp}
+		expJSON, _ := json.Marshal(expected)
+
+		assert.Equal(t, expected.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("record_section_not_found", func(t *testing.T) {
+		mockRepository.On("Create", mock.Anything, 9, mock.Anything).
+			Return([]sectionreading.Reading{}, fmt.Errorf(sectionreading.ERR_SECTION_NOT_FOUND, 9)).Once()
+
+		req, w := InitServer(http.MethodPost, "/api/v1/sections/9/readings", []byte(`{"readings": [{"temperature": 4}]}`))
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{404, "section with id (9) not found"}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("record_missing_temperature", func(t *testing.T) {
+		req, w := InitServer(http.MethodPost, "/api/v1/sections/3/readings", []byte(`{"readings": [{"recorded_at": "2026-10-19 10:00:00"}]}`))
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("record_no_readings", func(t *testing.T) {
+		req, w := InitServer(http.MethodPost, "/api/v1/sections/3/readings", []byte(`{"readings": []}`))
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{422, sectionreading.ERR_NO_READINGS}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+}
+
+func TestHistory(t *testing.T) {
+	engine, mockRepository := InitTest(t)
+
+	t.Run("history_ok", func(t *testing.T) {
+		exp := []sectionreading.Sample{{From: "2026-10-19 10:00:00", Readings: 12, MinTemperature: -19, MaxTemperature: -17, AvgTemperature: -18.2}}
+		mockRepository.On("SectionExists", mock.Anything, 3).Return(true, nil).Once()
+		mockRepository.On("History", mock.Anything, 3, "2026-10-19 00:00:00", "2026-10-19 23:59:59", 900).Return(exp, nil).Once()
+
+		req, w := InitServer(http.MethodGet, "/api/v1/sections/3/readings?from=2026-10-19&to=2026-10-19&interval=15m", nil)
+		engine.ServeHTTP(w, req)
+
+		expected := ExpectedJSON{200, exp}
+		expJSON, _ := json.Marshal(expected)
+
+		assert.Equal(t, expected.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("history_invalid_interval", func(t *testing.T) {
+		req, w := InitServer(http.MethodGet, "/api/v1/sections/3/readings?interval=often", nil)
+		engine.ServeHTTP(w, req)
+
+		exp := ExpectedErrorJSON{400, sectionreading.ERR_INVALID_INTERVAL}
+		expJSON, _ := json.Marshal(exp)
+
+		assert.Equal(t, exp.Code, w.Code)
+		assert.Equal(t, string(expJSON), w.Body.String())
+	})
+
+	t.Run("history_fail_db", func(t *testing.T) {
+		mockRepository.On("SectionExists", mock.Anything, 3).Return(false, errors.New("sql: connection failed")).Once()
+
+		req, w := InitServer(http.MethodGet, "/api/v1/sections/3/readings", nil)
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
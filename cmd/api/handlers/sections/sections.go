@@ -1,24 +1,31 @@
 package sections
 
 import (
+	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
+const ERR_CURRENT_CAPACITY_READ_ONLY = "current_capacity é somente leitura, ela acompanha os product batches da seção"
+
+// sectionRequest holds the temperatures as pointers, so required only
+// rejects them when missing and 0 °C stays a valid temperature.
 type sectionRequest struct {
-	ID             int `json:"id"`
-	SectionNumber  int `json:"section_number" binding:"required"`
-	CurTemperature int `json:"current_temperature" binding:"required"`
-	MinTemperature int `json:"minimum_temperature" binding:"required"`
-	CurCapacity    int `json:"current_capacity" binding:"required"`
-	MinCapacity    int `json:"minimum_capacity" binding:"required"`
-	MaxCapacity    int `json:"maximum_capacity" binding:"required"`
-	WareHouseID    int `json:"warehouse_id" binding:"required"`
-	ProductTypeID  int `json:"product_type_id" binding:"required"`
+	ID             int  `json:"id"`
+	SectionNumber  int  `json:"section_number" binding:"required"`
+	CurTemperature *int `json:"current_temperature" binding:"required"`
+	MinTemperature *int `json:"minimum_temperature" binding:"required"`
+	CurCapacity    int  `json:"current_capacity" binding:"gte=0"`
+	MinCapacity    int  `json:"minimum_capacity" binding:"required"`
+	MaxCapacity    int  `json:"maximum_capacity" binding:"required"`
+	WareHouseID    int  `json:"warehouse_id" binding:"required"`
+	ProductTypeID  int  `json:"product_type_id" binding:"required"`
 }
 
 type Section struct {
@@ -72,8 +79,14 @@ func (p *Section) CreateSection() gin.HandlerFunc {
 			return
 		}
 
-		sec, err := p.service.Create(req.SectionNumber, req.CurTemperature, req.MinTemperature,
-			req.CurCapacity, req.MinCapacity, req.MaxCapacity, req.WareHouseID, req.ProductTypeID)
+		// New sections start empty; their product batches fill them.
+		if req.CurCapacity != 0 {
+			c.JSON(web.DecodeError(http.StatusUnprocessableEntity, ERR_CURRENT_CAPACITY_READ_ONLY))
+			return
+		}
+
+		sec, err := p.service.Create(req.SectionNumber, *req.CurTemperature, *req.MinTemperature,
+			0, req.MinCapacity, req.MaxCapacity, req.WareHouseID, req.ProductTypeID)
 		if err != nil {
 			c.JSON(web.DecodeError(http.StatusConflict, err.Error()))
 			return
@@ -83,19 +96,45 @@ func (p *Section) CreateSection() gin.HandlerFunc {
 	}
 }
 
-func (p *Section) UpdateSecID() gin.HandlerFunc {
+func (p *Section) UpdateSection() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		id, _ := strconv.Atoi(c.Param("id"))
+
+		sec, err := p.service.GetByID(id)
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+			return
+		}
+
+		patch, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+			return
+		}
+
 		var req sectionRequest
-		if err := c.ShouldBind(&req); err != nil {
+		if err := mergepatch.Apply(sec, patch, &req); err != nil {
 			c.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
 			return
 		}
 
-		id, _ := strconv.Atoi(c.Param("id"))
+		if err := binding.Validator.ValidateStruct(req); err != nil {
+			c.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+			return
+		}
+
+		// The current capacity follows the product batches of the section.
+		if req.CurCapacity != sec.CurCapacity {
+			c.JSON(web.DecodeError(http.StatusUnprocessableEntity, ERR_CURRENT_CAPACITY_READ_ONLY))
+			return
+		}
 
-		sec, err := p.service.UpdateSecID(id, req.SectionNumber)
-		if err.Code != 200 {
-			c.JSON(web.DecodeError(err.Code, err.Message.Error()))
+		sec, erro := p.service.Update(section.Section{ID: id, SectionNumber: req.SectionNumber,
+			CurTemperature: *req.CurTemperature, MinTemperature: *req.MinTemperature, CurCapacity: req.CurCapacity,
+			MinCapacity: req.MinCapacity, MaxCapacity: req.MaxCapacity, WareHouseID: req.WareHouseID,
+			ProductTypeID: req.ProductTypeID})
+		if erro.Code != 200 {
+			c.JSON(web.DecodeError(erro.Code, erro.Message.Error()))
 			return
 		}
 
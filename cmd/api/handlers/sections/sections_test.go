@@ -121,6 +121,7 @@ func TestSectionCreate(t *testing.T) {
 	router.POST(URL_SECTIONS, sec.CreateSection())
 	secs := createSectionArray()
 	exp := secs[0]
+	exp.CurCapacity = 0
 	secs = append([]section.Section{}, secs[1:]...)
 
 	t.Run("create_ok", func(t *testing.T) {
@@ -140,6 +141,7 @@ func TestSectionCreate(t *testing.T) {
 
 	t.Run("create_conflict", func(t *testing.T) {
 		exp = secs[0]
+		exp.CurCapacity = 0
 		mockRepository.On("GetAll").Return(secs)
 
 		expJSON, _ := json.Marshal(exp)
@@ -150,6 +152,18 @@ func TestSectionCreate(t *testing.T) {
 		assert.Equal(t, "{\"code\":409,\"error\":\"seção com sectionNumber: 20 já existe no banco de dados\"}", w.Body.String())
 	})
 
+	t.Run("create_current_capacity", func(t *testing.T) {
+		filled := exp
+		filled.CurCapacity = 20
+
+		expJSON, _ := json.Marshal(filled)
+		req, w := InitServer(http.MethodPost, URL_SECTIONS, expJSON)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, 422, w.Code)
+		assert.Equal(t, "{\"code\":422,\"error\":\""+sections.ERR_CURRENT_CAPACITY_READ_ONLY+"\"}", w.Body.String())
+	})
+
 	t.Run("create_fail", func(t *testing.T) {
 		exp.ProductTypeID = 0
 
@@ -163,22 +177,22 @@ func TestSectionCreate(t *testing.T) {
 	})
 }
 
-func TestSectionUpdateSecID(t *testing.T) {
+func TestSectionUpdate(t *testing.T) {
 	router, mockRepository, sec := InitTest(t)
-	router.PATCH(URL_SECTIONS+":id", sec.UpdateSecID())
+	router.PATCH(URL_SECTIONS+":id", sec.UpdateSection())
 
 	secs := createSectionArray()
 	exp := secs[0]
 
 	mockRepository.On("GetAll").Return(secs, nil)
+	mockRepository.On("GetByID", 1).Return(secs[0], nil)
 
 	t.Run("update_ok", func(t *testing.T) {
 		exp.SectionNumber = 50
 
-		mockRepository.On("UpdateSecID", 1, exp.SectionNumber).Return(exp, section.CodeError{Code: 200, Message: nil})
+		mockRepository.On("Update", exp).Return(exp, section.CodeError{Code: 200, Message: nil})
 
-		expected, _ := json.Marshal(exp)
-		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", expected)
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", []byte(`{"section_number": 50}`))
 		router.ServeHTTP(w, req)
 
 		expJSON := ExpectedJSON{200, exp}
@@ -188,42 +202,103 @@ func TestSectionUpdateSecID(t *testing.T) {
 		assert.Equal(t, string(expectedJSON), w.Body.String())
 	})
 
-	t.Run("update_non_existent", func(t *testing.T) {
-		exp.SectionNumber = 50
+	t.Run("update_ignores_id", func(t *testing.T) {
+		exp.SectionNumber = 60
 
-		mockRepository.On("UpdateSecID", 99, exp.SectionNumber).Return(section.Section{},
-			section.CodeError{Code: 404, Message: errors.New("seção 99 não encontrada")})
+		mockRepository.On("Update", exp).Return(exp, section.CodeError{Code: 200, Message: nil})
 
-		expJSON, _ := json.Marshal(exp)
-		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"99", expJSON)
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", []byte(`{"id": 2, "section_number": 60}`))
+		router.ServeHTTP(w, req)
+
+		expJSON := ExpectedJSON{200, exp}
+		expectedJSON, _ := json.Marshal(expJSON)
+
+		assert.Equal(t, expJSON.Code, w.Code)
+		assert.Equal(t, string(expectedJSON), w.Body.String())
+	})
+
+	t.Run("update_non_existent", func(t *testing.T) {
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"99", []byte(`{"section_number": 50}`))
 		router.ServeHTTP(w, req)
 
 		assert.Equal(t, 404, w.Code)
-		assert.Equal(t, "{\"code\":404,\"error\":\"seção 99 não encontrada\"}", w.Body.String())
+		assert.Equal(t, "{\"code\":404,\"error\":\"seção com id: 99 não existe no banco de dados\"}", w.Body.String())
 	})
 
 	t.Run("update_conflict", func(t *testing.T) {
-		exp.SectionNumber = 40
-
-		expJSON, _ := json.Marshal(exp)
-		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", expJSON)
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", []byte(`{"section_number": 20}`))
 		router.ServeHTTP(w, req)
 
 		assert.Equal(t, 409, w.Code)
-		assert.Equal(t, "{\"code\":409,\"error\":\"seção com section_number: 40 já existe no banco de dados\"}", w.Body.String())
+		assert.Equal(t, "{\"code\":409,\"error\":\"seção com section_number: 20 já existe no banco de dados\"}", w.Body.String())
 	})
 
 	t.Run("update_fail", func(t *testing.T) {
-		exp.SectionNumber = 0
-
-		expJSON, _ := json.Marshal(exp)
-		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", expJSON)
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", []byte(`{"section_number": null}`))
 		router.ServeHTTP(w, req)
 
 		assert.Equal(t, 422, w.Code)
 		assert.Equal(t, "{\"code\":422,\"error\":\"Key: 'sectionRequest.SectionNumber'"+
 			" Error:Field validation for 'SectionNumber' failed on the 'required' tag\"}", w.Body.String())
 	})
+
+	t.Run("update_current_capacity", func(t *testing.T) {
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", []byte(`{"current_capacity": 0}`))
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, 422, w.Code)
+		assert.Equal(t, "{\"code\":422,\"error\":\""+sections.ERR_CURRENT_CAPACITY_READ_ONLY+"\"}", w.Body.String())
+	})
+
+	t.Run("update_maximum_below_current_capacity", func(t *testing.T) {
+		changes := secs[0]
+		changes.MaxCapacity = 10
+
+		mockRepository.On("Update", changes).Return(section.Section{},
+			section.CodeError{Code: 409, Message: errors.New("maximum_capacity não pode ser menor que a current_capacity: 20")})
+
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", []byte(`{"maximum_capacity": 10}`))
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, 409, w.Code)
+	})
+
+	t.Run("update_zero_temperature_section", func(t *testing.T) {
+		router, mockRepository, sec := InitTest(t)
+		router.PATCH(URL_SECTIONS+":id", sec.UpdateSection())
+
+		cold := createSectionArray()[0]
+		cold.CurTemperature, cold.MinTemperature = 0, 0
+		changes := cold
+		changes.SectionNumber = 71
+
+		mockRepository.On("GetAll").Return([]section.Section{cold}, nil)
+		mockRepository.On("GetByID", 1).Return(cold, nil)
+		mockRepository.On("Update", changes).Return(changes, section.CodeError{Code: 200, Message: nil})
+
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", []byte(`{"section_number": 71}`))
+		router.ServeHTTP(w, req)
+
+		expectedJSON, _ := json.Marshal(ExpectedJSON{200, changes})
+
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, string(expectedJSON), w.Body.String())
+	})
+
+	t.Run("update_remove_temperature", func(t *testing.T) {
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", []byte(`{"current_temperature": null}`))
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, 422, w.Code)
+	})
+
+	t.Run("update_invalid_patch", func(t *testing.T) {
+		req, w := InitServer(http.MethodPatch, URL_SECTIONS+"1", []byte(`[1, 2]`))
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, 422, w.Code)
+		assert.Equal(t, "{\"code\":422,\"error\":\"the patch must be a valid JSON object\"}", w.Body.String())
+	})
 }
 
 func TestSectionDelete(t *testing.T) {
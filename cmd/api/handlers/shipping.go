@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipping"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type requestQuoteItem struct {
+	ProductID int `json:"product_id" binding:"required"`
+	Quantity  int `json:"quantity" binding:"required,gt=0"`
+}
+
+type requestQuote struct {
+	LocalityID  int                `json:"locality_id" binding:"required"`
+	WarehouseID int                `json:"warehouse_id"`
+	Items       []requestQuoteItem `json:"items" binding:"required,min=1,dive"`
+}
+
+type Shipping struct {
+	service shipping.Service
+}
+
+func NewShipping(s shipping.Service) *Shipping {
+	return &Shipping{service: s}
+}
+
+// Quote answers with the price of delivering the items with every carry that
+// serves the locality.
+func (s *Shipping) Quote(ctx *gin.Context) {
+	var req requestQuote
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	quoteRequest := shipping.QuoteRequest{LocalityID: req.LocalityID, WarehouseID: req.WarehouseID}
+
+	for _, item := range req.Items {
+		quoteRequest.Items = append(quoteRequest.Items, shipping.QuoteItem{ProductID: item.ProductID, Quantity: item.Quantity})
+	}
+
+	quote, err := s.service.Quote(ctx, quoteRequest)
+
+	if err != nil {
+		switch err.Error() {
+		case shipping.ERR_PRODUCT_NOT_FOUND, shipping.ERR_WAREHOUSE_NOT_FOUND, locality.ERR_LOCALITY_NOT_FOUND:
+			ctx.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		case shipping.ERR_NO_ITEMS, shipping.ERR_INVALID_QUANTITY, shipping.ERR_NO_WAREHOUSE, locality.ERR_LOCALITY_NOT_LOCATED:
+			ctx.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		default:
+			ctx.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	ctx.JSON(web.NewResponse(http.StatusOK, quote))
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipping"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipping/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	URL_SHIPPING = "/api/v1/shipping/"
+)
+
+func initShippingServer(t *testing.T) (*gin.Engine, *mocks.Service) {
+	mockService := mocks.NewService(t)
+	handlerShipping := NewShipping(mockService)
+
+	server := gin.Default()
+	shippingGroup := server.Group(URL_SHIPPING)
+	shippingGroup.POST("/quote", handlerShipping.Quote)
+
+	return server, mockService
+}
+
+func TestShipping_Quote(t *testing.T) {
+	body := `{"locality_id": 2, "warehouse_id": 5, "items": [{"product_id": 1, "quantity": 4}]}`
+	request := shipping.QuoteRequest{LocalityID: 2, WarehouseID: 5, Items: []shipping.QuoteItem{{ProductID: 1, Quantity: 4}}}
+
+	t.Run("Deve retornar status 200 com as opções de frete", func(t *testing.T) {
+		server, mockService := initShippingServer(t)
+		quote := shipping.Quote{WarehouseID: 5, LocalityID: 2, DistanceKm: 84.1, WeightKg: 2,
+			Options: []shipping.Option{{CarryID: 1, Cid: "CHEAP", CompanyName: "Cheap", Price: 15.41}}}
+		mockService.On("Quote", mock.Anything, request).Return(quote, nil)
+
+		req, rr := createRequestTest(http.MethodPost, URL_SHIPPING+"quote", body)
+		server.ServeHTTP(rr, req)
+
+		var resp struct {
+			Data shipping.Quote `json:"data"`
+		}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+
+		assert.Equal(t, 200, rr.Code)
+		assert.Equal(t, quote, resp.Data)
+	})
+
+	t.Run("Deve retornar status 422 sem itens", func(t *testing.T) {
+		server, _ := initShippingServer(t)
+
+		req, rr := createRequestTest(http.MethodPost, URL_SHIPPING+"quote", `{"locality_id": 2, "items": []}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 422 com quantidade inválida", func(t *testing.T) {
+		server, _ := initShippingServer(t)
+
+		req, rr := createRequestTest(http.MethodPost, URL_SHIPPING+"quote", `{"locality_id": 2, "items": [{"product_id": 1, "quantity": 0}]}`)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 404 quando o produto não existir", func(t *testing.T) {
+		server, mockService := initShippingServer(t)
+		mockService.On("Quote", mock.Anything, request).Return(shipping.Quote{}, fmt.Errorf(shipping.ERR_PRODUCT_NOT_FOUND))
+
+		req, rr := createRequestTest(http.MethodPost, URL_SHIPPING+"quote", body)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 404, rr.Code)
+	})
+
+	t.Run("Deve retornar status 422 quando a locality não tiver coordenadas", func(t *testing.T) {
+		server, mockService := initShippingServer(t)
+		mockService.On("Quote", mock.Anything, request).Return(shipping.Quote{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_LOCATED))
+
+		req, rr := createRequestTest(http.MethodPost, URL_SHIPPING+"quote", body)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 422, rr.Code)
+	})
+
+	t.Run("Deve retornar status 500", func(t *testing.T) {
+		server, mockService := initShippingServer(t)
+		mockService.On("Quote", mock.Anything, request).Return(shipping.Quote{}, fmt.Errorf("error"))
+
+		req, rr := createRequestTest(http.MethodPost, URL_SHIPPING+"quote", body)
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, 500, rr.Code)
+	})
+}
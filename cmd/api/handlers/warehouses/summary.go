@@ -0,0 +1,46 @@
+package warehouses
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type WarehouseSummary struct {
+	service usecases.SummaryService
+}
+
+func NewWarehouseSummary(s usecases.SummaryService) WarehouseSummary {
+	return WarehouseSummary{s}
+}
+
+// Summary lists the capacity of the sections of a warehouse, the stock of
+// their batches, its employees and the inbound orders it received between
+// the from and to queries (the last 30 days by default).
+func (w WarehouseSummary) Summary(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+
+	if err != nil {
+		c.JSON(web.DecodeError(http.StatusBadRequest, "O id passado não é um número!"))
+		return
+	}
+
+	summary, err := w.service.Summary(c.Request.Context(), id, c.Query("from"), c.Query("to"))
+
+	if err != nil {
+		switch err.Error() {
+		case usecases.ERR_WAREHOUSE_NOT_FOUND:
+			c.JSON(web.DecodeError(http.StatusNotFound, "O warehouse não foi encontrado!"))
+		case usecases.ERR_INVALID_DATE, usecases.ERR_INVALID_PERIOD:
+			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+		default:
+			c.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusOK, summary))
+}
@@ -0,0 +1,101 @@
+package warehouses_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/warehouses"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases/mock/mock_summary_service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_WarehouseSummary(t *testing.T) {
+
+	service := mock_summary_service.NewSummaryService(t)
+	controller := warehouses.NewWarehouseSummary(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.GET(URLwarehouses+"/:id/summary", controller.Summary)
+
+	t.Run("Deve retornar um status code 200 com o resumo do warehouse.", func(t *testing.T) {
+
+		summary := domain.Summary{
+			Warehouse: makeValidDBWarehouse(),
+			From:      "2026-10-01",
+			To:        "2026-10-19",
+			Sections:  domain.SectionsSummary{Count: 2, TotalCapacity: 800, UsedCapacity: 200, Utilization: 25},
+			Employees: 4,
+		}
+
+		service.On("Summary", mock.Anything, 1, "2026-10-01", "2026-10-19").Return(summary, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/1/summary?from=2026-10-01&to=2026-10-19", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "\"utilization_percentage\":25")
+		assert.Contains(t, rr.Body.String(), "\"employees\":4")
+	})
+
+	t.Run("Deve retornar um status code 400, se o id não for um número.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/abc/summary", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 400, se as datas forem inválidas.", func(t *testing.T) {
+
+		service.On("Summary", mock.Anything, 1, "ontem", "").Return(domain.Summary{}, errors.New(usecases.ERR_INVALID_DATE)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/1/summary?from=ontem", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 404, se o warehouse não existir.", func(t *testing.T) {
+
+		service.On("Summary", mock.Anything, 9, "", "").Return(domain.Summary{}, errors.New(usecases.ERR_WAREHOUSE_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/9/summary", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 500, se a consulta falhar.", func(t *testing.T) {
+
+		service.On("Summary", mock.Anything, 1, "", "").Return(domain.Summary{}, errors.New("erro ao executar a query")).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/1/summary", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
@@ -1,12 +1,17 @@
 package warehouses
 
 import (
+	"io"
 	"net/http"
 	"strconv"
 
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 )
 
 type requestWarehouse struct {
@@ -17,14 +22,6 @@ type requestWarehouse struct {
 	LocalityID    int    `json:"locality_id" binding:"required"`
 }
 
-type requestPatchWarehouse struct {
-	ID            int    `json:"id"`
-	WarehouseCode string `json:"warehouse_code" binding:"required"`
-	Address       string `json:"address"`
-	Telephone     string `json:"telephone"`
-	LocalityID    int    `json:"locality_id"`
-}
-
 type Warehouse struct {
 	service usecases.Service
 }
@@ -57,6 +54,44 @@ func (w Warehouse) GetByID(c *gin.Context) {
 	c.JSON(web.NewResponse(http.StatusOK, warehouse))
 }
 
+// NearestWarehouses lists the warehouses closest to the locality_id query,
+// limited to limit (1 by default).
+func (w Warehouse) NearestWarehouses(c *gin.Context) {
+	localityID, err := strconv.Atoi(c.Query("locality_id"))
+
+	if err != nil {
+		c.JSON(web.DecodeError(http.StatusBadRequest, "O locality_id passado não é um número!"))
+		return
+	}
+
+	limit := 1
+
+	if c.Query("limit") != "" {
+		limit, err = strconv.Atoi(c.Query("limit"))
+
+		if err != nil || limit < 1 {
+			c.JSON(web.DecodeError(http.StatusBadRequest, "O limit deve ser um número maior que zero!"))
+			return
+		}
+	}
+
+	nearest, err := w.service.NearestWarehouses(c.Request.Context(), localityID, limit)
+
+	if err != nil {
+		switch err.Error() {
+		case locality.ERR_LOCALITY_NOT_FOUND:
+			c.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		case locality.ERR_LOCALITY_NOT_LOCATED:
+			c.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		default:
+			c.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusOK, nearest))
+}
+
 func (w Warehouse) CreateWarehouse(c *gin.Context) {
 	var req requestWarehouse
 
@@ -76,25 +111,50 @@ func (w Warehouse) CreateWarehouse(c *gin.Context) {
 	c.JSON(web.NewResponse(http.StatusCreated, warehouse))
 }
 
-func (w Warehouse) UpdatedWarehouseID(c *gin.Context) {
-	var req requestPatchWarehouse
+func (w Warehouse) UpdateWarehouse(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
 
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+	if err != nil {
+		c.JSON(web.DecodeError(http.StatusBadRequest, "O id passado não é um número!"))
 		return
 	}
 
-	id, err := strconv.Atoi(c.Param("id"))
+	warehouse, err := w.service.GetByID(id)
 
 	if err != nil {
-		c.JSON(web.DecodeError(http.StatusBadRequest, "O id passado não é um número!"))
+		c.JSON(web.DecodeError(http.StatusNotFound, "O warehouse não foi encontrado!"))
 		return
 	}
 
-	warehouse, err := w.service.UpdatedWarehouseID(id, req.WarehouseCode)
+	patch, err := io.ReadAll(c.Request.Body)
 
 	if err != nil {
-		c.JSON(web.DecodeError(http.StatusNotFound, err.Error()))
+		c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	var req requestWarehouse
+
+	if err := mergepatch.Apply(warehouse, patch, &req); err != nil {
+		c.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		c.JSON(web.DecodeError(http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	warehouse, err = w.service.UpdateWarehouse(domain.Warehouse{
+		ID:            id,
+		WarehouseCode: req.WarehouseCode,
+		Address:       req.Address,
+		Telephone:     req.Telephone,
+		LocalityID:    req.LocalityID,
+	})
+
+	if err != nil {
+		c.JSON(web.DecodeError(http.StatusConflict, err.Error()))
 		return
 	}
 
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/warehouses"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases/mock/mock_service"
 
@@ -214,7 +215,7 @@ func Test_GetByID(t *testing.T) {
 	})
 }
 
-func Test_UpdatedWarehouseID(t *testing.T) {
+func Test_UpdateWarehouse(t *testing.T) {
 
 	service := mock_service.NewService(t)
 	controller := warehouses.NewWarehouse(service)
@@ -222,17 +223,20 @@ func Test_UpdatedWarehouseID(t *testing.T) {
 
 	gin.SetMode(gin.TestMode)
 
-	server.PATCH(URLwarehouses+"/:id", controller.UpdatedWarehouseID)
+	server.PATCH(URLwarehouses+"/:id", controller.UpdateWarehouse)
 
 	t.Run("Deve retornar um status code 200, e o Warehouse atualizado, quando a solicitação for bem sucedida.", func(t *testing.T) {
 
 		data := makeValidDBWarehouse()
+		data.ID = 1
 
-		service.On("UpdatedWarehouseID", mock.AnythingOfType("int"), mock.AnythingOfType("string")).Return(data, nil).Once()
+		expected := data
+		expected.Address = "Rua das Rosas"
 
-		dataJSON, _ := json.Marshal(data)
+		service.On("GetByID", 1).Return(data, nil).Once()
+		service.On("UpdateWarehouse", expected).Return(expected, nil).Once()
 
-		body := strings.NewReader(string(dataJSON))
+		body := strings.NewReader(`{"address": "Rua das Rosas"}`)
 
 		rr := httptest.NewRecorder()
 
@@ -245,15 +249,20 @@ func Test_UpdatedWarehouseID(t *testing.T) {
 		json.Unmarshal(rr.Body.Bytes(), &respBody)
 
 		assert.Equal(t, http.StatusOK, rr.Code)
-		assert.Equal(t, data, respBody.Data)
+		assert.Equal(t, expected, respBody.Data)
 		assert.Empty(t, respBody.Error)
 	})
 
-	t.Run("Deve retornar um status code 422, se o objeto JSON não contiver os campos necessários", func(t *testing.T) {
+	t.Run("Deve retornar um status code 422, se o Warehouse resultante não contiver os campos necessários", func(t *testing.T) {
+
+		data := makeValidDBWarehouse()
+		data.ID = 1
+
+		service.On("GetByID", 1).Return(data, nil).Once()
 
 		invalidBody := bytes.NewBuffer([]byte(`
 		{
-			"minimum_capacity": 10,
+			"warehouse_code": null,
 			"minimum_temperature": 8
 		}
 		`))
@@ -265,20 +274,28 @@ func Test_UpdatedWarehouseID(t *testing.T) {
 		server.ServeHTTP(rr, req)
 
 		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
-		assert.Contains(t, rr.Body.String(), "\"error\":")
+		assert.Contains(t, rr.Body.String(), "'WarehouseCode' failed on the 'required' tag")
 	})
 
-	t.Run("Deve retornar um código 400, e uma mensagem de erro, quando o id passado não for um número.", func(t *testing.T) {
+	t.Run("Deve retornar um status code 422, se o patch não for um objeto JSON.", func(t *testing.T) {
 
-		data := makeValidDBWarehouse()
+		service.On("GetByID", 1).Return(makeValidDBWarehouse(), nil).Once()
 
-		dataJSON, _ := json.Marshal(data)
+		rr := httptest.NewRecorder()
 
-		body := strings.NewReader(string(dataJSON))
+		req, _ := http.NewRequest(http.MethodPatch, URLwarehouses+"/1", strings.NewReader(`"j753"`))
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Contains(t, rr.Body.String(), "the patch must be a valid JSON object")
+	})
+
+	t.Run("Deve retornar um código 400, e uma mensagem de erro, quando o id passado não for um número.", func(t *testing.T) {
 
 		rr := httptest.NewRecorder()
 
-		req, _ := http.NewRequest(http.MethodPatch, URLwarehouses+"/casa", body)
+		req, _ := http.NewRequest(http.MethodPatch, URLwarehouses+"/casa", strings.NewReader(`{"address": "Rua das Rosas"}`))
 
 		server.ServeHTTP(rr, req)
 
@@ -288,26 +305,38 @@ func Test_UpdatedWarehouseID(t *testing.T) {
 
 	t.Run("Deve retornar um código 404, se o Warehouse a ser atualizado não existir.", func(t *testing.T) {
 
-		data := makeValidDBWarehouse()
-
-		service.On("UpdatedWarehouseID", mock.AnythingOfType("int"), mock.AnythingOfType("string")).Return(domain.Warehouse{}, fmt.Errorf("o id: %d informado não existe", 1)).Once()
-
-		dataJSON, _ := json.Marshal(data)
-
-		body := strings.NewReader(string(dataJSON))
+		service.On("GetByID", 1).Return(domain.Warehouse{}, fmt.Errorf("o id: %d não foi encontrado", 1)).Once()
 
 		rr := httptest.NewRecorder()
 
-		req, _ := http.NewRequest(http.MethodPatch, URLwarehouses+"/1", body)
+		req, _ := http.NewRequest(http.MethodPatch, URLwarehouses+"/1", strings.NewReader(`{"address": "Rua das Rosas"}`))
+
+		server.ServeHTTP(rr, req)
 
 		respBody := warehouseResponseBody{}
 
 		json.Unmarshal(rr.Body.Bytes(), &respBody)
 
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		assert.Equal(t, "O warehouse não foi encontrado!", respBody.Error)
+	})
+
+	t.Run("Deve retornar um código 409, se `warehouse_code` já estiver em uso.", func(t *testing.T) {
+
+		data := makeValidDBWarehouse()
+		data.ID = 1
+
+		service.On("GetByID", 1).Return(data, nil).Once()
+		service.On("UpdateWarehouse", mock.AnythingOfType("domain.Warehouse")).Return(domain.Warehouse{}, errors.New("o `warehouse_code` já está em uso")).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodPatch, URLwarehouses+"/1", strings.NewReader(`{"warehouse_code": "k951"}`))
+
 		server.ServeHTTP(rr, req)
 
-		assert.Equal(t, http.StatusNotFound, rr.Code)
-		assert.Contains(t, "o id: 1 informado não existe", respBody.Error)
+		assert.Equal(t, http.StatusConflict, rr.Code)
+		assert.Contains(t, rr.Body.String(), "o `warehouse_code` já está em uso")
 	})
 }
 
@@ -361,3 +390,91 @@ func Test_DeleteWarehouse(t *testing.T) {
 		assert.Empty(t, rr.Body.String())
 	})
 }
+
+func Test_NearestWarehouses(t *testing.T) {
+
+	service := mock_service.NewService(t)
+	controller := warehouses.NewWarehouse(service)
+	server := gin.Default()
+
+	gin.SetMode(gin.TestMode)
+
+	server.GET(URLwarehouses+"/nearest", controller.NearestWarehouses)
+
+	t.Run("Deve retornar um status code 200 com os warehouses mais próximos.", func(t *testing.T) {
+
+		nearest := []domain.NearestWarehouse{{Warehouse: makeValidDBWarehouse(), DistanceKm: 14.3}}
+
+		service.On("NearestWarehouses", mock.Anything, 1, 3).Return(nearest, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/nearest?locality_id=1&limit=3", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "\"distance_km\":14.3")
+	})
+
+	t.Run("Deve usar o limit 1 quando ele não for informado.", func(t *testing.T) {
+
+		service.On("NearestWarehouses", mock.Anything, 1, 1).Return([]domain.NearestWarehouse{}, nil).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/nearest?locality_id=1", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 400, se o locality_id não for um número.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/nearest?locality_id=abc", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 400, se o limit for inválido.", func(t *testing.T) {
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/nearest?locality_id=1&limit=0", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 404, se a locality não existir.", func(t *testing.T) {
+
+		service.On("NearestWarehouses", mock.Anything, 9, 1).Return(nil, errors.New(locality.ERR_LOCALITY_NOT_FOUND)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/nearest?locality_id=9", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Deve retornar um status code 422, se a locality não tiver coordenadas.", func(t *testing.T) {
+
+		service.On("NearestWarehouses", mock.Anything, 2, 1).Return(nil, errors.New(locality.ERR_LOCALITY_NOT_LOCATED)).Once()
+
+		rr := httptest.NewRecorder()
+
+		req, _ := http.NewRequest(http.MethodGet, URLwarehouses+"/nearest?locality_id=2", nil)
+
+		server.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+}
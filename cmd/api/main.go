@@ -1,11 +1,19 @@
 package main
 
 import (
+	"context"
+	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/routes"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/docs"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert"
+	productbatch "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -29,6 +37,11 @@ func main() {
 
 	godotenv.Load(".env")
 
+	// The background jobs and the server run until the process is asked to
+	// stop.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	gin.SetMode("release")
 
 	server := gin.Default()
@@ -40,29 +53,85 @@ func main() {
 
 	baseRoute := server.Group("/api/v1/")
 	{
-		localityService := routes.Localities(baseRoute)
-		sellerService := routes.Sellers(baseRoute, localityService)
-		productsService := routes.Products(baseRoute, sellerService)
+		auditService := routes.Audit(baseRoute)
+
+		countryService := routes.Countries(baseRoute, auditService)
+		provinceService := routes.Provinces(baseRoute, countryService, auditService)
+		localityService := routes.Localities(baseRoute, provinceService, auditService)
+		sellerService := routes.Sellers(baseRoute, localityService, auditService)
+		productsService := routes.Products(baseRoute, sellerService, auditService)
+
+		routes.ProductRecord(baseRoute, productsService, auditService)
+
+		routes.ProductTypes(baseRoute, auditService)
+
+		routes.Buyers(baseRoute, auditService)
+
+		carryService := routes.Carry(baseRoute, localityService, provinceService, auditService)
+
+		reservationService := routes.PurchaseOrders(baseRoute, carryService, auditService)
+
+		// RESERVATION_EXPIRY_INTERVAL is how often the expired stock holds
+		// are released, a minute when it isn't set.
+		go reservation.RunExpiryJob(ctx, reservationService, interval("RESERVATION_EXPIRY_INTERVAL", time.Minute))
+
+		routes.Sections(baseRoute, auditService)
 
-		routes.ProductRecord(baseRoute, productsService)
+		routes.SectionReadings(baseRoute)
 
-		routes.Buyers(baseRoute)
+		alertService := routes.Alerts(baseRoute, auditService)
 
-		routes.PurchaseOrders(baseRoute)
+		// ALERT_EVALUATION_INTERVAL is how often the rules are checked
+		// against the section readings, a minute when it isn't set.
+		go alert.RunEvaluationJob(ctx, alertService, interval("ALERT_EVALUATION_INTERVAL", time.Minute))
 
-		routes.Sections(baseRoute)
+		productBatchService := routes.ProductBatches(baseRoute, auditService)
 
-		routes.ProductBatches(baseRoute)
+		// BATCH_EXPIRY_INTERVAL is how often the batches past their due date
+		// are flagged as expired, an hour when it isn't set.
+		go productbatch.RunExpiryJob(ctx, productBatchService, interval("BATCH_EXPIRY_INTERVAL", time.Hour))
 
-		routes.Employees(baseRoute)
+		routes.Employees(baseRoute, auditService)
 
-		routes.InboundOrders(baseRoute)
+		routes.InboundOrders(baseRoute, auditService)
 
-		routes.Carry(baseRoute)
+		warehouseService := routes.Warehouses(baseRoute, localityService, auditService)
 
-		routes.LocalityCarry(baseRoute)
+		routes.Shipping(baseRoute, productsService, warehouseService, localityService, carryService)
+	}
+
+	httpServer := &http.Server{Addr: address(), Handler: server}
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("could not shut the server down: %v", err)
+	}
+}
+
+// address is where the server listens, on the PORT variable like gin does,
+// 8080 when it isn't set.
+func address() string {
+	if port := os.Getenv("PORT"); port != "" {
+		return ":" + port
+	}
+	return ":8080"
+}
 
-		routes.Warehouses(baseRoute)
+// interval reads how often a background job runs from the environment,
+// falling back when the variable isn't set or isn't a positive duration.
+func interval(name string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(name))
+	if err != nil || d <= 0 {
+		return fallback
 	}
-	server.Run()
+	return d
 }
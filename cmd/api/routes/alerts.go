@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"os"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/alerts"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/validation"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/gin-gonic/gin"
+)
+
+// Alerts returns the alerts service so main can run the job evaluating the
+// rules.
+func Alerts(routerGroup *gin.RouterGroup, auditService audit.Service) alert.Service {
+	alertRepository := alert.NewRepository(database.GetInstance())
+
+	// ALERT_WEBHOOK_URL receives every new alert as a JSON POST; the alerts
+	// are only kept in the database when it isn't set.
+	var notifier alert.Notifier
+	if url := os.Getenv("ALERT_WEBHOOK_URL"); url != "" {
+		notifier = alert.NewWebhook(url)
+	}
+	alertService := alert.NewService(alertRepository, notifier)
+	handler := alerts.NewAlerts(alertService)
+
+	ruleAudit := auditHandler.Middleware(auditService, "alert_rules", func(c *gin.Context, id int) (interface{}, error) {
+		return alertService.GetRule(c.Request.Context(), id)
+	})
+	routerGroup.GET("alerts/rules", handler.GetRules())
+	routerGroup.POST("alerts/rules", ruleAudit, handler.CreateRule())
+	routerGroup.DELETE("alerts/rules/:id", validation.ValidateID, ruleAudit, handler.DeleteRule())
+
+	alertAudit := auditHandler.Middleware(auditService, "alerts", func(c *gin.Context, id int) (interface{}, error) {
+		return alertService.GetByID(c.Request.Context(), id)
+	})
+	routerGroup.GET("alerts/", handler.GetAll())
+	routerGroup.GET("alerts/:id", validation.ValidateID, handler.GetByID())
+	routerGroup.POST("alerts/:id/acknowledge", validation.ValidateID, alertAudit, handler.Acknowledge())
+	routerGroup.POST("alerts/:id/resolve", validation.ValidateID, alertAudit, handler.Resolve())
+
+	return alertService
+}
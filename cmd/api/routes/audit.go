@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/gin-gonic/gin"
+)
+
+func Audit(routerGroup *gin.RouterGroup) audit.Service {
+	auditRepository := audit.NewRepository(database.GetInstance())
+	auditService := audit.NewService(auditRepository)
+	auditHandler := handler.NewAudit(auditService)
+
+	auditRouterGroup := routerGroup.Group("/audit")
+	{
+		auditRouterGroup.GET("/", auditHandler.Search)
+	}
+
+	return auditService
+}
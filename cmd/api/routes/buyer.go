@@ -2,7 +2,9 @@ package routes
 
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/validation"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/buyer/controller"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/buyer/repository/myslq"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/buyer/service"
@@ -10,13 +12,16 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 )
 
-func Buyers(routerGroup *gin.RouterGroup) {
+func Buyers(routerGroup *gin.RouterGroup, auditService audit.Service) {
 
 	repo := myslq.NewRepository(database.GetInstance())
 	buyersService := service.NewService(repo)
 	buyerHandler := handler.NewBuyer(buyersService)
 
 	buyerRouterGroup := routerGroup.Group("/buyers")
+	buyerRouterGroup.Use(auditHandler.Middleware(auditService, "buyers", func(c *gin.Context, id int) (interface{}, error) {
+		return buyersService.GetById(c.Request.Context(), id)
+	}))
 	{
 
 		buyerRouterGroup.GET("/", buyerHandler.GetAll)
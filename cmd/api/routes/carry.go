@@ -2,20 +2,53 @@ package routes
 
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/carries"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/adapters"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
 	"github.com/gin-gonic/gin"
 )
 
-func Carry(routerGroup *gin.RouterGroup) {
+func Carry(routerGroup *gin.RouterGroup, localityService locality.Service, provinceService province.Service,
+	auditService audit.Service) usecases.ServiceCarry {
 
 	carryRepository := adapters.NewMySqlCarryRepository(database.GetInstance())
-	carryService := usecases.NewServiceCarry(carryRepository)
+	carryService := usecases.NewServiceCarry(carryRepository, localityService, provinceService)
 	carryHandler := carries.NewCarry(carryService)
 
 	carryRouterGroup := routerGroup.Group("/carries")
+	carryRouterGroup.Use(auditHandler.Middleware(auditService, "carries", func(c *gin.Context, id int) (interface{}, error) {
+		return carryService.GetByID(id)
+	}))
 	{
+		carryRouterGroup.GET("/", carryHandler.GetAll)
+		carryRouterGroup.GET("/cid/:cid", carryHandler.GetByCid)
+		carryRouterGroup.GET("/:id", carryHandler.GetByID)
 		carryRouterGroup.POST("/", carryHandler.CreateCarry)
+		carryRouterGroup.PATCH("/:id", carryHandler.UpdateCarry)
+		carryRouterGroup.DELETE("/:id", carryHandler.DeleteCarry)
 	}
+
+	coverageRouterGroup := routerGroup.Group("/carries")
+	coverageRouterGroup.Use(auditHandler.Middleware(auditService, "carrier_coverage", func(c *gin.Context, id int) (interface{}, error) {
+		return carryService.GetCoverage(id)
+	}))
+	{
+		coverageRouterGroup.GET("/:id/coverage", carryHandler.GetCoverage)
+		coverageRouterGroup.PUT("/:id/coverage", carryHandler.SetCoverage)
+	}
+
+	rateCardRouterGroup := routerGroup.Group("/carries")
+	rateCardRouterGroup.Use(auditHandler.Middleware(auditService, "carrier_rate_cards", func(c *gin.Context, id int) (interface{}, error) {
+		return carryService.GetRateCard(id)
+	}))
+	{
+		rateCardRouterGroup.GET("/:id/rate-card", carryHandler.GetRateCard)
+		rateCardRouterGroup.PUT("/:id/rate-card", carryHandler.SaveRateCard)
+	}
+
+	return carryService
 }
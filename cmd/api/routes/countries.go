@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	"github.com/gin-gonic/gin"
+)
+
+func Countries(routerGroup *gin.RouterGroup, auditService audit.Service) country.Service {
+	countryRepository := country.NewMariaDBRepository(database.GetInstance())
+	countryService := country.NewService(countryRepository)
+	countryController := handlers.NewCountry(countryService)
+
+	countryRouterGroup := routerGroup.Group("/countries")
+	countryRouterGroup.Use(auditHandler.Middleware(auditService, "countries", func(c *gin.Context, id int) (interface{}, error) {
+		return countryService.GetById(c.Request.Context(), id)
+	}))
+	{
+		countryRouterGroup.GET("/", countryController.GetAll)
+		countryRouterGroup.GET("/:id", countryController.GetById)
+		countryRouterGroup.POST("/", countryController.Create)
+		countryRouterGroup.PATCH("/:id", countryController.Update)
+		countryRouterGroup.DELETE("/:id", countryController.Delete)
+	}
+
+	return countryService
+}
@@ -3,6 +3,8 @@ package routes
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
 	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	employees "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/employee"
 	inboundOrders "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/inbound_orders"
 
@@ -10,7 +12,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 )
 
-func Employees(routerGroup *gin.RouterGroup) {
+func Employees(routerGroup *gin.RouterGroup, auditService audit.Service) {
 	db := database.GetInstance()
 	employeesRepository := employees.NewRepository(db)
 
@@ -21,6 +23,9 @@ func Employees(routerGroup *gin.RouterGroup) {
 	employeesHandler := handler.NewEmployee(employeesService, inboundOrderService)
 
 	employeesRouterGroup := routerGroup.Group("/employees")
+	employeesRouterGroup.Use(auditHandler.Middleware(auditService, "employees", func(c *gin.Context, id int) (interface{}, error) {
+		return employeesService.GetById(id)
+	}))
 	{
 		employeesRouterGroup.POST("/", employeesHandler.Create())
 		employeesRouterGroup.GET("/", employeesHandler.GetAll())
@@ -3,12 +3,14 @@ package routes
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
 	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	io "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/inbound_orders"
 	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql"
 )
 
-func InboundOrders(routerGroup *gin.RouterGroup) {
+func InboundOrders(routerGroup *gin.RouterGroup, auditService audit.Service) {
 
 	inboundOrdersRepository := io.NewRepository(database.GetInstance())
 
@@ -16,6 +18,7 @@ func InboundOrders(routerGroup *gin.RouterGroup) {
 	inboundOrdersHandler := handler.NewInboundOrder(inboundOrdersService)
 
 	inboundOrdersRouterGroup := routerGroup.Group("/inboundOrders")
+	inboundOrdersRouterGroup.Use(auditHandler.Middleware(auditService, "inbound_orders", nil))
 	{
 		inboundOrdersRouterGroup.POST("/", inboundOrdersHandler.Create())
 	}
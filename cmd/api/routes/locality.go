@@ -3,20 +3,35 @@ package routes
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
 	"github.com/gin-gonic/gin"
 )
 
-func Localities(routerGroup *gin.RouterGroup) locality.Service {
+func Localities(routerGroup *gin.RouterGroup, provinceService province.Service, auditService audit.Service) locality.Service {
+	zipCodeService := zipcode.NewService(zipcode.NewMariaDBRepository(database.GetInstance()))
+
 	localityRepository := locality.NewMariaDBRepository(database.GetInstance())
-	localityService := locality.NewService(localityRepository)
+	localityService := locality.NewService(localityRepository, provinceService, zipCodeService)
 	localityController := handlers.NewLocality(localityService)
 
 	localityRouterGroup := routerGroup.Group("/localities")
+	localityRouterGroup.Use(auditHandler.Middleware(auditService, "localities", func(c *gin.Context, id int) (interface{}, error) {
+		return localityService.GetById(c.Request.Context(), id)
+	}))
 	{
 		localityRouterGroup.GET("/", localityController.GetAll)
+		localityRouterGroup.GET("/report", localityController.Report)
 		localityRouterGroup.GET("/reportSellers", localityController.ReportSellers)
+		localityRouterGroup.GET("/reportCarries", localityController.ReportCarries)
+		localityRouterGroup.GET("/lookup", localityController.Lookup)
+		localityRouterGroup.GET("/:id", localityController.GetById)
 		localityRouterGroup.POST("/", localityController.Create)
+		localityRouterGroup.PATCH("/:id", localityController.Update)
+		localityRouterGroup.DELETE("/:id", localityController.Delete)
 	}
 
 	return localityService
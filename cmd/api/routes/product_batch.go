@@ -2,16 +2,43 @@ package routes
 
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/product_batches"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/validation"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	productbatch "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch"
 	"github.com/gin-gonic/gin"
 )
 
-func ProductBatches(routerGroup *gin.RouterGroup) {
+// ProductBatches returns the product batches service so main can run the job
+// flagging the expired batches.
+func ProductBatches(routerGroup *gin.RouterGroup, auditService audit.Service) productbatch.Services {
 	pb_rep := productbatch.NewRepository(database.GetInstance())
 	pb_service := productbatch.NewService(pb_rep)
 	productBatch := product_batches.NewProductBatch(pb_service)
 
-	routerGroup.POST("productBatches/", productBatch.Create())
+	batchAudit := auditHandler.Middleware(auditService, "product_batches", func(c *gin.Context, id int) (interface{}, error) {
+		return pb_service.GetByID(c.Request.Context(), id)
+	})
+	routerGroup.POST("productBatches/", batchAudit, productBatch.Create())
+	routerGroup.GET("productBatches/", productBatch.GetAll())
+	routerGroup.GET("productBatches/:id", validation.ValidateID, productBatch.GetByID())
+	routerGroup.PATCH("productBatches/:id", validation.ValidateID, batchAudit, productBatch.Update())
+	routerGroup.DELETE("productBatches/:id", validation.ValidateID, batchAudit, productBatch.Delete())
 	routerGroup.GET("sections/reportProducts", productBatch.Report())
+	routerGroup.GET("sections/compatible", productBatch.CompatibleSections())
+	routerGroup.POST("sections/recommend", productBatch.Recommend())
+
+	routerGroup.GET("productBatches/expiring", productBatch.Expiring())
+	routerGroup.POST("productBatches/allocations", productBatch.Allocate())
+	routerGroup.POST("productBatches/allocations/confirm", auditHandler.Middleware(auditService, "product_batch_picks", nil),
+		productBatch.ConfirmPick())
+
+	shelfLifeAudit := auditHandler.Middleware(auditService, "buyer_shelf_life", func(c *gin.Context, id int) (interface{}, error) {
+		return pb_service.GetShelfLife(c.Request.Context(), id)
+	})
+	routerGroup.GET("buyers/:id/shelfLife", validation.ValidateID, productBatch.GetShelfLife())
+	routerGroup.PUT("buyers/:id/shelfLife", validation.ValidateID, shelfLifeAudit, productBatch.SetShelfLife())
+
+	return pb_service
 }
@@ -3,6 +3,8 @@ package routes
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
 	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	products "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product"
 	productrecord "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_record"
 
@@ -10,12 +12,13 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 )
 
-func ProductRecord(routerGroup *gin.RouterGroup, productsService products.Service) {
+func ProductRecord(routerGroup *gin.RouterGroup, productsService products.Service, auditService audit.Service) {
 	productRecordRepository := productrecord.NewRepository(database.GetInstance())
 	productRecordService := productrecord.NewService(productRecordRepository, productsService)
 	productRecordHandler := handler.NewProductRecord(productRecordService)
 
 	productRecordRouterGroupPost := routerGroup.Group("/productRecords")
+	productRecordRouterGroupPost.Use(auditHandler.Middleware(auditService, "product_records", nil))
 	{
 		productRecordRouterGroupPost.POST("/", productRecordHandler.Store())
 	}
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	producttype "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_type"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/go-sql-driver/mysql"
+)
+
+func ProductTypes(routerGroup *gin.RouterGroup, auditService audit.Service) {
+	productTypeRepository := producttype.NewRepository(database.GetInstance())
+	productTypeService := producttype.NewService(productTypeRepository)
+	productTypeHandler := handler.NewProductType(productTypeService)
+
+	productTypeRouterGroup := routerGroup.Group("/productTypes")
+	productTypeRouterGroup.Use(auditHandler.Middleware(auditService, "product_types", func(c *gin.Context, id int) (interface{}, error) {
+		return productTypeService.GetById(c.Request.Context(), id)
+	}))
+	{
+		productTypeRouterGroup.POST("/", productTypeHandler.Store())
+		productTypeRouterGroup.GET("/", productTypeHandler.GetAll())
+		productTypeRouterGroup.GET("/:id", productTypeHandler.GetById())
+		productTypeRouterGroup.GET("/:id/report", productTypeHandler.GetReport())
+		productTypeRouterGroup.PATCH("/:id", productTypeHandler.Update())
+		productTypeRouterGroup.DELETE("/:id", productTypeHandler.Delete())
+	}
+}
@@ -3,6 +3,8 @@ package routes
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
 	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	products "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product"
 	seller "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/seller"
 
@@ -10,12 +12,15 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 )
 
-func Products(routerGroup *gin.RouterGroup, sellerService seller.Service) products.Service {
+func Products(routerGroup *gin.RouterGroup, sellerService seller.Service, auditService audit.Service) products.Service {
 	productsRepository := products.NewRepository(database.GetInstance())
 	productsService := products.NewService(productsRepository, sellerService)
 	productsHandler := handler.NewProduct(productsService)
 
 	productsRouterGroup := routerGroup.Group("/products")
+	productsRouterGroup.Use(auditHandler.Middleware(auditService, "products", func(c *gin.Context, id int) (interface{}, error) {
+		return productsService.GetById(c.Request.Context(), id)
+	}))
 	{
 		productsRouterGroup.POST("/", productsHandler.Store())
 		productsRouterGroup.GET("/", productsHandler.GetAll())
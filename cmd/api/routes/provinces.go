@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/gin-gonic/gin"
+)
+
+func Provinces(routerGroup *gin.RouterGroup, countryService country.Service, auditService audit.Service) province.Service {
+	provinceRepository := province.NewMariaDBRepository(database.GetInstance())
+	provinceService := province.NewService(provinceRepository, countryService)
+	provinceController := handlers.NewProvince(provinceService)
+
+	provinceRouterGroup := routerGroup.Group("/provinces")
+	provinceRouterGroup.Use(auditHandler.Middleware(auditService, "provinces", func(c *gin.Context, id int) (interface{}, error) {
+		return provinceService.GetById(c.Request.Context(), id)
+	}))
+	{
+		provinceRouterGroup.GET("/", provinceController.GetAll)
+		provinceRouterGroup.GET("/:id", provinceController.GetById)
+		provinceRouterGroup.POST("/", provinceController.Create)
+		provinceRouterGroup.PATCH("/:id", provinceController.Update)
+		provinceRouterGroup.DELETE("/:id", provinceController.Delete)
+	}
+
+	return provinceService
+}
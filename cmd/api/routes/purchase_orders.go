@@ -1,24 +1,62 @@
 package routes
 
 import (
+	"log"
+	"os"
+	"strconv"
+
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/validation"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
 	purchaseOrdersHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/controller"
 	purchaseOrdersRepo "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/repository"
 	purchaseOrdersService "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/service"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
+	shipmentRepo "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/repository"
+	shipmentService "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/service"
 	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql"
 )
 
-func PurchaseOrders(routerGroup *gin.RouterGroup) {
+// PurchaseOrders returns the reservations service so main can run the job
+// releasing the expired stock holds.
+func PurchaseOrders(routerGroup *gin.RouterGroup, carryService usecases.ServiceCarry, auditService audit.Service) reservation.Service {
+
+	// ORDER_TAX_RATE is the tax charged on the orders, 0.1 for 10%. Orders
+	// are tax free when it isn't set.
+	var taxRate float64
+	if value := os.Getenv("ORDER_TAX_RATE"); value != "" {
+		var err error
+		taxRate, err = strconv.ParseFloat(value, 64)
+		if err != nil || taxRate < 0 {
+			log.Fatalf("ORDER_TAX_RATE must be a number not below zero, got %q", value)
+		}
+	}
+
+	reservations := reservation.NewService(reservation.NewRepository(database.GetInstance()))
 
 	repo := purchaseOrdersRepo.NewRepository(database.GetInstance())
-	service := purchaseOrdersService.NewService(repo)
-	handler := purchaseOrdersHandler.NewPurchaseOrder(service)
+	service := purchaseOrdersService.NewService(repo, taxRate, reservations)
+
+	shipments := shipmentService.NewService(shipmentRepo.NewRepository(database.GetInstance()), service, carryService)
+	Shipments(routerGroup, shipments, auditService)
+
+	handler := purchaseOrdersHandler.NewPurchaseOrder(service, shipments)
 
 	purchaseOrderGroup := routerGroup.Group("/purchase-orders")
+	purchaseOrderGroup.Use(auditHandler.Middleware(auditService, "purchase_orders", func(c *gin.Context, id int) (interface{}, error) {
+		return service.GetById(c.Request.Context(), id)
+	}))
 	{
+		purchaseOrderGroup.GET("/", handler.GetAll)
 		purchaseOrderGroup.POST("/", handler.Create)
 		purchaseOrderGroup.GET("/:id", validation.ValidateID, handler.GetPurchaseOrderById)
+		purchaseOrderGroup.PATCH("/:id", validation.ValidateID, handler.Update)
+		purchaseOrderGroup.POST("/:id/cancel", validation.ValidateID, handler.Cancel)
+		purchaseOrderGroup.POST("/:id/transitions", validation.ValidateID, handler.Transition)
 	}
+
+	return reservations
 }
@@ -2,22 +2,28 @@ package routes
 
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
 	sections "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/sections"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section"
 	"github.com/gin-gonic/gin"
 )
 
-func Sections(routerGroup *gin.RouterGroup) {
+func Sections(routerGroup *gin.RouterGroup, auditService audit.Service) {
 	sectionRouterGroup := routerGroup.Group("/sections")
 	{
 		sec_rep := section.NewRepository(database.GetInstance())
 		sec_service := section.NewService(sec_rep)
 		section := sections.NewSection(sec_service)
 
+		sectionRouterGroup.Use(auditHandler.Middleware(auditService, "sections", func(c *gin.Context, id int) (interface{}, error) {
+			return sec_service.GetByID(id)
+		}))
+
 		sectionRouterGroup.GET("/", section.GetAll())
 		sectionRouterGroup.POST("/", section.CreateSection())
 		sectionRouterGroup.GET("/:id", section.IdVerificatorMiddleware, section.GetByID())
-		sectionRouterGroup.PATCH("/:id", section.IdVerificatorMiddleware, section.UpdateSecID())
+		sectionRouterGroup.PATCH("/:id", section.IdVerificatorMiddleware, section.UpdateSection())
 		sectionRouterGroup.DELETE("/:id", section.IdVerificatorMiddleware, section.DeleteSection())
 	}
 }
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/section_readings"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/validation"
+	sectionreading "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section_reading"
+	"github.com/gin-gonic/gin"
+)
+
+func SectionReadings(routerGroup *gin.RouterGroup) sectionreading.Service {
+	readingRepository := sectionreading.NewRepository(database.GetInstance())
+	readingService := sectionreading.NewService(readingRepository)
+	readings := section_readings.NewSectionReadings(readingService)
+
+	// The readings are sent by sensors and stored as they come, so they
+	// aren't audited: the readings table is already their log.
+	routerGroup.POST("sections/:id/readings", validation.ValidateID, readings.Record())
+	routerGroup.GET("sections/:id/readings", validation.ValidateID, readings.History())
+
+	return readingService
+}
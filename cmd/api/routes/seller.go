@@ -3,18 +3,23 @@ package routes
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
 	handler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/seller"
 	"github.com/gin-gonic/gin"
 )
 
-func Sellers(routerGroup *gin.RouterGroup, localityService locality.Service) seller.Service {
+func Sellers(routerGroup *gin.RouterGroup, localityService locality.Service, auditService audit.Service) seller.Service {
 
 	sellerRepository := seller.NewMariaDBRepository(database.GetInstance())
 	sellerService := seller.NewService(sellerRepository, localityService)
 	sellerController := handler.NewSeller(sellerService)
 
 	sellerRouterGroup := routerGroup.Group("/sellers")
+	sellerRouterGroup.Use(auditHandler.Middleware(auditService, "sellers", func(c *gin.Context, id int) (interface{}, error) {
+		return sellerService.GetOne(c.Request.Context(), id)
+	}))
 	{
 		sellerRouterGroup.GET("/", sellerController.GetAll)
 		sellerRouterGroup.GET("/:id", sellerController.GetOne)
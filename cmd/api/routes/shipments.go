@@ -0,0 +1,27 @@
+package routes
+
+import (
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/validation"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	shipmentHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/controller"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// Shipments is registered by PurchaseOrders, because the shipments check the
+// purchase orders and the purchase orders show their shipments.
+func Shipments(routerGroup *gin.RouterGroup, service domain.Service, auditService audit.Service) {
+
+	handler := shipmentHandler.NewShipment(service)
+
+	shipmentGroup := routerGroup.Group("/shipments")
+	shipmentGroup.Use(auditHandler.Middleware(auditService, "shipments", func(c *gin.Context, id int) (interface{}, error) {
+		return service.GetById(c.Request.Context(), id)
+	}))
+	{
+		shipmentGroup.POST("/", handler.Create)
+		shipmentGroup.GET("/:id", validation.ValidateID, handler.GetShipmentById)
+		shipmentGroup.POST("/:id/events", validation.ValidateID, handler.CreateEvent)
+	}
+}
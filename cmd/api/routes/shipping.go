@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers"
+	carry "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	products "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipping"
+	warehouse "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases"
+	"github.com/gin-gonic/gin"
+)
+
+func Shipping(routerGroup *gin.RouterGroup, productService products.Service, warehouseService warehouse.Service,
+	localityService locality.Service, carryService carry.ServiceCarry) {
+	shippingService := shipping.NewService(productService, warehouseService, localityService, carryService)
+	shippingController := handlers.NewShipping(shippingService)
+
+	shippingRouterGroup := routerGroup.Group("/shipping")
+	{
+		shippingRouterGroup.POST("/quote", shippingController.Quote)
+	}
+}
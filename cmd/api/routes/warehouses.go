@@ -2,7 +2,14 @@ package routes
 
 import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	auditHandler "github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/audit"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/handlers/warehouses"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/employee"
+	inboundorders "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/inbound_orders"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	productbatch "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/adapters"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases"
 
@@ -10,21 +17,34 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func Warehouses(routerGroup *gin.RouterGroup) {
+func Warehouses(routerGroup *gin.RouterGroup, localityService locality.Service, auditService audit.Service) usecases.Service {
+
+	// file := store.New(store.FileType, "../../internal/warehouse/warehouses.json")
+	warehouseRepository := adapters.NewMySqlRepository(database.GetInstance())
+	warehouseService := usecases.NewService(warehouseRepository, localityService)
+	warehouse := warehouses.NewWarehouse(warehouseService)
+
+	db := database.GetInstance()
+	summaryService := usecases.NewSummaryService(warehouseRepository, section.NewRepository(db),
+		productbatch.NewRepository(db), employee.NewRepository(db), inboundorders.NewRepository(db))
+	summary := warehouses.NewWarehouseSummary(summaryService)
 
 	warehouseRouterGroup := routerGroup.Group("/warehouses")
 
 	{
-		// file := store.New(store.FileType, "../../internal/warehouse/warehouses.json")
-		warehouseRepository := adapters.NewMySqlRepository(database.GetInstance())
-		warehouseService := usecases.NewService(warehouseRepository)
-		warehouse := warehouses.NewWarehouse(warehouseService)
+
+		warehouseRouterGroup.Use(auditHandler.Middleware(auditService, "warehouses", func(c *gin.Context, id int) (interface{}, error) {
+			return warehouseService.GetByID(id)
+		}))
 
 		warehouseRouterGroup.GET("/", warehouse.GetAll)
+		warehouseRouterGroup.GET("/nearest", warehouse.NearestWarehouses)
 		warehouseRouterGroup.GET("/:id", warehouse.GetByID)
+		warehouseRouterGroup.GET("/:id/summary", summary.Summary)
 		warehouseRouterGroup.POST("/", warehouse.CreateWarehouse)
-		warehouseRouterGroup.PATCH("/:id", warehouse.UpdatedWarehouseID)
+		warehouseRouterGroup.PATCH("/:id", warehouse.UpdateWarehouse)
 		warehouseRouterGroup.DELETE("/:id", warehouse.DeleteWarehouse)
 	}
 
+	return warehouseService
 }
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/cmd/api/database"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
+	"github.com/joho/godotenv"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// Imports a postal code CSV (zip_code,locality_name,province_name,country_name)
+// into the zip_codes table used by the localities zip validation and lookup.
+func main() {
+	file := flag.String("file", "db/zipcodes/zip_codes.csv", "path of the zip codes csv")
+	flag.Parse()
+
+	godotenv.Load(".env")
+
+	csvFile, err := os.Open(*file)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer csvFile.Close()
+
+	zipCodeService := zipcode.NewService(zipcode.NewMariaDBRepository(database.GetInstance()))
+
+	total, err := zipCodeService.Import(context.Background(), csvFile)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%d zip codes imported\n", total)
+}
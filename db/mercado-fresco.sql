@@ -150,11 +150,25 @@ CREATE TABLE IF NOT EXISTS `mercado-fresco`.`localities`
     `id`            SERIAL,
     `zip_code`      VARCHAR(255),
     `locality_name` VARCHAR(255) NOT NULL,
-    `country_name` VARCHAR(255) NOT NULL,
-    `province_name` VARCHAR(255) NOT NULL,
+    `province_id`   BIGINT UNSIGNED NOT NULL,
+    `latitude`      DECIMAL(9, 6) NULL,
+    `longitude`     DECIMAL(9, 6) NULL,
     PRIMARY KEY (`id`)
 ) ENGINE = InnoDB;
 
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`zip_codes`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`zip_codes`
+(
+    `zip_code`      VARCHAR(20)  NOT NULL,
+    `locality_name` VARCHAR(255) NOT NULL,
+    `province_name` VARCHAR(255) NOT NULL,
+    `country_name`  VARCHAR(255) NOT NULL,
+    PRIMARY KEY (`country_name`, `zip_code`),
+    INDEX `IDX_ZIP_CODES_ZIP_CODE` (`zip_code`)
+) ENGINE = InnoDB;
+
 -- -----------------------------------------------------
 -- Table `mercado-fresco`.`product_types`
 -- -----------------------------------------------------
@@ -196,7 +210,19 @@ CREATE TABLE IF NOT EXISTS `mercado-fresco`.`product_batches`
     `minimum_temperature` INT,
     `product_id`          BIGINT UNSIGNED,
     `section_id`          BIGINT UNSIGNED,
-    PRIMARY KEY (`id`)
+    `expired`             TINYINT(1) NOT NULL DEFAULT 0,
+    PRIMARY KEY (`id`),
+    INDEX `IDX_PRODUCT_BATCHES_DUE_DATE` (`due_date`)
+) ENGINE = InnoDB;
+
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`buyer_shelf_life`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`buyer_shelf_life`
+(
+    `buyer_id`     BIGINT UNSIGNED NOT NULL,
+    `minimum_days` INT             NOT NULL,
+    PRIMARY KEY (`buyer_id`)
 ) ENGINE = InnoDB;
 
 -- -----------------------------------------------------
@@ -222,6 +248,16 @@ CREATE TABLE IF NOT EXISTS `mercado-fresco`.`order_status`
     PRIMARY KEY (`id`)
 ) ENGINE = InnoDB;
 
+-- The ids are the ones the purchase orders service expects for each status.
+INSERT IGNORE INTO `mercado-fresco`.`order_status` (`id`, `description`)
+VALUES (1, 'created'),
+       (2, 'confirmed'),
+       (3, 'picking'),
+       (4, 'shipped'),
+       (5, 'delivered'),
+       (6, 'cancelled'),
+       (7, 'returned');
+
 -- -----------------------------------------------------
 -- Table `mercado-fresco`.`carriers`
 -- -----------------------------------------------------
@@ -236,6 +272,59 @@ CREATE TABLE IF NOT EXISTS `mercado-fresco`.`carriers`
     PRIMARY KEY (`id`)
 ) ENGINE = InnoDB;
 
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`carrier_coverage`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`carrier_coverage`
+(
+    `id`          SERIAL,
+    `carrier_id`  BIGINT UNSIGNED NOT NULL,
+    `locality_id` BIGINT UNSIGNED NULL,
+    `province_id` BIGINT UNSIGNED NULL,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB;
+
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`carrier_rate_cards`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`carrier_rate_cards`
+(
+    `carrier_id`             BIGINT UNSIGNED NOT NULL,
+    `base_price`             DECIMAL(19, 2)  NOT NULL,
+    `price_per_kg`           DECIMAL(19, 2)  NOT NULL,
+    `price_per_km`           DECIMAL(19, 2)  NOT NULL,
+    `refrigerated_surcharge` DECIMAL(19, 2)  NOT NULL,
+    PRIMARY KEY (`carrier_id`)
+) ENGINE = InnoDB;
+
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`shipments`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`shipments`
+(
+    `id`                SERIAL,
+    `tracking_code`     VARCHAR(40)     NOT NULL,
+    `purchase_order_id` BIGINT UNSIGNED NOT NULL,
+    `carrier_id`        BIGINT UNSIGNED NOT NULL,
+    `status`            VARCHAR(20)     NOT NULL,
+    `created_at`        DATETIME        NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `UQ_SHIPMENTS_TRACKING_CODE` (`tracking_code`)
+) ENGINE = InnoDB;
+
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`shipment_events`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`shipment_events`
+(
+    `id`          SERIAL,
+    `shipment_id` BIGINT UNSIGNED NOT NULL,
+    `status`      VARCHAR(20)     NOT NULL,
+    `description` VARCHAR(255)    NOT NULL DEFAULT '',
+    `occurred_at` DATETIME        NOT NULL,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB;
+
 -- -----------------------------------------------------
 -- Table `mercado-fresco`.`purchase_orders`
 -- -----------------------------------------------------
@@ -248,6 +337,20 @@ CREATE TABLE IF NOT EXISTS `mercado-fresco`.`purchase_orders`
     `buyer_id`          BIGINT UNSIGNED,
     `product_record_id` BIGINT UNSIGNED,
     `order_status_id`   BIGINT UNSIGNED,
+    `tax_rate`          DECIMAL(5, 4),
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB;
+
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`purchase_order_status_history`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`purchase_order_status_history`
+(
+    `id`                SERIAL,
+    `purchase_order_id` BIGINT UNSIGNED NOT NULL,
+    `from_status_id`    BIGINT UNSIGNED NULL,
+    `to_status_id`      BIGINT UNSIGNED NOT NULL,
+    `changed_at`        DATETIME        NOT NULL,
     PRIMARY KEY (`id`)
 ) ENGINE = InnoDB;
 
@@ -262,9 +365,27 @@ CREATE TABLE IF NOT EXISTS `mercado-fresco`.`order_details`
     `temperature`        DECIMAL(19, 2),
     `product_record_id`  BIGINT UNSIGNED,
     `purchase_order_id`  BIGINT UNSIGNED,
+    `unit_price`         DECIMAL(19, 2),
     PRIMARY KEY (`id`)
 ) ENGINE = InnoDB;
 
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`stock_reservations`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`stock_reservations`
+(
+    `id`                SERIAL,
+    `purchase_order_id` BIGINT UNSIGNED NOT NULL,
+    `product_batch_id`  BIGINT UNSIGNED NOT NULL,
+    `quantity`          INT(11)         NOT NULL,
+    `status`            VARCHAR(20)     NOT NULL,
+    `created_at`        DATETIME        NOT NULL,
+    `expires_at`        DATETIME        NULL,
+    PRIMARY KEY (`id`),
+    INDEX `IDX_STOCK_RESERVATIONS_BATCH_STATUS` (`product_batch_id`, `status`),
+    INDEX `IDX_STOCK_RESERVATIONS_STATUS_EXPIRES_AT` (`status`, `expires_at`)
+) ENGINE = InnoDB;
+
 -- -----------------------------------------------------
 -- Table `mercado-fresco`.`section`
 -- -----------------------------------------------------
@@ -282,11 +403,92 @@ CREATE TABLE IF NOT EXISTS `mercado-fresco`.`section`
     PRIMARY KEY (`id`)
 ) ENGINE = InnoDB;
 
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`section_readings`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`section_readings`
+(
+    `id`          SERIAL,
+    `section_id`  BIGINT UNSIGNED NOT NULL,
+    `temperature` DECIMAL(5, 2)   NOT NULL,
+    `recorded_at` DATETIME        NOT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `IDX_SECTION_READINGS_SECTION_RECORDED_AT` (`section_id`, `recorded_at`)
+) ENGINE = InnoDB;
+
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`alert_rules`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`alert_rules`
+(
+    `id`               SERIAL,
+    `name`             VARCHAR(255)    NOT NULL,
+    `section_id`       BIGINT UNSIGNED NULL,
+    `threshold`        DECIMAL(5, 2)   NULL,
+    `duration_seconds` INT(11)         NOT NULL DEFAULT 0,
+    `created_at`       DATETIME        NOT NULL,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB;
+
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`alerts`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`alerts`
+(
+    `id`               SERIAL,
+    `rule_id`          BIGINT UNSIGNED NULL,
+    `section_id`       BIGINT UNSIGNED NOT NULL,
+    `threshold`        DECIMAL(5, 2)   NOT NULL,
+    `peak_temperature` DECIMAL(5, 2)   NOT NULL,
+    `started_at`       DATETIME        NOT NULL,
+    `detected_at`      DATETIME        NOT NULL,
+    `status`           VARCHAR(20)     NOT NULL DEFAULT 'open',
+    `acknowledged_at`  DATETIME        NULL,
+    `resolved_at`      DATETIME        NULL,
+    `notified_at`      DATETIME        NULL,
+    PRIMARY KEY (`id`),
+    INDEX `IDX_ALERTS_RULE_SECTION` (`rule_id`, `section_id`)
+) ENGINE = InnoDB;
+
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`alert_batches`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`alert_batches`
+(
+    `alert_id`         BIGINT UNSIGNED NOT NULL,
+    `product_batch_id` BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (`alert_id`, `product_batch_id`)
+) ENGINE = InnoDB;
+
+-- -----------------------------------------------------
+-- Table `mercado-fresco`.`audit_log`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `mercado-fresco`.`audit_log`
+(
+    `id`          SERIAL,
+    `actor`       VARCHAR(255) NOT NULL,
+    `entity`      VARCHAR(255) NOT NULL,
+    `entity_id`   BIGINT UNSIGNED,
+    `action`      VARCHAR(45)  NOT NULL,
+    `before_data` JSON,
+    `after_data`  JSON,
+    `diff`        JSON,
+    `created_at`  DATETIME(6)  NOT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `IDX_AUDIT_LOG_ENTITY` (`entity`, `entity_id`),
+    INDEX `IDX_AUDIT_LOG_CREATED_AT` (`created_at`)
+) ENGINE = InnoDB;
+
 ALTER TABLE `mercado-fresco`.`user_rol`
     ADD CONSTRAINT `FK_USER_ROL_USER` FOREIGN KEY (`usuario_id`) REFERENCES `mercado-fresco`.`users` (`id`);
 ALTER TABLE `mercado-fresco`.`user_rol`
     ADD CONSTRAINT `FK_USER_ROL_ROL` FOREIGN KEY (`rol_id`) REFERENCES `mercado-fresco`.`rol` (`id`);
 
+ALTER TABLE `mercado-fresco`.`provinces`
+    ADD CONSTRAINT `FK_PROVINCES_COUNTRY` FOREIGN KEY (`id_country`) REFERENCES `mercado-fresco`.`countries` (`id`);
+ALTER TABLE `mercado-fresco`.`localities`
+    ADD CONSTRAINT `FK_LOCALITIES_PROVINCE` FOREIGN KEY (`province_id`) REFERENCES `mercado-fresco`.`provinces` (`id`);
+
 ALTER TABLE `mercado-fresco`.`carriers`
     ADD CONSTRAINT `FK_CARRIERS_LOCALITY` FOREIGN KEY (`locality_id`) REFERENCES `mercado-fresco`.`localities` (`id`);
 
@@ -301,6 +503,22 @@ ALTER TABLE `mercado-fresco`.`order_details`
 ALTER TABLE `mercado-fresco`.`order_details`
     ADD CONSTRAINT `FK_ORDER_DETAILS_PURCHASE_ORDER` FOREIGN KEY (`purchase_order_id`) REFERENCES `mercado-fresco`.`purchase_orders` (`id`);
 
+ALTER TABLE `mercado-fresco`.`carrier_coverage`
+    ADD CONSTRAINT `FK_CARRIER_COVERAGE_CARRIER` FOREIGN KEY (`carrier_id`) REFERENCES `mercado-fresco`.`carriers` (`id`) ON DELETE CASCADE;
+ALTER TABLE `mercado-fresco`.`carrier_coverage`
+    ADD CONSTRAINT `FK_CARRIER_COVERAGE_LOCALITY` FOREIGN KEY (`locality_id`) REFERENCES `mercado-fresco`.`localities` (`id`);
+ALTER TABLE `mercado-fresco`.`carrier_coverage`
+    ADD CONSTRAINT `FK_CARRIER_COVERAGE_PROVINCE` FOREIGN KEY (`province_id`) REFERENCES `mercado-fresco`.`provinces` (`id`);
+ALTER TABLE `mercado-fresco`.`carrier_rate_cards`
+    ADD CONSTRAINT `FK_CARRIER_RATE_CARDS_CARRIER` FOREIGN KEY (`carrier_id`) REFERENCES `mercado-fresco`.`carriers` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `mercado-fresco`.`shipments`
+    ADD CONSTRAINT `FK_SHIPMENTS_PURCHASE_ORDER` FOREIGN KEY (`purchase_order_id`) REFERENCES `mercado-fresco`.`purchase_orders` (`id`);
+ALTER TABLE `mercado-fresco`.`shipments`
+    ADD CONSTRAINT `FK_SHIPMENTS_CARRIER` FOREIGN KEY (`carrier_id`) REFERENCES `mercado-fresco`.`carriers` (`id`);
+ALTER TABLE `mercado-fresco`.`shipment_events`
+    ADD CONSTRAINT `FK_SHIPMENT_EVENTS_SHIPMENT` FOREIGN KEY (`shipment_id`) REFERENCES `mercado-fresco`.`shipments` (`id`);
+
 ALTER TABLE `mercado-fresco`.`inbound_orders`
     ADD CONSTRAINT `FK_INBOUND_ORDERS_EMPLOYEE` FOREIGN KEY (`employee_id`) REFERENCES `mercado-fresco`.`employees` (`id`);
 ALTER TABLE `mercado-fresco`.`inbound_orders`
@@ -313,6 +531,9 @@ ALTER TABLE `mercado-fresco`.`product_batches`
 ALTER TABLE `mercado-fresco`.`product_batches`
     ADD CONSTRAINT `FK_PRODUCT_BATCHES_SECTION` FOREIGN KEY (`section_id`) REFERENCES `mercado-fresco`.`section` (`id`);
 
+ALTER TABLE `mercado-fresco`.`buyer_shelf_life`
+    ADD CONSTRAINT `FK_BUYER_SHELF_LIFE_BUYER` FOREIGN KEY (`buyer_id`) REFERENCES `mercado-fresco`.`buyers` (`id`);
+
 ALTER TABLE `mercado-fresco`.`purchase_orders`
     ADD CONSTRAINT `UNIQUE_ORDER_NUMBER` UNIQUE (`order_number`);
 
@@ -321,6 +542,18 @@ ALTER TABLE `mercado-fresco`.`purchase_orders`
 ALTER TABLE `mercado-fresco`.`purchase_orders`
     ADD CONSTRAINT `FK_PURCHASE_ORDERS_STATUS_ORDER` FOREIGN KEY (`order_status_id`) REFERENCES `mercado-fresco`.`order_status` (`id`);
 
+ALTER TABLE `mercado-fresco`.`purchase_order_status_history`
+    ADD CONSTRAINT `FK_STATUS_HISTORY_PURCHASE_ORDER` FOREIGN KEY (`purchase_order_id`) REFERENCES `mercado-fresco`.`purchase_orders` (`id`);
+ALTER TABLE `mercado-fresco`.`purchase_order_status_history`
+    ADD CONSTRAINT `FK_STATUS_HISTORY_FROM_STATUS` FOREIGN KEY (`from_status_id`) REFERENCES `mercado-fresco`.`order_status` (`id`);
+ALTER TABLE `mercado-fresco`.`purchase_order_status_history`
+    ADD CONSTRAINT `FK_STATUS_HISTORY_TO_STATUS` FOREIGN KEY (`to_status_id`) REFERENCES `mercado-fresco`.`order_status` (`id`);
+
+ALTER TABLE `mercado-fresco`.`stock_reservations`
+    ADD CONSTRAINT `FK_STOCK_RESERVATIONS_PURCHASE_ORDER` FOREIGN KEY (`purchase_order_id`) REFERENCES `mercado-fresco`.`purchase_orders` (`id`);
+ALTER TABLE `mercado-fresco`.`stock_reservations`
+    ADD CONSTRAINT `FK_STOCK_RESERVATIONS_PRODUCT_BATCH` FOREIGN KEY (`product_batch_id`) REFERENCES `mercado-fresco`.`product_batches` (`id`);
+
 ALTER TABLE `mercado-fresco`.`sellers`
     ADD CONSTRAINT `FK_SELLER_LOCALITY` FOREIGN KEY (`locality_id`) REFERENCES `mercado-fresco`.`localities` (`id`);
 
@@ -339,3 +572,15 @@ ALTER TABLE `mercado-fresco`.`section`
     ADD CONSTRAINT `FK_SECTION_WAREHOUSE` FOREIGN KEY (`warehouse_id`) REFERENCES `mercado-fresco`.`warehouse` (`id`);
 ALTER TABLE `mercado-fresco`.`section`
     ADD CONSTRAINT `FK_SECTION_PRODUCT` FOREIGN KEY (`product_type_id`) REFERENCES `mercado-fresco`.`product_types` (`id`);
+ALTER TABLE `mercado-fresco`.`section_readings`
+    ADD CONSTRAINT `FK_SECTION_READINGS_SECTION` FOREIGN KEY (`section_id`) REFERENCES `mercado-fresco`.`section` (`id`);
+ALTER TABLE `mercado-fresco`.`alert_rules`
+    ADD CONSTRAINT `FK_ALERT_RULES_SECTION` FOREIGN KEY (`section_id`) REFERENCES `mercado-fresco`.`section` (`id`) ON DELETE CASCADE;
+ALTER TABLE `mercado-fresco`.`alerts`
+    ADD CONSTRAINT `FK_ALERTS_RULE` FOREIGN KEY (`rule_id`) REFERENCES `mercado-fresco`.`alert_rules` (`id`) ON DELETE SET NULL;
+ALTER TABLE `mercado-fresco`.`alerts`
+    ADD CONSTRAINT `FK_ALERTS_SECTION` FOREIGN KEY (`section_id`) REFERENCES `mercado-fresco`.`section` (`id`);
+ALTER TABLE `mercado-fresco`.`alert_batches`
+    ADD CONSTRAINT `FK_ALERT_BATCHES_ALERT` FOREIGN KEY (`alert_id`) REFERENCES `mercado-fresco`.`alerts` (`id`) ON DELETE CASCADE;
+ALTER TABLE `mercado-fresco`.`alert_batches`
+    ADD CONSTRAINT `FK_ALERT_BATCHES_PRODUCT_BATCH` FOREIGN KEY (`product_batch_id`) REFERENCES `mercado-fresco`.`product_batches` (`id`) ON DELETE CASCADE;
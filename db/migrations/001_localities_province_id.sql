@@ -0,0 +1,39 @@
+-- -----------------------------------------------------
+-- Moves the free text country_name/province_name of
+-- `localities` into `countries` and `provinces` and makes
+-- every locality reference its province through province_id.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+INSERT INTO `countries` (`country_name`)
+SELECT DISTINCT l.`country_name`
+FROM `localities` l
+WHERE NOT EXISTS (SELECT 1 FROM `countries` c WHERE c.`country_name` = l.`country_name`);
+
+INSERT INTO `provinces` (`province_name`, `id_country`)
+SELECT DISTINCT l.`province_name`, c.`id`
+FROM `localities` l
+         JOIN `countries` c ON c.`country_name` = l.`country_name`
+WHERE NOT EXISTS (SELECT 1
+                  FROM `provinces` p
+                  WHERE p.`province_name` = l.`province_name`
+                    AND p.`id_country` = c.`id`);
+
+ALTER TABLE `localities`
+    ADD COLUMN `province_id` BIGINT UNSIGNED NULL AFTER `locality_name`;
+
+UPDATE `localities` l
+    JOIN `countries` c ON c.`country_name` = l.`country_name`
+    JOIN `provinces` p ON p.`province_name` = l.`province_name` AND p.`id_country` = c.`id`
+SET l.`province_id` = p.`id`;
+
+ALTER TABLE `localities`
+    MODIFY `province_id` BIGINT UNSIGNED NOT NULL,
+    DROP COLUMN `country_name`,
+    DROP COLUMN `province_name`;
+
+ALTER TABLE `provinces`
+    ADD CONSTRAINT `FK_PROVINCES_COUNTRY` FOREIGN KEY (`id_country`) REFERENCES `countries` (`id`);
+ALTER TABLE `localities`
+    ADD CONSTRAINT `FK_LOCALITIES_PROVINCE` FOREIGN KEY (`province_id`) REFERENCES `provinces` (`id`);
@@ -0,0 +1,16 @@
+-- -----------------------------------------------------
+-- Creates the `zip_codes` dataset table on databases
+-- created before this change. Load it with
+-- go run ./cmd/zipcodes -file db/zipcodes/zip_codes.csv
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+CREATE TABLE IF NOT EXISTS `zip_codes`
+(
+    `zip_code`      VARCHAR(20)  NOT NULL,
+    `locality_name` VARCHAR(255) NOT NULL,
+    `province_name` VARCHAR(255) NOT NULL,
+    `country_name`  VARCHAR(255) NOT NULL,
+    PRIMARY KEY (`country_name`, `zip_code`),
+    INDEX `IDX_ZIP_CODES_ZIP_CODE` (`zip_code`)
+) ENGINE = InnoDB;
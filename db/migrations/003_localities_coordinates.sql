@@ -0,0 +1,10 @@
+-- -----------------------------------------------------
+-- Adds the optional latitude/longitude of `localities`
+-- used by the nearest warehouse and near carries queries.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+ALTER TABLE `localities`
+    ADD COLUMN `latitude`  DECIMAL(9, 6) NULL AFTER `province_id`,
+    ADD COLUMN `longitude` DECIMAL(9, 6) NULL AFTER `latitude`;
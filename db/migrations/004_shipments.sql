@@ -0,0 +1,20 @@
+-- -----------------------------------------------------
+-- Creates the `shipments` table that references the
+-- carriers, so a carrier with shipments can't be deleted.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+CREATE TABLE IF NOT EXISTS `shipments`
+(
+    `id`                SERIAL,
+    `tracking_code`     VARCHAR(40)     NOT NULL,
+    `purchase_order_id` BIGINT UNSIGNED NOT NULL,
+    `carrier_id`        BIGINT UNSIGNED NOT NULL,
+    `status`            VARCHAR(20)     NOT NULL,
+    `created_at`        DATETIME        NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `UQ_SHIPMENTS_TRACKING_CODE` (`tracking_code`),
+    CONSTRAINT `FK_SHIPMENTS_PURCHASE_ORDER` FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders` (`id`),
+    CONSTRAINT `FK_SHIPMENTS_CARRIER` FOREIGN KEY (`carrier_id`) REFERENCES `carriers` (`id`)
+) ENGINE = InnoDB;
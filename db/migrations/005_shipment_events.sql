@@ -0,0 +1,17 @@
+-- -----------------------------------------------------
+-- Creates the `shipment_events` table with the tracking
+-- events that make the timeline of a shipment.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+CREATE TABLE IF NOT EXISTS `shipment_events`
+(
+    `id`          SERIAL,
+    `shipment_id` BIGINT UNSIGNED NOT NULL,
+    `status`      VARCHAR(20)     NOT NULL,
+    `description` VARCHAR(255)    NOT NULL DEFAULT '',
+    `occurred_at` DATETIME        NOT NULL,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `FK_SHIPMENT_EVENTS_SHIPMENT` FOREIGN KEY (`shipment_id`) REFERENCES `shipments` (`id`)
+) ENGINE = InnoDB;
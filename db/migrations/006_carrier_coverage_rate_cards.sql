@@ -0,0 +1,29 @@
+-- -----------------------------------------------------
+-- Creates the `carrier_coverage` and `carrier_rate_cards`
+-- tables used to quote the shipping of an order.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+CREATE TABLE IF NOT EXISTS `carrier_coverage`
+(
+    `id`          SERIAL,
+    `carrier_id`  BIGINT UNSIGNED NOT NULL,
+    `locality_id` BIGINT UNSIGNED NULL,
+    `province_id` BIGINT UNSIGNED NULL,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `FK_CARRIER_COVERAGE_CARRIER` FOREIGN KEY (`carrier_id`) REFERENCES `carriers` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `FK_CARRIER_COVERAGE_LOCALITY` FOREIGN KEY (`locality_id`) REFERENCES `localities` (`id`),
+    CONSTRAINT `FK_CARRIER_COVERAGE_PROVINCE` FOREIGN KEY (`province_id`) REFERENCES `provinces` (`id`)
+) ENGINE = InnoDB;
+
+CREATE TABLE IF NOT EXISTS `carrier_rate_cards`
+(
+    `carrier_id`             BIGINT UNSIGNED NOT NULL,
+    `base_price`             DECIMAL(19, 2)  NOT NULL,
+    `price_per_kg`           DECIMAL(19, 2)  NOT NULL,
+    `price_per_km`           DECIMAL(19, 2)  NOT NULL,
+    `refrigerated_surcharge` DECIMAL(19, 2)  NOT NULL,
+    PRIMARY KEY (`carrier_id`),
+    CONSTRAINT `FK_CARRIER_RATE_CARDS_CARRIER` FOREIGN KEY (`carrier_id`) REFERENCES `carriers` (`id`) ON DELETE CASCADE
+) ENGINE = InnoDB;
@@ -0,0 +1,34 @@
+-- -----------------------------------------------------
+-- Seeds `order_status` with the purchase order lifecycle
+-- and creates the `purchase_order_status_history` table.
+-- Orders without a known status start as created.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+INSERT IGNORE INTO `order_status` (`id`, `description`)
+VALUES (1, 'created'),
+       (2, 'confirmed'),
+       (3, 'picking'),
+       (4, 'shipped'),
+       (5, 'delivered'),
+       (6, 'cancelled'),
+       (7, 'returned');
+
+UPDATE `purchase_orders`
+SET `order_status_id` = 1
+WHERE `order_status_id` IS NULL
+   OR `order_status_id` NOT IN (1, 2, 3, 4, 5, 6, 7);
+
+CREATE TABLE IF NOT EXISTS `purchase_order_status_history`
+(
+    `id`                SERIAL,
+    `purchase_order_id` BIGINT UNSIGNED NOT NULL,
+    `from_status_id`    BIGINT UNSIGNED NULL,
+    `to_status_id`      BIGINT UNSIGNED NOT NULL,
+    `changed_at`        DATETIME        NOT NULL,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `FK_STATUS_HISTORY_PURCHASE_ORDER` FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders` (`id`),
+    CONSTRAINT `FK_STATUS_HISTORY_FROM_STATUS` FOREIGN KEY (`from_status_id`) REFERENCES `order_status` (`id`),
+    CONSTRAINT `FK_STATUS_HISTORY_TO_STATUS` FOREIGN KEY (`to_status_id`) REFERENCES `order_status` (`id`)
+) ENGINE = InnoDB;
@@ -0,0 +1,19 @@
+-- -----------------------------------------------------
+-- Adds the price snapshot of the purchase orders: the
+-- tax rate of the order and the unit price of each of
+-- its details. Existing details keep the current sale
+-- price of their product record as their unit price.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+ALTER TABLE `purchase_orders`
+    ADD COLUMN `tax_rate` DECIMAL(5, 4) NULL;
+
+ALTER TABLE `order_details`
+    ADD COLUMN `unit_price` DECIMAL(19, 2) NULL;
+
+UPDATE `order_details` od
+    JOIN `product_records` pr ON pr.id = od.product_record_id
+SET od.unit_price = pr.sale_price
+WHERE od.unit_price IS NULL;
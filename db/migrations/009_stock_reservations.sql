@@ -0,0 +1,24 @@
+-- -----------------------------------------------------
+-- Creates the `stock_reservations` table, which holds
+-- quantities of the product batches for the purchase
+-- orders. Orders created before this change hold no
+-- stock until they are confirmed.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+CREATE TABLE IF NOT EXISTS `stock_reservations`
+(
+    `id`                SERIAL,
+    `purchase_order_id` BIGINT UNSIGNED NOT NULL,
+    `product_batch_id`  BIGINT UNSIGNED NOT NULL,
+    `quantity`          INT(11)         NOT NULL,
+    `status`            VARCHAR(20)     NOT NULL,
+    `created_at`        DATETIME        NOT NULL,
+    `expires_at`        DATETIME        NULL,
+    PRIMARY KEY (`id`),
+    INDEX `IDX_STOCK_RESERVATIONS_BATCH_STATUS` (`product_batch_id`, `status`),
+    INDEX `IDX_STOCK_RESERVATIONS_STATUS_EXPIRES_AT` (`status`, `expires_at`),
+    CONSTRAINT `FK_STOCK_RESERVATIONS_PURCHASE_ORDER` FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders` (`id`),
+    CONSTRAINT `FK_STOCK_RESERVATIONS_PRODUCT_BATCH` FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches` (`id`)
+) ENGINE = InnoDB;
@@ -0,0 +1,16 @@
+-- -----------------------------------------------------
+-- Creates the `buyer_shelf_life` table with the minimum
+-- days the products allocated to a buyer must still
+-- have before their due date. Buyers without a row have
+-- no minimum.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+CREATE TABLE IF NOT EXISTS `buyer_shelf_life`
+(
+    `buyer_id`     BIGINT UNSIGNED NOT NULL,
+    `minimum_days` INT             NOT NULL,
+    PRIMARY KEY (`buyer_id`),
+    CONSTRAINT `FK_BUYER_SHELF_LIFE_BUYER` FOREIGN KEY (`buyer_id`) REFERENCES `buyers` (`id`)
+) ENGINE = InnoDB;
@@ -0,0 +1,15 @@
+-- -----------------------------------------------------
+-- Adds the `expired` flag of the product batches, which
+-- keeps them out of the allocations, and flags the ones
+-- already past their due date.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+ALTER TABLE `product_batches`
+    ADD COLUMN `expired` TINYINT(1) NOT NULL DEFAULT 0,
+    ADD INDEX `IDX_PRODUCT_BATCHES_DUE_DATE` (`due_date`);
+
+UPDATE `product_batches`
+SET `expired` = 1
+WHERE `due_date` < NOW();
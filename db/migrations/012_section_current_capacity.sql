@@ -0,0 +1,14 @@
+-- -----------------------------------------------------
+-- Sets the current capacity of the sections to the
+-- quantity of their product batches, which keep it up to
+-- date from now on.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+UPDATE `section` AS s
+SET s.`current_capacity` = (
+    SELECT COALESCE(SUM(b.`current_quantity`), 0)
+    FROM `product_batches` AS b
+    WHERE b.`section_id` = s.`id`
+);
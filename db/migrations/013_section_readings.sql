@@ -0,0 +1,17 @@
+-- -----------------------------------------------------
+-- Creates the `section_readings` table, which holds the
+-- temperatures measured by the sensors of the sections.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+CREATE TABLE IF NOT EXISTS `section_readings`
+(
+    `id`          SERIAL,
+    `section_id`  BIGINT UNSIGNED NOT NULL,
+    `temperature` DECIMAL(5, 2)   NOT NULL,
+    `recorded_at` DATETIME        NOT NULL,
+    PRIMARY KEY (`id`),
+    INDEX `IDX_SECTION_READINGS_SECTION_RECORDED_AT` (`section_id`, `recorded_at`),
+    CONSTRAINT `FK_SECTION_READINGS_SECTION` FOREIGN KEY (`section_id`) REFERENCES `section` (`id`)
+) ENGINE = InnoDB;
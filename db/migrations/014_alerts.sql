@@ -0,0 +1,46 @@
+-- -----------------------------------------------------
+-- Creates the `alert_rules`, `alerts` and `alert_batches`
+-- tables used to raise temperature excursion alerts.
+-- Run once on databases created before this change.
+-- -----------------------------------------------------
+USE `mercado-fresco`;
+
+CREATE TABLE IF NOT EXISTS `alert_rules`
+(
+    `id`               SERIAL,
+    `name`             VARCHAR(255)    NOT NULL,
+    `section_id`       BIGINT UNSIGNED NULL,
+    `threshold`        DECIMAL(5, 2)   NULL,
+    `duration_seconds` INT(11)         NOT NULL DEFAULT 0,
+    `created_at`       DATETIME        NOT NULL,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `FK_ALERT_RULES_SECTION` FOREIGN KEY (`section_id`) REFERENCES `section` (`id`) ON DELETE CASCADE
+) ENGINE = InnoDB;
+
+CREATE TABLE IF NOT EXISTS `alerts`
+(
+    `id`               SERIAL,
+    `rule_id`          BIGINT UNSIGNED NULL,
+    `section_id`       BIGINT UNSIGNED NOT NULL,
+    `threshold`        DECIMAL(5, 2)   NOT NULL,
+    `peak_temperature` DECIMAL(5, 2)   NOT NULL,
+    `started_at`       DATETIME        NOT NULL,
+    `detected_at`      DATETIME        NOT NULL,
+    `status`           VARCHAR(20)     NOT NULL DEFAULT 'open',
+    `acknowledged_at`  DATETIME        NULL,
+    `resolved_at`      DATETIME        NULL,
+    `notified_at`      DATETIME        NULL,
+    PRIMARY KEY (`id`),
+    INDEX `IDX_ALERTS_RULE_SECTION` (`rule_id`, `section_id`),
+    CONSTRAINT `FK_ALERTS_RULE` FOREIGN KEY (`rule_id`) REFERENCES `alert_rules` (`id`) ON DELETE SET NULL,
+    CONSTRAINT `FK_ALERTS_SECTION` FOREIGN KEY (`section_id`) REFERENCES `section` (`id`)
+) ENGINE = InnoDB;
+
+CREATE TABLE IF NOT EXISTS `alert_batches`
+(
+    `alert_id`         BIGINT UNSIGNED NOT NULL,
+    `product_batch_id` BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (`alert_id`, `product_batch_id`),
+    CONSTRAINT `FK_ALERT_BATCHES_ALERT` FOREIGN KEY (`alert_id`) REFERENCES `alerts` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `FK_ALERT_BATCHES_PRODUCT_BATCH` FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches` (`id`) ON DELETE CASCADE
+) ENGINE = InnoDB;
@@ -0,0 +1,30 @@
+package alert
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunEvaluationJob evaluates the alert rules every interval until the
+// context is done.
+func RunEvaluationJob(ctx context.Context, s Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			raised, err := s.Evaluate(ctx)
+			if err != nil {
+				log.Printf("alerts: could not evaluate the rules: %v", err)
+				continue
+			}
+			if raised > 0 {
+				log.Printf("alerts: raised %d alerts", raised)
+			}
+		}
+	}
+}
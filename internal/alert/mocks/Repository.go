@@ -0,0 +1,413 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	alert "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Acknowledge provides a mock function with given fields: ctx, id, at
+func (_m *Repository) Acknowledge(ctx context.Context, id int, at string) (bool, error) {
+	ret := _m.Called(ctx, id, at)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) bool); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AffectedBatches provides a mock function with given fields: ctx, sectionID, peak
+func (_m *Repository) AffectedBatches(ctx context.Context, sectionID int, peak float64) ([]int, error) {
+	ret := _m.Called(ctx, sectionID, peak)
+
+	var r0 []int
+	if rf, ok := ret.Get(0).(func(context.Context, int, float64) []int); ok {
+		r0 = rf(ctx, sectionID, peak)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, float64) error); ok {
+		r1 = rf(ctx, sectionID, peak)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertExists provides a mock function with given fields: ctx, ruleID, sectionID, startedAt
+func (_m *Repository) AlertExists(ctx context.Context, ruleID int, sectionID int, startedAt string) (bool, error) {
+	ret := _m.Called(ctx, ruleID, sectionID, startedAt)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) bool); ok {
+		r0 = rf(ctx, ruleID, sectionID, startedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string) error); ok {
+		r1 = rf(ctx, ruleID, sectionID, startedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchThreshold provides a mock function with given fields: ctx, sectionID
+func (_m *Repository) BatchThreshold(ctx context.Context, sectionID int) (float64, bool, error) {
+	ret := _m.Called(ctx, sectionID)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(context.Context, int) float64); ok {
+		r0 = rf(ctx, sectionID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, int) bool); ok {
+		r1 = rf(ctx, sectionID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(ctx, sectionID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *Repository) Create(ctx context.Context, _a1 alert.Alert) (alert.Alert, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 alert.Alert
+	if rf, ok := ret.Get(0).(func(context.Context, alert.Alert) alert.Alert); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(alert.Alert)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, alert.Alert) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRule provides a mock function with given fields: ctx, rule
+func (_m *Repository) CreateRule(ctx context.Context, rule alert.Rule) (alert.Rule, error) {
+	ret := _m.Called(ctx, rule)
+
+	var r0 alert.Rule
+	if rf, ok := ret.Get(0).(func(context.Context, alert.Rule) alert.Rule); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		r0 = ret.Get(0).(alert.Rule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, alert.Rule) error); ok {
+		r1 = rf(ctx, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRule provides a mock function with given fields: ctx, id
+func (_m *Repository) DeleteRule(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Excursions provides a mock function with given fields: ctx, sectionID, threshold, afterReadingID
+func (_m *Repository) Excursions(ctx context.Context, sectionID int, threshold float64, afterReadingID int) ([]alert.Excursion, error) {
+	ret := _m.Called(ctx, sectionID, threshold, afterReadingID)
+
+	var r0 []alert.Excursion
+	if rf, ok := ret.Get(0).(func(context.Context, int, float64, int) []alert.Excursion); ok {
+		r0 = rf(ctx, sectionID, threshold, afterReadingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]alert.Excursion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, float64, int) error); ok {
+		r1 = rf(ctx, sectionID, threshold, afterReadingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *Repository) GetAll(ctx context.Context, filter alert.Filter) ([]alert.Alert, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []alert.Alert
+	if rf, ok := ret.Get(0).(func(context.Context, alert.Filter) []alert.Alert); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]alert.Alert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, alert.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Repository) GetByID(ctx context.Context, id int) (alert.Alert, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 alert.Alert
+	if rf, ok := ret.Get(0).(func(context.Context, int) alert.Alert); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(alert.Alert)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRule provides a mock function with given fields: ctx, id
+func (_m *Repository) GetRule(ctx context.Context, id int) (alert.Rule, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 alert.Rule
+	if rf, ok := ret.Get(0).(func(context.Context, int) alert.Rule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(alert.Rule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LastReadingID provides a mock function with given fields: ctx
+func (_m *Repository) LastReadingID(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkNotified provides a mock function with given fields: ctx, id, at
+func (_m *Repository) MarkNotified(ctx context.Context, id int, at string) error {
+	ret := _m.Called(ctx, id, at)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Resolve provides a mock function with given fields: ctx, id, at
+func (_m *Repository) Resolve(ctx context.Context, id int, at string) (bool, error) {
+	ret := _m.Called(ctx, id, at)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) bool); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rules provides a mock function with given fields: ctx
+func (_m *Repository) Rules(ctx context.Context) ([]alert.Rule, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []alert.Rule
+	if rf, ok := ret.Get(0).(func(context.Context) []alert.Rule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]alert.Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SectionExists provides a mock function with given fields: ctx, sectionID
+func (_m *Repository) SectionExists(ctx context.Context, sectionID int) (bool, error) {
+	ret := _m.Called(ctx, sectionID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = rf(ctx, sectionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, sectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SectionsWithReadings provides a mock function with given fields: ctx
+func (_m *Repository) SectionsWithReadings(ctx context.Context) ([]int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []int
+	if rf, ok := ret.Get(0).(func(context.Context) []int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Undelivered provides a mock function with given fields: ctx
+func (_m *Repository) Undelivered(ctx context.Context) ([]alert.Alert, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []alert.Alert
+	if rf, ok := ret.Get(0).(func(context.Context) []alert.Alert); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]alert.Alert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,216 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	alert "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// Acknowledge provides a mock function with given fields: ctx, id
+func (_m *Service) Acknowledge(ctx context.Context, id int) (alert.Alert, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 alert.Alert
+	if rf, ok := ret.Get(0).(func(context.Context, int) alert.Alert); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(alert.Alert)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRule provides a mock function with given fields: ctx, rule
+func (_m *Service) CreateRule(ctx context.Context, rule alert.Rule) (alert.Rule, error) {
+	ret := _m.Called(ctx, rule)
+
+	var r0 alert.Rule
+	if rf, ok := ret.Get(0).(func(context.Context, alert.Rule) alert.Rule); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		r0 = ret.Get(0).(alert.Rule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, alert.Rule) error); ok {
+		r1 = rf(ctx, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRule provides a mock function with given fields: ctx, id
+func (_m *Service) DeleteRule(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Evaluate provides a mock function with given fields: ctx
+func (_m *Service) Evaluate(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *Service) GetAll(ctx context.Context, filter alert.Filter) ([]alert.Alert, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []alert.Alert
+	if rf, ok := ret.Get(0).(func(context.Context, alert.Filter) []alert.Alert); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]alert.Alert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, alert.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Service) GetByID(ctx context.Context, id int) (alert.Alert, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 alert.Alert
+	if rf, ok := ret.Get(0).(func(context.Context, int) alert.Alert); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(alert.Alert)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRule provides a mock function with given fields: ctx, id
+func (_m *Service) GetRule(ctx context.Context, id int) (alert.Rule, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 alert.Rule
+	if rf, ok := ret.Get(0).(func(context.Context, int) alert.Rule); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(alert.Rule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Resolve provides a mock function with given fields: ctx, id
+func (_m *Service) Resolve(ctx context.Context, id int) (alert.Alert, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 alert.Alert
+	if rf, ok := ret.Get(0).(func(context.Context, int) alert.Alert); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(alert.Alert)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rules provides a mock function with given fields: ctx
+func (_m *Service) Rules(ctx context.Context) ([]alert.Rule, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []alert.Rule
+	if rf, ok := ret.Get(0).(func(context.Context) []alert.Rule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]alert.Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,60 @@
+package alert
+
+const (
+	STATUS_OPEN         = "open"
+	STATUS_ACKNOWLEDGED = "acknowledged"
+	STATUS_RESOLVED     = "resolved"
+
+	ERR_RULE_NOT_FOUND    = "alert rule with id (%d) not found"
+	ERR_ALERT_NOT_FOUND   = "alert with id (%d) not found"
+	ERR_SECTION_NOT_FOUND = "section with id (%d) not found"
+	ERR_INVALID_DURATION  = "the duration can't be negative"
+	ERR_INVALID_STATUS    = "status must be open, acknowledged or resolved"
+	ERR_NOT_OPEN          = "only open alerts can be acknowledged"
+	ERR_ALREADY_RESOLVED  = "the alert is already resolved"
+)
+
+// Rule raises an alert when the readings of a section stay above the
+// threshold for the duration. Rules without a section watch every section
+// with readings, and rules without a threshold use the lowest minimum
+// temperature of the batches stored in the section.
+type Rule struct {
+	ID              int      `json:"id"`
+	Name            string   `json:"name"`
+	SectionID       *int     `json:"section_id"`
+	Threshold       *float64 `json:"threshold"`
+	DurationSeconds int      `json:"duration_seconds"`
+	CreatedAt       string   `json:"created_at"`
+}
+
+// Alert is a temperature excursion of a section found by a rule, with the
+// product batches that were stored above their minimum temperature.
+type Alert struct {
+	ID              int     `json:"id"`
+	RuleID          *int    `json:"rule_id"`
+	SectionID       int     `json:"section_id"`
+	Threshold       float64 `json:"threshold"`
+	PeakTemperature float64 `json:"peak_temperature"`
+	StartedAt       string  `json:"started_at"`
+	DetectedAt      string  `json:"detected_at"`
+	Status          string  `json:"status"`
+	AcknowledgedAt  *string `json:"acknowledged_at"`
+	ResolvedAt      *string `json:"resolved_at"`
+	NotifiedAt      *string `json:"notified_at"`
+	ProductBatchIDs []int   `json:"product_batch_ids"`
+}
+
+// Excursion is a run of readings of a section above a threshold. It is
+// closed when a reading within the threshold follows it; LastReadingAt is
+// the last reading above the threshold either way.
+type Excursion struct {
+	StartedAt     string
+	LastReadingAt string
+	Peak          float64
+}
+
+// Filter narrows the alerts listed. Zero values don't filter.
+type Filter struct {
+	Status    string
+	SectionID int
+}
@@ -0,0 +1,63 @@
+package alert
+
+const (
+	SqlCreateRule = "INSERT INTO alert_rules (name, section_id, threshold, duration_seconds, created_at) VALUES (?, ?, ?, ?, ?)"
+
+	SqlGetRules = "SELECT id, name, section_id, threshold, duration_seconds, created_at FROM alert_rules"
+
+	SqlDeleteRule = "DELETE FROM alert_rules WHERE id = ?"
+
+	SqlSectionExists = "SELECT COUNT(*) FROM section WHERE id = ?"
+
+	SqlSectionsWithReadings = "SELECT DISTINCT section_id FROM section_readings ORDER BY section_id"
+
+	// The batches that ran out or expired don't need to be kept cold.
+	SqlBatchThreshold = `SELECT MIN(minimum_temperature) FROM product_batches
+		WHERE section_id = ? AND expired = 0 AND current_quantity > 0`
+
+	SqlAffectedBatches = `SELECT id FROM product_batches
+		WHERE section_id = ? AND expired = 0 AND current_quantity > 0 AND minimum_temperature < ?
+		ORDER BY id`
+)
+
+const (
+	SqlLastReadingID = "SELECT COALESCE(MAX(id), 0) FROM section_readings"
+
+	// Readings can be saved late, so the new ones are found by id and not by
+	// the time they were recorded.
+	SqlFirstNewReading = "SELECT MIN(recorded_at) FROM section_readings WHERE section_id = ? AND id > ?"
+
+	// The readings from the last one within the threshold before the first
+	// new reading, so the excursion that new reading belongs to is read
+	// whole.
+	SqlExcursionReadings = `SELECT temperature, recorded_at FROM section_readings
+		WHERE section_id = ? AND recorded_at > COALESCE(
+			(SELECT MAX(recorded_at) FROM section_readings WHERE section_id = ? AND temperature <= ? AND recorded_at < ?),
+			'1000-01-01')
+		ORDER BY recorded_at, id`
+)
+
+const (
+	SqlCreateAlert = `INSERT INTO alerts (rule_id, section_id, threshold, peak_temperature, started_at, detected_at, status)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	SqlCreateAlertBatch = "INSERT INTO alert_batches (alert_id, product_batch_id) VALUES (?, ?)"
+
+	// A rule raises one alert per excursion, and no new ones while the
+	// section has an alert of the rule that isn't resolved.
+	SqlAlertExists = `SELECT COUNT(*) FROM alerts
+		WHERE rule_id = ? AND section_id = ? AND (status <> 'resolved' OR started_at = ?)`
+
+	SqlGetAlerts = `SELECT a.id, a.rule_id, a.section_id, a.threshold, a.peak_temperature, a.started_at, a.detected_at,
+		a.status, a.acknowledged_at, a.resolved_at, a.notified_at,
+		COALESCE(GROUP_CONCAT(ab.product_batch_id ORDER BY ab.product_batch_id), '')
+		FROM alerts a LEFT JOIN alert_batches ab ON ab.alert_id = a.id`
+
+	SqlGetAlertsGroupBy = " GROUP BY a.id ORDER BY a.detected_at DESC, a.id DESC"
+
+	SqlAcknowledge = "UPDATE alerts SET status = 'acknowledged', acknowledged_at = ? WHERE id = ? AND status = 'open'"
+
+	SqlResolve = "UPDATE alerts SET status = 'resolved', resolved_at = ? WHERE id = ? AND status <> 'resolved'"
+
+	SqlMarkNotified = "UPDATE alerts SET notified_at = ? WHERE id = ?"
+)
@@ -0,0 +1,424 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Repository interface {
+	CreateRule(ctx context.Context, rule Rule) (Rule, error)
+	GetRule(ctx context.Context, id int) (Rule, error)
+	Rules(ctx context.Context) ([]Rule, error)
+	DeleteRule(ctx context.Context, id int) error
+	SectionExists(ctx context.Context, sectionID int) (bool, error)
+	SectionsWithReadings(ctx context.Context) ([]int, error)
+	BatchThreshold(ctx context.Context, sectionID int) (float64, bool, error)
+	LastReadingID(ctx context.Context) (int, error)
+	Excursions(ctx context.Context, sectionID int, threshold float64, afterReadingID int) ([]Excursion, error)
+	AffectedBatches(ctx context.Context, sectionID int, peak float64) ([]int, error)
+	AlertExists(ctx context.Context, ruleID, sectionID int, startedAt string) (bool, error)
+	Create(ctx context.Context, alert Alert) (Alert, error)
+	GetAll(ctx context.Context, filter Filter) ([]Alert, error)
+	GetByID(ctx context.Context, id int) (Alert, error)
+	Undelivered(ctx context.Context) ([]Alert, error)
+	Acknowledge(ctx context.Context, id int, at string) (bool, error)
+	Resolve(ctx context.Context, id int, at string) (bool, error)
+	MarkNotified(ctx context.Context, id int, at string) error
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r repository) CreateRule(ctx context.Context, rule Rule) (Rule, error) {
+	res, err := r.db.ExecContext(ctx, SqlCreateRule, rule.Name, rule.SectionID, rule.Threshold, rule.DurationSeconds,
+		rule.CreatedAt)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	lastID, _ := res.LastInsertId()
+	rule.ID = int(lastID)
+
+	return rule, nil
+}
+
+func (r repository) GetRule(ctx context.Context, id int) (Rule, error) {
+	rule, err := scanRule(r.db.QueryRowContext(ctx, SqlGetRules+" WHERE id = ?", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Rule{}, fmt.Errorf(ERR_RULE_NOT_FOUND, id)
+	}
+	if err != nil {
+		return Rule{}, err
+	}
+
+	return rule, nil
+}
+
+func (r repository) Rules(ctx context.Context) ([]Rule, error) {
+	rows, err := r.db.QueryContext(ctx, SqlGetRules+" ORDER BY id")
+	if err != nil {
+		return []Rule{}, err
+	}
+
+	defer rows.Close()
+
+	rules := []Rule{}
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return []Rule{}, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+func (r repository) DeleteRule(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, SqlDeleteRule, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected <= 0 {
+		return fmt.Errorf(ERR_RULE_NOT_FOUND, id)
+	}
+
+	return nil
+}
+
+func (r repository) SectionExists(ctx context.Context, sectionID int) (bool, error) {
+	var count int
+
+	err := r.db.QueryRowContext(ctx, SqlSectionExists, sectionID).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r repository) SectionsWithReadings(ctx context.Context) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, SqlSectionsWithReadings)
+	if err != nil {
+		return []int{}, err
+	}
+
+	defer rows.Close()
+
+	sections := []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return []int{}, err
+		}
+
+		sections = append(sections, id)
+	}
+
+	return sections, rows.Err()
+}
+
+// BatchThreshold returns the lowest minimum temperature of the batches in
+// the section, and false when it has none.
+func (r repository) BatchThreshold(ctx context.Context, sectionID int) (float64, bool, error) {
+	var threshold sql.NullFloat64
+
+	err := r.db.QueryRowContext(ctx, SqlBatchThreshold, sectionID).Scan(&threshold)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return threshold.Float64, threshold.Valid, nil
+}
+
+func (r repository) LastReadingID(ctx context.Context) (int, error) {
+	var id int
+
+	err := r.db.QueryRowContext(ctx, SqlLastReadingID).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Excursions lists the runs of readings of the section above the threshold,
+// closed or still open, from the one holding the first reading saved after
+// afterReadingID. There are none when no reading was saved since.
+func (r repository) Excursions(ctx context.Context, sectionID int, threshold float64, afterReadingID int) ([]Excursion, error) {
+	var firstNew sql.NullString
+
+	err := r.db.QueryRowContext(ctx, SqlFirstNewReading, sectionID, afterReadingID).Scan(&firstNew)
+	if err != nil {
+		return []Excursion{}, err
+	}
+	if !firstNew.Valid {
+		return []Excursion{}, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, SqlExcursionReadings, sectionID, sectionID, threshold, firstNew.String)
+	if err != nil {
+		return []Excursion{}, err
+	}
+
+	defer rows.Close()
+
+	excursions := []Excursion{}
+	var current *Excursion
+	for rows.Next() {
+		var temperature float64
+		var recordedAt string
+		if err := rows.Scan(&temperature, &recordedAt); err != nil {
+			return []Excursion{}, err
+		}
+
+		if temperature <= threshold {
+			if current != nil {
+				excursions = append(excursions, *current)
+				current = nil
+			}
+			continue
+		}
+
+		if current == nil {
+			current = &Excursion{StartedAt: recordedAt, Peak: temperature}
+		}
+		current.LastReadingAt = recordedAt
+		if temperature > current.Peak {
+			current.Peak = temperature
+		}
+	}
+
+	if current != nil {
+		excursions = append(excursions, *current)
+	}
+
+	return excursions, rows.Err()
+}
+
+func (r repository) AffectedBatches(ctx context.Context, sectionID int, peak float64) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, SqlAffectedBatches, sectionID, peak)
+	if err != nil {
+		return []int{}, err
+	}
+
+	defer rows.Close()
+
+	batches := []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return []int{}, err
+		}
+
+		batches = append(batches, id)
+	}
+
+	return batches, rows.Err()
+}
+
+func (r repository) AlertExists(ctx context.Context, ruleID, sectionID int, startedAt string) (bool, error) {
+	var count int
+
+	err := r.db.QueryRowContext(ctx, SqlAlertExists, ruleID, sectionID, startedAt).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Create stores the alert and links it to the affected batches in the same
+// transaction.
+func (r repository) Create(ctx context.Context, alert Alert) (Alert, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Alert{}, err
+	}
+
+	res, err := tx.ExecContext(ctx, SqlCreateAlert, alert.RuleID, alert.SectionID, alert.Threshold,
+		alert.PeakTemperature, alert.StartedAt, alert.DetectedAt, alert.Status)
+	if err != nil {
+		tx.Rollback()
+		return Alert{}, err
+	}
+
+	lastID, _ := res.LastInsertId()
+	alert.ID = int(lastID)
+
+	for _, batchID := range alert.ProductBatchIDs {
+		_, err = tx.ExecContext(ctx, SqlCreateAlertBatch, alert.ID, batchID)
+		if err != nil {
+			tx.Rollback()
+			return Alert{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Alert{}, err
+	}
+
+	return alert, nil
+}
+
+func (r repository) GetAll(ctx context.Context, filter Filter) ([]Alert, error) {
+	var where []string
+	var args []interface{}
+
+	if filter.Status != "" {
+		where = append(where, "a.status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.SectionID != 0 {
+		where = append(where, "a.section_id = ?")
+		args = append(args, filter.SectionID)
+	}
+
+	return r.query(ctx, where, args)
+}
+
+func (r repository) GetByID(ctx context.Context, id int) (Alert, error) {
+	alerts, err := r.query(ctx, []string{"a.id = ?"}, []interface{}{id})
+	if err != nil {
+		return Alert{}, err
+	}
+	if len(alerts) == 0 {
+		return Alert{}, fmt.Errorf(ERR_ALERT_NOT_FOUND, id)
+	}
+
+	return alerts[0], nil
+}
+
+// Undelivered returns the alerts that weren't sent to the webhook yet,
+// leaving out the ones resolved meanwhile.
+func (r repository) Undelivered(ctx context.Context) ([]Alert, error) {
+	return r.query(ctx, []string{"a.notified_at IS NULL", "a.status <> 'resolved'"}, nil)
+}
+
+func (r repository) Acknowledge(ctx context.Context, id int, at string) (bool, error) {
+	return r.update(ctx, SqlAcknowledge, at, id)
+}
+
+func (r repository) Resolve(ctx context.Context, id int, at string) (bool, error) {
+	return r.update(ctx, SqlResolve, at, id)
+}
+
+func (r repository) MarkNotified(ctx context.Context, id int, at string) error {
+	_, err := r.db.ExecContext(ctx, SqlMarkNotified, at, id)
+	return err
+}
+
+func (r repository) update(ctx context.Context, query string, at string, id int) (bool, error) {
+	res, err := r.db.ExecContext(ctx, query, at, id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected > 0, nil
+}
+
+func (r repository) query(ctx context.Context, where []string, args []interface{}) ([]Alert, error) {
+	query := SqlGetAlerts
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += SqlGetAlertsGroupBy
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []Alert{}, err
+	}
+
+	defer rows.Close()
+
+	alerts := []Alert{}
+	for rows.Next() {
+		alert, err := scanAlert(rows)
+		if err != nil {
+			return []Alert{}, err
+		}
+
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRule(row scanner) (Rule, error) {
+	var rule Rule
+	var sectionID sql.NullInt64
+	var threshold sql.NullFloat64
+
+	err := row.Scan(&rule.ID, &rule.Name, &sectionID, &threshold, &rule.DurationSeconds, &rule.CreatedAt)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	if sectionID.Valid {
+		id := int(sectionID.Int64)
+		rule.SectionID = &id
+	}
+	if threshold.Valid {
+		rule.Threshold = &threshold.Float64
+	}
+
+	return rule, nil
+}
+
+func scanAlert(row scanner) (Alert, error) {
+	var alert Alert
+	var ruleID sql.NullInt64
+	var acknowledgedAt, resolvedAt, notifiedAt sql.NullString
+	var batches string
+
+	err := row.Scan(&alert.ID, &ruleID, &alert.SectionID, &alert.Threshold, &alert.PeakTemperature, &alert.StartedAt,
+		&alert.DetectedAt, &alert.Status, &acknowledgedAt, &resolvedAt, &notifiedAt, &batches)
+	if err != nil {
+		return Alert{}, err
+	}
+
+	if ruleID.Valid {
+		id := int(ruleID.Int64)
+		alert.RuleID = &id
+	}
+	alert.AcknowledgedAt = nullString(acknowledgedAt)
+	alert.ResolvedAt = nullString(resolvedAt)
+	alert.NotifiedAt = nullString(notifiedAt)
+
+	alert.ProductBatchIDs = []int{}
+	if batches != "" {
+		for _, id := range strings.Split(batches, ",") {
+			batchID, err := strconv.Atoi(id)
+			if err != nil {
+				return Alert{}, err
+			}
+			alert.ProductBatchIDs = append(alert.ProductBatchIDs, batchID)
+		}
+	}
+
+	return alert, nil
+}
+
+func nullString(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	return &value.String
+}
@@ -0,0 +1,223 @@
+package alert_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert"
+	"github.com/stretchr/testify/assert"
+)
+
+var alertColumns = []string{"id", "rule_id", "section_id", "threshold", "peak_temperature", "started_at", "detected_at",
+	"status", "acknowledged_at", "resolved_at", "notified_at", "product_batch_ids"}
+
+func InitTestRepository(t *testing.T) (sqlmock.Sqlmock, alert.Repository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	return mock, alert.NewRepository(db)
+}
+
+func TestRepositoryRules(t *testing.T) {
+	t.Run("create_rule_ok", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+		sectionID := 3
+		rule := alert.Rule{Name: "freezer", SectionID: &sectionID, DurationSeconds: 600, CreatedAt: "2026-10-19 10:00:00"}
+
+		mock.ExpectExec(regexp.QuoteMeta(alert.SqlCreateRule)).WithArgs("freezer", &sectionID, nil, 600, "2026-10-19 10:00:00").
+			WillReturnResult(sqlmock.NewResult(4, 1))
+
+		created, err := repository.CreateRule(context.TODO(), rule)
+
+		rule.ID = 4
+		assert.NoError(t, err)
+		assert.Equal(t, rule, created)
+	})
+
+	t.Run("rules_ok", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+		rows := sqlmock.NewRows([]string{"id", "name", "section_id", "threshold", "duration_seconds", "created_at"}).
+			AddRow(1, "freezer", 3, "-15.00", 600, "2026-10-19 10:00:00").
+			AddRow(2, "batches", nil, nil, 0, "2026-10-19 10:00:00")
+
+		mock.ExpectQuery(regexp.QuoteMeta(alert.SqlGetRules)).WillReturnRows(rows)
+
+		rules, err := repository.Rules(context.TODO())
+
+		sectionID, threshold := 3, -15.0
+		assert.NoError(t, err)
+		assert.Equal(t, []alert.Rule{
+			{ID: 1, Name: "freezer", SectionID: &sectionID, Threshold: &threshold, DurationSeconds: 600, CreatedAt: "2026-10-19 10:00:00"},
+			{ID: 2, Name: "batches", CreatedAt: "2026-10-19 10:00:00"},
+		}, rules)
+	})
+
+	t.Run("delete_rule_not_found", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectExec(regexp.QuoteMeta(alert.SqlDeleteRule)).WithArgs(9).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repository.DeleteRule(context.TODO(), 9)
+
+		assert.Equal(t, fmt.Errorf(alert.ERR_RULE_NOT_FOUND, 9), err)
+	})
+}
+
+func TestRepositoryExcursion(t *testing.T) {
+	t.Run("excursions_ok", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(alert.SqlFirstNewReading)).WithArgs(3, 40).
+			WillReturnRows(sqlmock.NewRows([]string{"recorded_at"}).AddRow("2026-10-19 10:05:00"))
+		mock.ExpectQuery(regexp.QuoteMeta(alert.SqlExcursionReadings)).WithArgs(3, 3, -15.0, "2026-10-19 10:05:00").
+			WillReturnRows(sqlmock.NewRows([]string{"temperature", "recorded_at"}).
+				AddRow("-12.00", "2026-10-19 10:00:00").
+				AddRow("-10.50", "2026-10-19 10:05:00").
+				AddRow("-11.00", "2026-10-19 10:20:00").
+				AddRow("-18.00", "2026-10-19 10:25:00").
+				AddRow("-14.00", "2026-10-19 10:30:00"))
+
+		excursions, err := repository.Excursions(context.TODO(), 3, -15, 40)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []alert.Excursion{
+			{StartedAt: "2026-10-19 10:00:00", LastReadingAt: "2026-10-19 10:20:00", Peak: -10.5},
+			{StartedAt: "2026-10-19 10:30:00", LastReadingAt: "2026-10-19 10:30:00", Peak: -14},
+		}, excursions)
+	})
+
+	t.Run("excursions_within_threshold", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(alert.SqlFirstNewReading)).WithArgs(3, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"recorded_at"}).AddRow("2026-10-19 10:20:00"))
+		mock.ExpectQuery(regexp.QuoteMeta(alert.SqlExcursionReadings)).WithArgs(3, 3, -15.0, "2026-10-19 10:20:00").
+			WillReturnRows(sqlmock.NewRows([]string{"temperature", "recorded_at"}).AddRow("-18.00", "2026-10-19 10:20:00"))
+
+		excursions, err := repository.Excursions(context.TODO(), 3, -15, 0)
+
+		assert.NoError(t, err)
+		assert.Empty(t, excursions)
+	})
+
+	t.Run("excursions_without_new_readings", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(alert.SqlFirstNewReading)).WithArgs(3, 40).
+			WillReturnRows(sqlmock.NewRows([]string{"recorded_at"}).AddRow(nil))
+
+		excursions, err := repository.Excursions(context.TODO(), 3, -15, 40)
+
+		assert.NoError(t, err)
+		assert.Empty(t, excursions)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("last_reading_id", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(alert.SqlLastReadingID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(42))
+
+		id, err := repository.LastReadingID(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 42, id)
+	})
+
+	t.Run("batch_threshold_without_batches", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(alert.SqlBatchThreshold)).WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"threshold"}).AddRow(nil))
+
+		_, found, err := repository.BatchThreshold(context.TODO(), 3)
+
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+}
+
+func TestRepositoryAlerts(t *testing.T) {
+	ruleID := 1
+
+	t.Run("create_ok", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+		exp := alert.Alert{RuleID: &ruleID, SectionID: 3, Threshold: -15, PeakTemperature: -10.5,
+			StartedAt: "2026-10-19 10:00:00", DetectedAt: "2026-10-19 10:21:00", Status: alert.STATUS_OPEN,
+			ProductBatchIDs: []int{7, 8}}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(alert.SqlCreateAlert)).
+			WithArgs(&ruleID, 3, -15.0, -10.5, "2026-10-19 10:00:00", "2026-10-19 10:21:00", alert.STATUS_OPEN).
+			WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectExec(regexp.QuoteMeta(alert.SqlCreateAlertBatch)).WithArgs(5, 7).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(alert.SqlCreateAlertBatch)).WithArgs(5, 8).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		created, err := repository.Create(context.TODO(), exp)
+
+		exp.ID = 5
+		assert.NoError(t, err)
+		assert.Equal(t, exp, created)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("create_fail_batch", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(alert.SqlCreateAlert)).WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectExec(regexp.QuoteMeta(alert.SqlCreateAlertBatch)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		_, err := repository.Create(context.TODO(), alert.Alert{ProductBatchIDs: []int{7}})
+
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("get_all_filtered", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+		rows := sqlmock.NewRows(alertColumns).
+			AddRow(5, 1, 3, "-15.00", "-10.50", "2026-10-19 10:00:00", "2026-10-19 10:21:00", "acknowledged",
+				"2026-10-19 10:30:00", nil, "2026-10-19 10:21:01", "7,8")
+
+		query := alert.SqlGetAlerts + " WHERE a.status = ? AND a.section_id = ?" + alert.SqlGetAlertsGroupBy
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(alert.STATUS_ACKNOWLEDGED, 3).WillReturnRows(rows)
+
+		alerts, err := repository.GetAll(context.TODO(), alert.Filter{Status: alert.STATUS_ACKNOWLEDGED, SectionID: 3})
+
+		acknowledgedAt, notifiedAt := "2026-10-19 10:30:00", "2026-10-19 10:21:01"
+		assert.NoError(t, err)
+		assert.Equal(t, []alert.Alert{{ID: 5, RuleID: &ruleID, SectionID: 3, Threshold: -15, PeakTemperature: -10.5,
+			StartedAt: "2026-10-19 10:00:00", DetectedAt: "2026-10-19 10:21:00", Status: alert.STATUS_ACKNOWLEDGED,
+			AcknowledgedAt: &acknowledgedAt, NotifiedAt: &notifiedAt, ProductBatchIDs: []int{7, 8}}}, alerts)
+	})
+
+	t.Run("get_by_id_not_found", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(alert.SqlGetAlerts)).WithArgs(9).WillReturnRows(sqlmock.NewRows(alertColumns))
+
+		_, err := repository.GetByID(context.TODO(), 9)
+
+		assert.Equal(t, fmt.Errorf(alert.ERR_ALERT_NOT_FOUND, 9), err)
+	})
+
+	t.Run("acknowledge_not_open", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectExec(regexp.QuoteMeta(alert.SqlAcknowledge)).WithArgs("2026-10-19 10:30:00", 5).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		updated, err := repository.Acknowledge(context.TODO(), 5, "2026-10-19 10:30:00")
+
+		assert.NoError(t, err)
+		assert.False(t, updated)
+	})
+}
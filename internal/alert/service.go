@@ -0,0 +1,282 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	dateTimeLayout = "2006-01-02 15:04:05"
+)
+
+type Service interface {
+	CreateRule(ctx context.Context, rule Rule) (Rule, error)
+	GetRule(ctx context.Context, id int) (Rule, error)
+	Rules(ctx context.Context) ([]Rule, error)
+	DeleteRule(ctx context.Context, id int) error
+	Evaluate(ctx context.Context) (int, error)
+	GetAll(ctx context.Context, filter Filter) ([]Alert, error)
+	GetByID(ctx context.Context, id int) (Alert, error)
+	Acknowledge(ctx context.Context, id int) (Alert, error)
+	Resolve(ctx context.Context, id int) (Alert, error)
+}
+
+type service struct {
+	repository Repository
+	notifier   Notifier
+
+	// mu guards lastReadingID, the last section reading looked at by an
+	// evaluation that went through.
+	mu            sync.Mutex
+	lastReadingID int
+}
+
+// NewService returns the alerts service. Alerts are only listed, and not
+// delivered, when the notifier is nil.
+func NewService(r Repository, notifier Notifier) Service {
+	return &service{repository: r, notifier: notifier}
+}
+
+func (s *service) CreateRule(ctx context.Context, rule Rule) (Rule, error) {
+	if rule.DurationSeconds < 0 {
+		return Rule{}, fmt.Errorf(ERR_INVALID_DURATION)
+	}
+
+	if rule.SectionID != nil {
+		exists, err := s.repository.SectionExists(ctx, *rule.SectionID)
+		if err != nil {
+			return Rule{}, err
+		}
+		if !exists {
+			return Rule{}, fmt.Errorf(ERR_SECTION_NOT_FOUND, *rule.SectionID)
+		}
+	}
+
+	rule.CreatedAt = time.Now().Format(dateTimeLayout)
+
+	return s.repository.CreateRule(ctx, rule)
+}
+
+func (s *service) GetRule(ctx context.Context, id int) (Rule, error) {
+	return s.repository.GetRule(ctx, id)
+}
+
+func (s *service) Rules(ctx context.Context) ([]Rule, error) {
+	return s.repository.Rules(ctx)
+}
+
+func (s *service) DeleteRule(ctx context.Context, id int) error {
+	return s.repository.DeleteRule(ctx, id)
+}
+
+// Evaluate checks every rule against the readings saved since the last
+// evaluation, raises an alert for each excursion that lasted the duration of
+// the rule, even the ones that were already back within the threshold, and
+// delivers the alerts that weren't delivered yet. It returns how many alerts
+// were raised. A rule that fails on a section is logged and checked again on
+// the next evaluation, without holding back the other rules nor the
+// delivery.
+func (s *service) Evaluate(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules, err := s.repository.Rules(ctx)
+	if err != nil {
+		s.deliverAfterFailure(ctx)
+		return 0, err
+	}
+
+	upTo, err := s.repository.LastReadingID(ctx)
+	if err != nil {
+		s.deliverAfterFailure(ctx)
+		return 0, err
+	}
+
+	var withReadings []int
+	var withReadingsErr error
+	loaded := false
+	failed := false
+	raised := 0
+
+	for _, rule := range rules {
+		sections := []int{}
+		if rule.SectionID != nil {
+			sections = append(sections, *rule.SectionID)
+		} else {
+			if !loaded {
+				withReadings, withReadingsErr = s.repository.SectionsWithReadings(ctx)
+				loaded = true
+			}
+			if withReadingsErr != nil {
+				log.Printf("alerts: could not evaluate rule %d: %v", rule.ID, withReadingsErr)
+				failed = true
+				continue
+			}
+			sections = withReadings
+		}
+
+		for _, sectionID := range sections {
+			alerted, err := s.evaluate(ctx, rule, sectionID)
+			if err != nil {
+				log.Printf("alerts: could not evaluate rule %d on section %d: %v", rule.ID, sectionID, err)
+				failed = true
+				continue
+			}
+			raised += alerted
+		}
+	}
+
+	// The readings are looked at again while an evaluation fails; the
+	// excursions already alerted aren't raised twice.
+	if !failed {
+		s.lastReadingID = upTo
+	}
+
+	return raised, s.deliver(ctx)
+}
+
+// evaluate raises an alert for each excursion of the section, among the ones
+// holding readings saved since the last evaluation, that lasted the duration
+// of the rule. It returns how many alerts were raised.
+func (s *service) evaluate(ctx context.Context, rule Rule, sectionID int) (int, error) {
+	var threshold float64
+	if rule.Threshold != nil {
+		threshold = *rule.Threshold
+	} else {
+		batchThreshold, found, err := s.repository.BatchThreshold(ctx, sectionID)
+		if err != nil || !found {
+			return 0, err
+		}
+		threshold = batchThreshold
+	}
+
+	excursions, err := s.repository.Excursions(ctx, sectionID, threshold, s.lastReadingID)
+	if err != nil {
+		return 0, err
+	}
+
+	raised := 0
+	for _, excursion := range excursions {
+		startedAt, err := time.Parse(dateTimeLayout, excursion.StartedAt)
+		if err != nil {
+			return raised, err
+		}
+		lastReadingAt, err := time.Parse(dateTimeLayout, excursion.LastReadingAt)
+		if err != nil {
+			return raised, err
+		}
+		if lastReadingAt.Sub(startedAt) < time.Duration(rule.DurationSeconds)*time.Second {
+			continue
+		}
+
+		exists, err := s.repository.AlertExists(ctx, rule.ID, sectionID, excursion.StartedAt)
+		if err != nil {
+			return raised, err
+		}
+		if exists {
+			continue
+		}
+
+		batches, err := s.repository.AffectedBatches(ctx, sectionID, excursion.Peak)
+		if err != nil {
+			return raised, err
+		}
+
+		ruleID := rule.ID
+		_, err = s.repository.Create(ctx, Alert{RuleID: &ruleID, SectionID: sectionID, Threshold: threshold,
+			PeakTemperature: excursion.Peak, StartedAt: excursion.StartedAt, DetectedAt: time.Now().Format(dateTimeLayout),
+			Status: STATUS_OPEN, ProductBatchIDs: batches})
+		if err != nil {
+			return raised, err
+		}
+		raised++
+	}
+
+	return raised, nil
+}
+
+// deliverAfterFailure still delivers the pending alerts when the rules
+// couldn't be evaluated.
+func (s *service) deliverAfterFailure(ctx context.Context) {
+	if err := s.deliver(ctx); err != nil {
+		log.Printf("alerts: could not deliver the alerts: %v", err)
+	}
+}
+
+// deliver sends the alerts that weren't delivered yet. The ones that fail
+// are sent again on the next evaluation.
+func (s *service) deliver(ctx context.Context) error {
+	if s.notifier == nil {
+		return nil
+	}
+
+	alerts, err := s.repository.Undelivered(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		if err := s.notifier.Notify(ctx, alert); err != nil {
+			log.Printf("alerts: could not deliver alert %d: %v", alert.ID, err)
+			continue
+		}
+
+		err = s.repository.MarkNotified(ctx, alert.ID, time.Now().Format(dateTimeLayout))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) GetAll(ctx context.Context, filter Filter) ([]Alert, error) {
+	switch filter.Status {
+	case "", STATUS_OPEN, STATUS_ACKNOWLEDGED, STATUS_RESOLVED:
+	default:
+		return []Alert{}, fmt.Errorf(ERR_INVALID_STATUS)
+	}
+
+	return s.repository.GetAll(ctx, filter)
+}
+
+func (s *service) GetByID(ctx context.Context, id int) (Alert, error) {
+	return s.repository.GetByID(ctx, id)
+}
+
+// Acknowledge marks an open alert as seen by an operator.
+func (s *service) Acknowledge(ctx context.Context, id int) (Alert, error) {
+	if _, err := s.repository.GetByID(ctx, id); err != nil {
+		return Alert{}, err
+	}
+
+	updated, err := s.repository.Acknowledge(ctx, id, time.Now().Format(dateTimeLayout))
+	if err != nil {
+		return Alert{}, err
+	}
+	if !updated {
+		return Alert{}, fmt.Errorf(ERR_NOT_OPEN)
+	}
+
+	return s.repository.GetByID(ctx, id)
+}
+
+// Resolve closes an open or acknowledged alert.
+func (s *service) Resolve(ctx context.Context, id int) (Alert, error) {
+	if _, err := s.repository.GetByID(ctx, id); err != nil {
+		return Alert{}, err
+	}
+
+	updated, err := s.repository.Resolve(ctx, id, time.Now().Format(dateTimeLayout))
+	if err != nil {
+		return Alert{}, err
+	}
+	if !updated {
+		return Alert{}, fmt.Errorf(ERR_ALREADY_RESOLVED)
+	}
+
+	return s.repository.GetByID(ctx, id)
+}
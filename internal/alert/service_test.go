@@ -0,0 +1,283 @@
+package alert_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceCreateRule(t *testing.T) {
+	t.Run("create_rule_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+		sectionID := 3
+
+		mockRepository.On("SectionExists", mock.Anything, 3).Return(true, nil)
+		mockRepository.On("CreateRule", mock.Anything, mock.MatchedBy(func(rule alert.Rule) bool {
+			return rule.Name == "freezer" && rule.CreatedAt != ""
+		})).Return(alert.Rule{ID: 1, Name: "freezer", SectionID: &sectionID}, nil)
+
+		rule, err := service.CreateRule(context.TODO(), alert.Rule{Name: "freezer", SectionID: &sectionID, DurationSeconds: 600})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, rule.ID)
+	})
+
+	t.Run("create_rule_section_not_found", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+		sectionID := 9
+
+		mockRepository.On("SectionExists", mock.Anything, 9).Return(false, nil)
+
+		_, err := service.CreateRule(context.TODO(), alert.Rule{Name: "freezer", SectionID: &sectionID})
+
+		assert.Equal(t, fmt.Errorf(alert.ERR_SECTION_NOT_FOUND, 9), err)
+	})
+
+	t.Run("create_rule_negative_duration", func(t *testing.T) {
+		service := alert.NewService(mocks.NewRepository(t), nil)
+
+		_, err := service.CreateRule(context.TODO(), alert.Rule{Name: "freezer", DurationSeconds: -1})
+
+		assert.Equal(t, fmt.Errorf(alert.ERR_INVALID_DURATION), err)
+	})
+}
+
+func TestServiceEvaluate(t *testing.T) {
+	threshold := -15.0
+	sectionID := 3
+	excursion := alert.Excursion{StartedAt: "2026-10-19 10:00:00", LastReadingAt: "2026-10-19 10:20:00", Peak: -10.5}
+
+	t.Run("evaluate_raises_alert", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		mockNotifier := mocks.NewNotifier(t)
+		service := alert.NewService(mockRepository, mockNotifier)
+		rule := alert.Rule{ID: 1, SectionID: &sectionID, Threshold: &threshold, DurationSeconds: 600}
+		raised := alert.Alert{ID: 5, SectionID: 3, Status: alert.STATUS_OPEN, ProductBatchIDs: []int{7}}
+
+		mockRepository.On("LastReadingID", mock.Anything).Return(50, nil)
+		mockRepository.On("Rules", mock.Anything).Return([]alert.Rule{rule}, nil)
+		mockRepository.On("Excursions", mock.Anything, 3, -15.0, 0).Return([]alert.Excursion{excursion}, nil)
+		mockRepository.On("AlertExists", mock.Anything, 1, 3, "2026-10-19 10:00:00").Return(false, nil)
+		mockRepository.On("AffectedBatches", mock.Anything, 3, -10.5).Return([]int{7}, nil)
+		mockRepository.On("Create", mock.Anything, mock.MatchedBy(func(a alert.Alert) bool {
+			return *a.RuleID == 1 && a.SectionID == 3 && a.Threshold == -15 && a.PeakTemperature == -10.5 &&
+				a.Status == alert.STATUS_OPEN && len(a.ProductBatchIDs) == 1
+		})).Return(raised, nil)
+		mockRepository.On("Undelivered", mock.Anything).Return([]alert.Alert{raised}, nil)
+		mockNotifier.On("Notify", mock.Anything, raised).Return(nil)
+		mockRepository.On("MarkNotified", mock.Anything, 5, mock.Anything).Return(nil)
+
+		count, err := service.Evaluate(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("evaluate_closed_excursions_since_last_evaluation", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+		rule := alert.Rule{ID: 1, SectionID: &sectionID, Threshold: &threshold, DurationSeconds: 600}
+		spike := alert.Excursion{StartedAt: "2026-10-19 08:00:00", LastReadingAt: "2026-10-19 08:15:00", Peak: -9}
+
+		mockRepository.On("Rules", mock.Anything).Return([]alert.Rule{rule}, nil)
+		mockRepository.On("LastReadingID", mock.Anything).Return(50, nil).Once()
+		mockRepository.On("Excursions", mock.Anything, 3, -15.0, 0).Return([]alert.Excursion{spike}, nil).Once()
+		mockRepository.On("AlertExists", mock.Anything, 1, 3, "2026-10-19 08:00:00").Return(false, nil)
+		mockRepository.On("AffectedBatches", mock.Anything, 3, -9.0).Return([]int{}, nil)
+		mockRepository.On("Create", mock.Anything, mock.MatchedBy(func(a alert.Alert) bool {
+			return a.StartedAt == "2026-10-19 08:00:00" && a.PeakTemperature == -9
+		})).Return(alert.Alert{ID: 6}, nil)
+
+		count, err := service.Evaluate(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		mockRepository.On("LastReadingID", mock.Anything).Return(55, nil).Once()
+		mockRepository.On("Excursions", mock.Anything, 3, -15.0, 50).Return([]alert.Excursion{}, nil).Once()
+
+		count, err = service.Evaluate(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("evaluate_excursion_too_short", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+		rule := alert.Rule{ID: 1, SectionID: &sectionID, Threshold: &threshold, DurationSeconds: 1800}
+
+		mockRepository.On("LastReadingID", mock.Anything).Return(50, nil)
+		mockRepository.On("Rules", mock.Anything).Return([]alert.Rule{rule}, nil)
+		mockRepository.On("Excursions", mock.Anything, 3, -15.0, 0).Return([]alert.Excursion{excursion}, nil)
+
+		count, err := service.Evaluate(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("evaluate_already_alerted", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+		rule := alert.Rule{ID: 1, SectionID: &sectionID, Threshold: &threshold, DurationSeconds: 600}
+
+		mockRepository.On("LastReadingID", mock.Anything).Return(50, nil)
+		mockRepository.On("Rules", mock.Anything).Return([]alert.Rule{rule}, nil)
+		mockRepository.On("Excursions", mock.Anything, 3, -15.0, 0).Return([]alert.Excursion{excursion}, nil)
+		mockRepository.On("AlertExists", mock.Anything, 1, 3, "2026-10-19 10:00:00").Return(true, nil)
+
+		count, err := service.Evaluate(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("evaluate_batch_threshold_every_section", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+		rule := alert.Rule{ID: 2}
+
+		mockRepository.On("LastReadingID", mock.Anything).Return(50, nil)
+		mockRepository.On("Rules", mock.Anything).Return([]alert.Rule{rule}, nil)
+		mockRepository.On("SectionsWithReadings", mock.Anything).Return([]int{3, 4}, nil)
+		mockRepository.On("BatchThreshold", mock.Anything, 3).Return(-18.0, true, nil)
+		mockRepository.On("BatchThreshold", mock.Anything, 4).Return(0.0, false, nil)
+		mockRepository.On("Excursions", mock.Anything, 3, -18.0, 0).Return([]alert.Excursion{}, nil)
+
+		count, err := service.Evaluate(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("evaluate_keeps_going_after_a_failure", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		mockNotifier := mocks.NewNotifier(t)
+		service := alert.NewService(mockRepository, mockNotifier)
+		otherSectionID := 4
+		failing := alert.Rule{ID: 1, SectionID: &otherSectionID, Threshold: &threshold, DurationSeconds: 600}
+		rule := alert.Rule{ID: 2, SectionID: &sectionID, Threshold: &threshold, DurationSeconds: 600}
+		raised := alert.Alert{ID: 5, SectionID: 3, Status: alert.STATUS_OPEN}
+
+		mockRepository.On("LastReadingID", mock.Anything).Return(50, nil)
+		mockRepository.On("Rules", mock.Anything).Return([]alert.Rule{failing, rule}, nil)
+		mockRepository.On("Excursions", mock.Anything, 4, -15.0, 0).Return(nil, errors.New("connection lost"))
+		mockRepository.On("Excursions", mock.Anything, 3, -15.0, 0).Return([]alert.Excursion{excursion}, nil)
+		mockRepository.On("AlertExists", mock.Anything, 2, 3, "2026-10-19 10:00:00").Return(false, nil)
+		mockRepository.On("AffectedBatches", mock.Anything, 3, -10.5).Return([]int{}, nil)
+		mockRepository.On("Create", mock.Anything, mock.Anything).Return(raised, nil)
+		mockRepository.On("Undelivered", mock.Anything).Return([]alert.Alert{raised}, nil)
+		mockNotifier.On("Notify", mock.Anything, raised).Return(nil)
+		mockRepository.On("MarkNotified", mock.Anything, 5, mock.Anything).Return(nil)
+
+		count, err := service.Evaluate(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("evaluate_rules_fail_still_delivers", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		mockNotifier := mocks.NewNotifier(t)
+		service := alert.NewService(mockRepository, mockNotifier)
+		pending := alert.Alert{ID: 5}
+
+		mockRepository.On("Rules", mock.Anything).Return(nil, errors.New("connection lost"))
+		mockRepository.On("Undelivered", mock.Anything).Return([]alert.Alert{pending}, nil)
+		mockNotifier.On("Notify", mock.Anything, pending).Return(nil)
+		mockRepository.On("MarkNotified", mock.Anything, 5, mock.Anything).Return(nil)
+
+		count, err := service.Evaluate(context.TODO())
+
+		assert.EqualError(t, err, "connection lost")
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("evaluate_delivery_fails", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		mockNotifier := mocks.NewNotifier(t)
+		service := alert.NewService(mockRepository, mockNotifier)
+		pending := alert.Alert{ID: 5}
+
+		mockRepository.On("LastReadingID", mock.Anything).Return(50, nil)
+		mockRepository.On("Rules", mock.Anything).Return([]alert.Rule{}, nil)
+		mockRepository.On("Undelivered", mock.Anything).Return([]alert.Alert{pending}, nil)
+		mockNotifier.On("Notify", mock.Anything, pending).Return(errors.New("the webhook answered 503"))
+
+		count, err := service.Evaluate(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+}
+
+func TestServiceAcknowledgeResolve(t *testing.T) {
+	open := alert.Alert{ID: 5, Status: alert.STATUS_OPEN}
+
+	t.Run("acknowledge_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+		acknowledged := alert.Alert{ID: 5, Status: alert.STATUS_ACKNOWLEDGED}
+
+		mockRepository.On("GetByID", mock.Anything, 5).Return(open, nil).Once()
+		mockRepository.On("Acknowledge", mock.Anything, 5, mock.Anything).Return(true, nil)
+		mockRepository.On("GetByID", mock.Anything, 5).Return(acknowledged, nil).Once()
+
+		a, err := service.Acknowledge(context.TODO(), 5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, acknowledged, a)
+	})
+
+	t.Run("acknowledge_not_open", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+
+		mockRepository.On("GetByID", mock.Anything, 5).Return(alert.Alert{ID: 5, Status: alert.STATUS_RESOLVED}, nil)
+		mockRepository.On("Acknowledge", mock.Anything, 5, mock.Anything).Return(false, nil)
+
+		_, err := service.Acknowledge(context.TODO(), 5)
+
+		assert.Equal(t, fmt.Errorf(alert.ERR_NOT_OPEN), err)
+	})
+
+	t.Run("resolve_already_resolved", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+
+		mockRepository.On("GetByID", mock.Anything, 5).Return(alert.Alert{ID: 5, Status: alert.STATUS_RESOLVED}, nil)
+		mockRepository.On("Resolve", mock.Anything, 5, mock.Anything).Return(false, nil)
+
+		_, err := service.Resolve(context.TODO(), 5)
+
+		assert.Equal(t, fmt.Errorf(alert.ERR_ALREADY_RESOLVED), err)
+	})
+
+	t.Run("resolve_not_found", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := alert.NewService(mockRepository, nil)
+
+		mockRepository.On("GetByID", mock.Anything, 9).Return(alert.Alert{}, fmt.Errorf(alert.ERR_ALERT_NOT_FOUND, 9))
+
+		_, err := service.Resolve(context.TODO(), 9)
+
+		assert.Equal(t, fmt.Errorf(alert.ERR_ALERT_NOT_FOUND, 9), err)
+	})
+
+	t.Run("get_all_invalid_status", func(t *testing.T) {
+		service := alert.NewService(mocks.NewRepository(t), nil)
+
+		_, err := service.GetAll(context.TODO(), alert.Filter{Status: "closed"})
+
+		assert.Equal(t, fmt.Errorf(alert.ERR_INVALID_STATUS), err)
+	})
+}
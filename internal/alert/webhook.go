@@ -0,0 +1,54 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WEBHOOK_TIMEOUT is how long the webhook has to answer an alert.
+const WEBHOOK_TIMEOUT = 10 * time.Second
+
+// Notifier delivers an alert to the people watching the sections.
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+type webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook returns a notifier posting the alerts as JSON to the url. Any
+// answer other than a 2xx is a failed delivery.
+func NewWebhook(url string) Notifier {
+	return &webhook{url: url, client: &http.Client{Timeout: WEBHOOK_TIMEOUT}}
+}
+
+func (w *webhook) Notify(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("the webhook answered %d", res.StatusCode)
+	}
+
+	return nil
+}
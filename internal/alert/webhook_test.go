@@ -0,0 +1,41 @@
+package alert_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/alert"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookNotify(t *testing.T) {
+	t.Run("notify_ok", func(t *testing.T) {
+		var received alert.Alert
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sent := alert.Alert{ID: 5, SectionID: 3, Status: alert.STATUS_OPEN, ProductBatchIDs: []int{7}}
+		err := alert.NewWebhook(server.URL).Notify(context.TODO(), sent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, sent, received)
+	})
+
+	t.Run("notify_rejected", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		err := alert.NewWebhook(server.URL).Notify(context.TODO(), alert.Alert{ID: 5})
+
+		assert.EqualError(t, err, "the webhook answered 503")
+	})
+}
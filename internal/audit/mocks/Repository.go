@@ -0,0 +1,74 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	audit "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, log
+func (_m *Repository) Create(ctx context.Context, log audit.Log) (audit.Log, error) {
+	ret := _m.Called(ctx, log)
+
+	var r0 audit.Log
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Log) audit.Log); ok {
+		r0 = rf(ctx, log)
+	} else {
+		r0 = ret.Get(0).(audit.Log)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, audit.Log) error); ok {
+		r1 = rf(ctx, log)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, filter
+func (_m *Repository) Search(ctx context.Context, filter audit.Filter) ([]audit.Log, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []audit.Log
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Filter) []audit.Log); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]audit.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, audit.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
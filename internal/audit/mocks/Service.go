@@ -0,0 +1,74 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	audit "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// Record provides a mock function with given fields: ctx, actor, entity, entityID, action, before, after
+func (_m *Service) Record(ctx context.Context, actor string, entity string, entityID int, action string, before interface{}, after interface{}) (audit.Log, error) {
+	ret := _m.Called(ctx, actor, entity, entityID, action, before, after)
+
+	var r0 audit.Log
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string, interface{}, interface{}) audit.Log); ok {
+		r0 = rf(ctx, actor, entity, entityID, action, before, after)
+	} else {
+		r0 = ret.Get(0).(audit.Log)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string, interface{}, interface{}) error); ok {
+		r1 = rf(ctx, actor, entity, entityID, action, before, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, filter
+func (_m *Service) Search(ctx context.Context, filter audit.Filter) ([]audit.Log, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []audit.Log
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Filter) []audit.Log); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]audit.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, audit.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,9 @@
+package audit
+
+const (
+	SqlCreate = "INSERT INTO audit_log (`actor`, `entity`, `entity_id`, `action`, `before_data`, `after_data`, `diff`, `created_at`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+
+	SqlSearch = "SELECT id, actor, entity, entity_id, action, before_data, after_data, diff, created_at FROM audit_log"
+
+	SqlOrderBy = " ORDER BY created_at, id"
+)
@@ -0,0 +1,134 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	ACTION_CREATE = "create"
+	ACTION_UPDATE = "update"
+	ACTION_DELETE = "delete"
+)
+
+type Log struct {
+	ID        int             `json:"id"`
+	Actor     string          `json:"actor"`
+	Entity    string          `json:"entity"`
+	EntityID  int             `json:"entity_id"`
+	Action    string          `json:"action"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	Diff      json.RawMessage `json:"diff,omitempty"`
+	CreatedAt string          `json:"created_at"`
+}
+
+type Filter struct {
+	Entity   string
+	EntityID int
+	From     string
+	To       string
+}
+
+type Repository interface {
+	Create(ctx context.Context, log Log) (Log, error)
+	Search(ctx context.Context, filter Filter) ([]Log, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r repository) Create(ctx context.Context, log Log) (Log, error) {
+	res, err := r.db.ExecContext(ctx, SqlCreate, log.Actor, log.Entity, log.EntityID, log.Action,
+		nullableJSON(log.Before), nullableJSON(log.After), nullableJSON(log.Diff), log.CreatedAt)
+	if err != nil {
+		return Log{}, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected <= 0 {
+		return Log{}, fmt.Errorf("sql: rows not affected")
+	}
+
+	lastID, _ := res.LastInsertId()
+	log.ID = int(lastID)
+
+	return log, nil
+}
+
+func (r repository) Search(ctx context.Context, filter Filter) ([]Log, error) {
+	var where []string
+	var args []interface{}
+
+	if filter.Entity != "" {
+		where = append(where, "entity = ?")
+		args = append(args, filter.Entity)
+	}
+	if filter.EntityID != 0 {
+		where = append(where, "entity_id = ?")
+		args = append(args, filter.EntityID)
+	}
+	if filter.From != "" {
+		where = append(where, "created_at >= ?")
+		args = append(args, filter.From)
+	}
+	if filter.To != "" {
+		where = append(where, "created_at <= ?")
+		args = append(args, filter.To)
+	}
+
+	query := SqlSearch
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += SqlOrderBy
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []Log{}, err
+	}
+
+	defer rows.Close()
+
+	logs := []Log{}
+	for rows.Next() {
+		var row Log
+		var before, after, diff sql.NullString
+
+		err = rows.Scan(&row.ID, &row.Actor, &row.Entity, &row.EntityID, &row.Action,
+			&before, &after, &diff, &row.CreatedAt)
+		if err != nil {
+			return []Log{}, err
+		}
+
+		row.Before = rawJSON(before)
+		row.After = rawJSON(after)
+		row.Diff = rawJSON(diff)
+
+		logs = append(logs, row)
+	}
+
+	return logs, nil
+}
+
+func nullableJSON(data json.RawMessage) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
+
+func rawJSON(data sql.NullString) json.RawMessage {
+	if !data.Valid {
+		return nil
+	}
+	return json.RawMessage(data.String)
+}
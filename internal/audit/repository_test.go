@@ -0,0 +1,177 @@
+package audit_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+func createLogArray() []audit.Log {
+	return []audit.Log{
+		{
+			ID:        1,
+			Actor:     "maria",
+			Entity:    "sections",
+			EntityID:  3,
+			Action:    audit.ACTION_CREATE,
+			After:     json.RawMessage(`{"id":3,"section_number":10}`),
+			Diff:      json.RawMessage(`{"id":{"before":null,"after":3},"section_number":{"before":null,"after":10}}`),
+			CreatedAt: "2022-08-01 10:00:00",
+		},
+		{
+			ID:        2,
+			Actor:     "joao",
+			Entity:    "sections",
+			EntityID:  3,
+			Action:    audit.ACTION_UPDATE,
+			Before:    json.RawMessage(`{"id":3,"section_number":10}`),
+			After:     json.RawMessage(`{"id":3,"section_number":12}`),
+			Diff:      json.RawMessage(`{"section_number":{"before":10,"after":12}}`),
+			CreatedAt: "2022-08-02 11:00:00",
+		},
+	}
+}
+
+func mockLogRows() *sqlmock.Rows {
+	logs := createLogArray()
+
+	rows := sqlmock.NewRows([]string{"id", "actor", "entity", "entity_id", "action",
+		"before_data", "after_data", "diff", "created_at"})
+
+	for _, l := range logs {
+		var before interface{}
+		if l.Before != nil {
+			before = string(l.Before)
+		}
+		rows.AddRow(l.ID, l.Actor, l.Entity, l.EntityID, l.Action, before, string(l.After), string(l.Diff), l.CreatedAt)
+	}
+
+	return rows
+}
+
+func TestRepositoryCreate(t *testing.T) {
+	exp := createLogArray()[1]
+
+	t.Run("create_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(audit.SqlCreate)).WithArgs(exp.Actor, exp.Entity, exp.EntityID, exp.Action,
+			string(exp.Before), string(exp.After), string(exp.Diff), exp.CreatedAt).WillReturnResult(sqlmock.NewResult(2, 1))
+
+		input := exp
+		input.ID = 0
+		log, err := audit.NewRepository(db).Create(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, exp, log)
+	})
+
+	t.Run("create_without_before", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		input := createLogArray()[0]
+		mock.ExpectExec(regexp.QuoteMeta(audit.SqlCreate)).WithArgs(input.Actor, input.Entity, input.EntityID, input.Action,
+			nil, string(input.After), string(input.Diff), input.CreatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		log, err := audit.NewRepository(db).Create(context.Background(), input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, input, log)
+	})
+
+	t.Run("create_fail_exec", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(audit.SqlCreate)).WillReturnError(sql.ErrConnDone)
+
+		log, err := audit.NewRepository(db).Create(context.Background(), exp)
+
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.Equal(t, audit.Log{}, log)
+	})
+
+	t.Run("create_fail_zero_rows_affected", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(audit.SqlCreate)).WillReturnResult(sqlmock.NewResult(1, 0))
+
+		log, err := audit.NewRepository(db).Create(context.Background(), exp)
+
+		assert.Equal(t, errors.New("sql: rows not affected"), err)
+		assert.Equal(t, audit.Log{}, log)
+	})
+}
+
+func TestRepositorySearch(t *testing.T) {
+	t.Run("search_all", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(audit.SqlSearch + audit.SqlOrderBy)).WillReturnRows(mockLogRows())
+
+		logs, err := audit.NewRepository(db).Search(context.Background(), audit.Filter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, createLogArray(), logs)
+	})
+
+	t.Run("search_with_filters", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		filter := audit.Filter{Entity: "sections", EntityID: 3, From: "2022-08-01 00:00:00", To: "2022-08-02 23:59:59"}
+		query := audit.SqlSearch + " WHERE entity = ? AND entity_id = ? AND created_at >= ? AND created_at <= ?" + audit.SqlOrderBy
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(filter.Entity, filter.EntityID, filter.From, filter.To).
+			WillReturnRows(mockLogRows())
+
+		logs, err := audit.NewRepository(db).Search(context.Background(), filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, createLogArray(), logs)
+	})
+
+	t.Run("search_fail_query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(audit.SqlSearch)).WillReturnError(sql.ErrConnDone)
+
+		logs, err := audit.NewRepository(db).Search(context.Background(), audit.Filter{})
+
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.Equal(t, []audit.Log{}, logs)
+	})
+
+	t.Run("search_fail_scan", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "actor", "entity", "entity_id", "action",
+			"before_data", "after_data", "diff", "created_at"}).AddRow("", "", "", "", "", "", "", "", "")
+		mock.ExpectQuery(regexp.QuoteMeta(audit.SqlSearch)).WillReturnRows(rows)
+
+		logs, err := audit.NewRepository(db).Search(context.Background(), audit.Filter{})
+
+		assert.Error(t, err)
+		assert.Equal(t, []audit.Log{}, logs)
+	})
+}
@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+const (
+	ERROR_INVALID_DATE = "the date must have the format YYYY-MM-DD"
+
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04:05"
+)
+
+type Change struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+type Service interface {
+	Record(ctx context.Context, actor, entity string, entityID int, action string, before, after interface{}) (Log, error)
+	Search(ctx context.Context, filter Filter) ([]Log, error)
+}
+
+type service struct {
+	repository Repository
+}
+
+func NewService(r Repository) Service {
+	return &service{repository: r}
+}
+
+func (s *service) Record(ctx context.Context, actor, entity string, entityID int, action string,
+	before, after interface{}) (Log, error) {
+	beforeJSON, err := marshal(before)
+	if err != nil {
+		return Log{}, err
+	}
+	afterJSON, err := marshal(after)
+	if err != nil {
+		return Log{}, err
+	}
+	diff, err := Diff(beforeJSON, afterJSON)
+	if err != nil {
+		return Log{}, err
+	}
+
+	log := Log{
+		Actor:     actor,
+		Entity:    entity,
+		EntityID:  entityID,
+		Action:    action,
+		Before:    beforeJSON,
+		After:     afterJSON,
+		Diff:      diff,
+		CreatedAt: time.Now().Format(dateTimeLayout),
+	}
+
+	log, err = s.repository.Create(ctx, log)
+	if err != nil {
+		return Log{}, err
+	}
+	return log, nil
+}
+
+func (s *service) Search(ctx context.Context, filter Filter) ([]Log, error) {
+	if filter.From != "" {
+		from, err := time.Parse(dateLayout, filter.From)
+		if err != nil {
+			return []Log{}, fmt.Errorf(ERROR_INVALID_DATE)
+		}
+		filter.From = from.Format(dateTimeLayout)
+	}
+	if filter.To != "" {
+		to, err := time.Parse(dateLayout, filter.To)
+		if err != nil {
+			return []Log{}, fmt.Errorf(ERROR_INVALID_DATE)
+		}
+		filter.To = to.Add(24*time.Hour - time.Second).Format(dateTimeLayout)
+	}
+
+	logs, err := s.repository.Search(ctx, filter)
+	if err != nil {
+		return []Log{}, err
+	}
+	return logs, nil
+}
+
+// Diff compares two JSON objects field by field and returns the fields whose
+// value changed, each one with its value before and after the change.
+func Diff(before, after json.RawMessage) (json.RawMessage, error) {
+	var beforeFields, afterFields map[string]interface{}
+	if len(before) > 0 {
+		if err := json.Unmarshal(before, &beforeFields); err != nil {
+			return nil, err
+		}
+	}
+	if len(after) > 0 {
+		if err := json.Unmarshal(after, &afterFields); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := map[string]Change{}
+	for field, value := range beforeFields {
+		if !reflect.DeepEqual(value, afterFields[field]) {
+			changes[field] = Change{Before: value, After: afterFields[field]}
+		}
+	}
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			changes[field] = Change{Before: nil, After: value}
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(changes)
+}
+
+func marshal(data interface{}) (json.RawMessage, error) {
+	switch value := data.(type) {
+	case nil:
+		return nil, nil
+	case json.RawMessage:
+		return value, nil
+	}
+	return json.Marshal(data)
+}
@@ -0,0 +1,136 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/audit/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type sectionState struct {
+	ID            int `json:"id"`
+	SectionNumber int `json:"section_number"`
+	CurCapacity   int `json:"current_capacity"`
+}
+
+func TestServiceRecord(t *testing.T) {
+	t.Run("record_update", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := audit.NewService(mockRepository)
+
+		before := sectionState{3, 10, 50}
+		after := sectionState{3, 12, 50}
+
+		mockRepository.On("Create", mock.Anything, mock.MatchedBy(func(l audit.Log) bool {
+			return l.Actor == "joao" && l.Entity == "sections" && l.EntityID == 3 &&
+				l.Action == audit.ACTION_UPDATE && l.CreatedAt != "" &&
+				string(l.Before) == `{"id":3,"section_number":10,"current_capacity":50}` &&
+				string(l.After) == `{"id":3,"section_number":12,"current_capacity":50}` &&
+				string(l.Diff) == `{"section_number":{"before":10,"after":12}}`
+		})).Return(func(ctx context.Context, l audit.Log) audit.Log {
+			l.ID = 1
+			return l
+		}, nil)
+
+		log, err := service.Record(context.Background(), "joao", "sections", 3, audit.ACTION_UPDATE, before, after)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, log.ID)
+	})
+
+	t.Run("record_delete_keeps_before", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := audit.NewService(mockRepository)
+
+		mockRepository.On("Create", mock.Anything, mock.MatchedBy(func(l audit.Log) bool {
+			return l.After == nil && string(l.Before) == `{"id":3,"section_number":10,"current_capacity":50}` &&
+				string(l.Diff) == `{"current_capacity":{"before":50,"after":null},"id":{"before":3,"after":null},"section_number":{"before":10,"after":null}}`
+		})).Return(audit.Log{ID: 2}, nil)
+
+		log, err := service.Record(context.Background(), "joao", "sections", 3, audit.ACTION_DELETE,
+			sectionState{3, 10, 50}, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, log.ID)
+	})
+
+	t.Run("record_fail", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := audit.NewService(mockRepository)
+
+		mockRepository.On("Create", mock.Anything, mock.Anything).Return(audit.Log{}, errors.New("sql: rows not affected"))
+
+		log, err := service.Record(context.Background(), "joao", "sections", 3, audit.ACTION_CREATE,
+			nil, json.RawMessage(`{"id":3}`))
+
+		assert.Equal(t, errors.New("sql: rows not affected"), err)
+		assert.Equal(t, audit.Log{}, log)
+	})
+
+	t.Run("record_invalid_json", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := audit.NewService(mockRepository)
+
+		log, err := service.Record(context.Background(), "joao", "sections", 3, audit.ACTION_CREATE,
+			nil, json.RawMessage(`[1, 2]`))
+
+		assert.Error(t, err)
+		assert.Equal(t, audit.Log{}, log)
+	})
+}
+
+func TestServiceSearch(t *testing.T) {
+	t.Run("search_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := audit.NewService(mockRepository)
+		exp := createLogArray()
+
+		mockRepository.On("Search", mock.Anything, audit.Filter{
+			Entity: "sections", EntityID: 3, From: "2022-08-01 00:00:00", To: "2022-08-02 23:59:59",
+		}).Return(exp, nil)
+
+		logs, err := service.Search(context.Background(), audit.Filter{
+			Entity: "sections", EntityID: 3, From: "2022-08-01", To: "2022-08-02",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, exp, logs)
+	})
+
+	t.Run("search_invalid_from", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := audit.NewService(mockRepository)
+
+		logs, err := service.Search(context.Background(), audit.Filter{From: "01/08/2022"})
+
+		assert.Equal(t, errors.New(audit.ERROR_INVALID_DATE), err)
+		assert.Equal(t, []audit.Log{}, logs)
+	})
+
+	t.Run("search_invalid_to", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := audit.NewService(mockRepository)
+
+		logs, err := service.Search(context.Background(), audit.Filter{To: "tomorrow"})
+
+		assert.Equal(t, errors.New(audit.ERROR_INVALID_DATE), err)
+		assert.Equal(t, []audit.Log{}, logs)
+	})
+
+	t.Run("search_fail", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := audit.NewService(mockRepository)
+
+		mockRepository.On("Search", mock.Anything, audit.Filter{}).Return([]audit.Log{}, errors.New("sql: connection is already closed"))
+
+		logs, err := service.Search(context.Background(), audit.Filter{})
+
+		assert.Error(t, err)
+		assert.Equal(t, []audit.Log{}, logs)
+	})
+}
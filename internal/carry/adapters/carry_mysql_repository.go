@@ -9,8 +9,37 @@ import (
 )
 
 const (
-	queryCreateCarry = "INSERT INTO carriers (cid, company_name, address, telephone, locality_id) VALUES (?, ?, ?, ?, ?)"
-	queryGetByCid    = "SELECT * FROM carriers WHERE cid=? "
+	queryCreateCarry    = "INSERT INTO carriers (cid, company_name, address, telephone, locality_id) VALUES (?, ?, ?, ?, ?)"
+	queryGetByCid       = "SELECT * FROM carriers WHERE cid=? "
+	queryGetAll         = "SELECT id, cid, company_name, address, telephone, locality_id FROM carriers"
+	queryGetByID        = queryGetAll + " WHERE id=?"
+	queryGetByLocality  = queryGetAll + " WHERE locality_id=?"
+	queryUpdateCarry    = "UPDATE carriers SET cid=?, company_name=?, address=?, telephone=?, locality_id=? WHERE id=?"
+	queryDeleteCarry    = "DELETE FROM carriers WHERE id=?"
+	queryCountShipments = "SELECT COUNT(*) FROM shipments WHERE carrier_id=?"
+
+	queryGetAllLocated = `SELECT c.id, c.cid, c.company_name, c.address, c.telephone, c.locality_id, l.latitude, l.longitude
+		FROM carriers c JOIN localities l ON l.id = c.locality_id
+		WHERE l.latitude IS NOT NULL AND l.longitude IS NOT NULL`
+
+	queryGetCoverageLocalities = "SELECT locality_id FROM carrier_coverage WHERE carrier_id=? AND locality_id IS NOT NULL ORDER BY locality_id"
+	queryGetCoverageProvinces  = "SELECT province_id FROM carrier_coverage WHERE carrier_id=? AND province_id IS NOT NULL ORDER BY province_id"
+	queryDeleteCoverage        = "DELETE FROM carrier_coverage WHERE carrier_id=?"
+	queryInsertCoverage        = "INSERT INTO carrier_coverage (carrier_id, locality_id, province_id) VALUES (?, ?, ?)"
+
+	queryGetRateCard  = "SELECT carrier_id, base_price, price_per_kg, price_per_km, refrigerated_surcharge FROM carrier_rate_cards WHERE carrier_id=?"
+	querySaveRateCard = `INSERT INTO carrier_rate_cards (carrier_id, base_price, price_per_kg, price_per_km, refrigerated_surcharge)
+		VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE base_price=VALUES(base_price), price_per_kg=VALUES(price_per_kg),
+		price_per_km=VALUES(price_per_km), refrigerated_surcharge=VALUES(refrigerated_surcharge)`
+
+	// Only the carries with a rate card can be quoted, so the others are left out.
+	queryGetServing = `SELECT c.id, c.cid, c.company_name, c.address, c.telephone, c.locality_id,
+		r.carrier_id, r.base_price, r.price_per_kg, r.price_per_km, r.refrigerated_surcharge
+		FROM carriers c JOIN carrier_rate_cards r ON r.carrier_id = c.id
+		WHERE EXISTS (SELECT 1 FROM carrier_coverage cc
+			WHERE cc.carrier_id = c.id AND (cc.locality_id = ? OR cc.province_id = ?))
+		ORDER BY c.id`
 )
 
 type mysqlCarryRepository struct {
@@ -66,3 +95,255 @@ func (r mysqlCarryRepository) GetCarryByCid(cid string) (domain.Carry, error) {
 
 	return carry, nil
 }
+
+func (r mysqlCarryRepository) GetAll() ([]domain.Carry, error) {
+	return r.queryCarries(queryGetAll)
+}
+
+func (r mysqlCarryRepository) GetByLocality(localityID int) ([]domain.Carry, error) {
+	return r.queryCarries(queryGetByLocality, localityID)
+}
+
+func (r mysqlCarryRepository) GetByID(id int) (domain.Carry, error) {
+	var carry domain.Carry
+
+	err := r.db.QueryRow(queryGetByID, id).
+		Scan(&carry.ID, &carry.Cid, &carry.Name, &carry.Address, &carry.Telephone, &carry.LocalityID)
+
+	if err != nil {
+		return domain.Carry{}, fmt.Errorf(usecases.ERR_CARRY_NOT_FOUND)
+	}
+
+	return carry, nil
+}
+
+func (r *mysqlCarryRepository) UpdateCarry(carry domain.Carry) (domain.Carry, error) {
+	stmt, err := r.db.Prepare(queryUpdateCarry)
+
+	if err != nil {
+		return domain.Carry{}, fmt.Errorf("erro ao preparar a query")
+	}
+
+	defer stmt.Close()
+
+	_, err = stmt.Exec(carry.Cid, carry.Name, carry.Address, carry.Telephone, carry.LocalityID, carry.ID)
+
+	if err != nil {
+		return domain.Carry{}, fmt.Errorf("erro ao executar a query")
+	}
+
+	return carry, nil
+}
+
+func (r *mysqlCarryRepository) DeleteCarry(id int) error {
+	stmt, err := r.db.Prepare(queryDeleteCarry)
+
+	if err != nil {
+		return fmt.Errorf("erro ao preparar a query: %v", err)
+	}
+
+	defer stmt.Close()
+
+	result, err := stmt.Exec(id)
+
+	if err != nil {
+		return fmt.Errorf("erro ao executar query: %v", err)
+	}
+
+	rows, _ := result.RowsAffected()
+
+	if rows == 0 {
+		return fmt.Errorf(usecases.ERR_CARRY_NOT_FOUND)
+	}
+
+	return nil
+}
+
+func (r mysqlCarryRepository) CountShipments(id int) (int, error) {
+	var total int
+
+	if err := r.db.QueryRow(queryCountShipments, id).Scan(&total); err != nil {
+		return 0, fmt.Errorf("erro ao executar a query")
+	}
+
+	return total, nil
+}
+
+func (r mysqlCarryRepository) queryCarries(query string, args ...interface{}) ([]domain.Carry, error) {
+	rows, err := r.db.Query(query, args...)
+
+	if err != nil {
+		return []domain.Carry{}, fmt.Errorf("erro ao executar a query")
+	}
+
+	defer rows.Close()
+
+	carries := []domain.Carry{}
+
+	for rows.Next() {
+		var carry domain.Carry
+
+		if err := rows.Scan(&carry.ID, &carry.Cid, &carry.Name, &carry.Address, &carry.Telephone, &carry.LocalityID); err != nil {
+			return []domain.Carry{}, err
+		}
+
+		carries = append(carries, carry)
+	}
+
+	return carries, rows.Err()
+}
+
+func (r mysqlCarryRepository) GetAllLocated() ([]domain.CarryLocation, error) {
+	rows, err := r.db.Query(queryGetAllLocated)
+
+	if err != nil {
+		return []domain.CarryLocation{}, fmt.Errorf("erro ao executar a query")
+	}
+
+	defer rows.Close()
+
+	carries := []domain.CarryLocation{}
+
+	for rows.Next() {
+		var c domain.CarryLocation
+
+		err := rows.Scan(&c.ID, &c.Cid, &c.Name, &c.Address, &c.Telephone, &c.LocalityID, &c.Latitude, &c.Longitude)
+
+		if err != nil {
+			return []domain.CarryLocation{}, err
+		}
+
+		carries = append(carries, c)
+	}
+
+	return carries, rows.Err()
+}
+
+func (r mysqlCarryRepository) GetCoverage(id int) (domain.Coverage, error) {
+	localityIDs, err := r.queryIDs(queryGetCoverageLocalities, id)
+
+	if err != nil {
+		return domain.Coverage{}, err
+	}
+
+	provinceIDs, err := r.queryIDs(queryGetCoverageProvinces, id)
+
+	if err != nil {
+		return domain.Coverage{}, err
+	}
+
+	return domain.Coverage{CarryID: id, LocalityIDs: localityIDs, ProvinceIDs: provinceIDs}, nil
+}
+
+// SetCoverage replaces the whole coverage of the carry in a single transaction.
+func (r *mysqlCarryRepository) SetCoverage(coverage domain.Coverage) error {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar a transação")
+	}
+
+	if _, err = tx.Exec(queryDeleteCoverage, coverage.CarryID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("erro ao executar a query")
+	}
+
+	for _, localityID := range coverage.LocalityIDs {
+		if _, err = tx.Exec(queryInsertCoverage, coverage.CarryID, localityID, nil); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("erro ao executar a query")
+		}
+	}
+
+	for _, provinceID := range coverage.ProvinceIDs {
+		if _, err = tx.Exec(queryInsertCoverage, coverage.CarryID, nil, provinceID); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("erro ao executar a query")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("erro ao confirmar a transação")
+	}
+
+	return nil
+}
+
+func (r mysqlCarryRepository) GetServing(localityID, provinceID int) ([]domain.CarryRate, error) {
+	rows, err := r.db.Query(queryGetServing, localityID, provinceID)
+
+	if err != nil {
+		return []domain.CarryRate{}, fmt.Errorf("erro ao executar a query")
+	}
+
+	defer rows.Close()
+
+	carries := []domain.CarryRate{}
+
+	for rows.Next() {
+		var c domain.CarryRate
+
+		err := rows.Scan(&c.ID, &c.Cid, &c.Name, &c.Address, &c.Telephone, &c.LocalityID, &c.RateCard.CarryID,
+			&c.RateCard.BasePrice, &c.RateCard.PricePerKg, &c.RateCard.PricePerKm, &c.RateCard.RefrigeratedSurcharge)
+
+		if err != nil {
+			return []domain.CarryRate{}, err
+		}
+
+		carries = append(carries, c)
+	}
+
+	return carries, rows.Err()
+}
+
+func (r mysqlCarryRepository) GetRateCard(id int) (domain.RateCard, error) {
+	var rateCard domain.RateCard
+
+	err := r.db.QueryRow(queryGetRateCard, id).Scan(&rateCard.CarryID, &rateCard.BasePrice,
+		&rateCard.PricePerKg, &rateCard.PricePerKm, &rateCard.RefrigeratedSurcharge)
+
+	if err == sql.ErrNoRows {
+		return domain.RateCard{}, fmt.Errorf(usecases.ERR_RATE_CARD_NOT_FOUND)
+	}
+
+	if err != nil {
+		return domain.RateCard{}, fmt.Errorf("erro ao executar a query")
+	}
+
+	return rateCard, nil
+}
+
+func (r *mysqlCarryRepository) SaveRateCard(rateCard domain.RateCard) (domain.RateCard, error) {
+	_, err := r.db.Exec(querySaveRateCard, rateCard.CarryID, rateCard.BasePrice,
+		rateCard.PricePerKg, rateCard.PricePerKm, rateCard.RefrigeratedSurcharge)
+
+	if err != nil {
+		return domain.RateCard{}, fmt.Errorf("erro ao executar a query")
+	}
+
+	return rateCard, nil
+}
+
+func (r mysqlCarryRepository) queryIDs(query string, args ...interface{}) ([]int, error) {
+	rows, err := r.db.Query(query, args...)
+
+	if err != nil {
+		return []int{}, fmt.Errorf("erro ao executar a query")
+	}
+
+	defer rows.Close()
+
+	ids := []int{}
+
+	for rows.Next() {
+		var id int
+
+		if err := rows.Scan(&id); err != nil {
+			return []int{}, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
@@ -1,6 +1,7 @@
 package adapters_test
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"regexp"
@@ -9,6 +10,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/adapters"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -112,3 +114,383 @@ func Test_GetCarryByCid(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func Test_GetAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+
+	t.Run("Deve retornar todas as Carries", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id"}).
+			AddRow(validCarry.ID, validCarry.Cid, validCarry.Name, validCarry.Address, validCarry.Telephone, validCarry.LocalityID)
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, cid, company_name, address, telephone, locality_id FROM carriers")).WillReturnRows(rows)
+
+		result, err := repository.GetAll()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []domain.Carry{validCarry}, result)
+	})
+
+	t.Run("Deve retornar um erro ao executar a query", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, cid")).WillReturnError(fmt.Errorf("xablau"))
+
+		_, err := repository.GetAll()
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_GetAllLocated(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+
+	t.Run("Deve retornar as Carries com as coordenadas da locality", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id", "latitude", "longitude"}).
+			AddRow(validCarry.ID, validCarry.Cid, validCarry.Name, validCarry.Address, validCarry.Telephone, validCarry.LocalityID, -28.6775, -49.3697)
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT c.id, c.cid")).WillReturnRows(rows)
+
+		result, err := repository.GetAllLocated()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []domain.CarryLocation{{Carry: validCarry, Latitude: -28.6775, Longitude: -49.3697}}, result)
+	})
+
+	t.Run("Deve retornar um erro no Scan", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "cid"}).AddRow(1, "CID#5")
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT c.id, c.cid")).WillReturnRows(rows)
+
+		_, err := repository.GetAllLocated()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Deve retornar um erro ao executar a query", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT c.id, c.cid")).WillReturnError(fmt.Errorf("xablau"))
+
+		_, err := repository.GetAllLocated()
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_GetByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+
+	t.Run("Deve retornar a Carry do id pesquisado", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id"}).
+			AddRow(validCarry.ID, validCarry.Cid, validCarry.Name, validCarry.Address, validCarry.Telephone, validCarry.LocalityID)
+
+		mock.ExpectQuery(regexp.QuoteMeta("FROM carriers WHERE id=?")).WithArgs(1).WillReturnRows(rows)
+
+		result, err := repository.GetByID(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, validCarry, result)
+	})
+
+	t.Run("Deve retornar um erro se o id não existir", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM carriers WHERE id=?")).WithArgs(9).WillReturnError(sql.ErrNoRows)
+
+		_, err := repository.GetByID(9)
+
+		assert.EqualError(t, err, usecases.ERR_CARRY_NOT_FOUND)
+	})
+}
+
+func Test_GetByLocality(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+
+	t.Run("Deve retornar as Carries da locality", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id"}).
+			AddRow(validCarry.ID, validCarry.Cid, validCarry.Name, validCarry.Address, validCarry.Telephone, validCarry.LocalityID)
+
+		mock.ExpectQuery(regexp.QuoteMeta("FROM carriers WHERE locality_id=?")).WithArgs(2).WillReturnRows(rows)
+
+		result, err := repository.GetByLocality(2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []domain.Carry{validCarry}, result)
+	})
+}
+
+func Test_UpdateCarry(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+
+	t.Run("Deve atualizar a Carry", func(t *testing.T) {
+		mock.ExpectPrepare("UPDATE carriers").ExpectExec().
+			WithArgs(validCarry.Cid, validCarry.Name, validCarry.Address, validCarry.Telephone, validCarry.LocalityID, validCarry.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		result, err := repository.UpdateCarry(validCarry)
+
+		assert.Nil(t, err)
+		assert.Equal(t, validCarry, result)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Deve retornar um erro ao preparar a query", func(t *testing.T) {
+		mock.ExpectPrepare("UPDATE carriers").WillReturnError(fmt.Errorf("xablau"))
+
+		_, err := repository.UpdateCarry(validCarry)
+
+		assert.EqualError(t, err, "erro ao preparar a query")
+	})
+
+	t.Run("Deve retornar um erro ao executar a query", func(t *testing.T) {
+		mock.ExpectPrepare("UPDATE carriers").ExpectExec().WillReturnError(fmt.Errorf("xablau"))
+
+		_, err := repository.UpdateCarry(validCarry)
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_DeleteCarry(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+
+	t.Run("Deve remover a Carry", func(t *testing.T) {
+		mock.ExpectPrepare("DELETE FROM carriers").ExpectExec().WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.DeleteCarry(1)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Deve retornar um erro se o id não existir", func(t *testing.T) {
+		mock.ExpectPrepare("DELETE FROM carriers").ExpectExec().WithArgs(9).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repository.DeleteCarry(9)
+
+		assert.EqualError(t, err, usecases.ERR_CARRY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar um erro ao executar a query", func(t *testing.T) {
+		mock.ExpectPrepare("DELETE FROM carriers").ExpectExec().WithArgs(1).WillReturnError(fmt.Errorf("xablau"))
+
+		err := repository.DeleteCarry(1)
+
+		assert.EqualError(t, err, "erro ao executar query: xablau")
+	})
+}
+
+func Test_CountShipments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+
+	t.Run("Deve retornar a quantidade de shipments da Carry", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM shipments WHERE carrier_id=?")).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		result, err := repository.CountShipments(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("Deve retornar um erro ao executar a query", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM shipments")).WithArgs(1).WillReturnError(fmt.Errorf("xablau"))
+
+		_, err := repository.CountShipments(1)
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_Coverage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+
+	t.Run("Deve retornar as localities e provinces da cobertura", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT locality_id FROM carrier_coverage")).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"locality_id"}).AddRow(2).AddRow(3))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT province_id FROM carrier_coverage")).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"province_id"}).AddRow(1))
+
+		result, err := repository.GetCoverage(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, domain.Coverage{CarryID: 1, LocalityIDs: []int{2, 3}, ProvinceIDs: []int{1}}, result)
+	})
+
+	t.Run("Deve retornar um erro ao buscar a cobertura", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT locality_id FROM carrier_coverage")).WithArgs(1).WillReturnError(fmt.Errorf("xablau"))
+
+		_, err := repository.GetCoverage(1)
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+
+	t.Run("Deve substituir a cobertura em uma transação", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM carrier_coverage")).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO carrier_coverage")).WithArgs(1, 2, nil).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO carrier_coverage")).WithArgs(1, nil, 5).WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+
+		err := repository.SetCoverage(domain.Coverage{CarryID: 1, LocalityIDs: []int{2}, ProvinceIDs: []int{5}})
+
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Deve desfazer a transação se um insert falhar", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM carrier_coverage")).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO carrier_coverage")).WithArgs(1, 2, nil).WillReturnError(fmt.Errorf("xablau"))
+		mock.ExpectRollback()
+
+		err := repository.SetCoverage(domain.Coverage{CarryID: 1, LocalityIDs: []int{2}})
+
+		assert.EqualError(t, err, "erro ao executar a query")
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+}
+
+func Test_GetServing(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+
+	t.Run("Deve retornar as Carries que atendem a locality com a tabela de preços", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id",
+			"carrier_id", "base_price", "price_per_kg", "price_per_km", "refrigerated_surcharge"}).
+			AddRow(1, "CID#5", "mercado-livre", "Criciuma, 666", "99999999", 2, 1, 10, 1.5, 0.2, 25)
+
+		mock.ExpectQuery(regexp.QuoteMeta("FROM carriers c JOIN carrier_rate_cards")).WithArgs(2, 7).WillReturnRows(rows)
+
+		result, err := repository.GetServing(2, 7)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []domain.CarryRate{{
+			Carry:    validCarry,
+			RateCard: domain.RateCard{CarryID: 1, BasePrice: 10, PricePerKg: 1.5, PricePerKm: 0.2, RefrigeratedSurcharge: 25},
+		}}, result)
+	})
+
+	t.Run("Deve retornar um erro ao executar a query", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM carriers c JOIN carrier_rate_cards")).WithArgs(2, 7).WillReturnError(fmt.Errorf("xablau"))
+
+		_, err := repository.GetServing(2, 7)
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_RateCard(t *testing.T) {
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlCarryRepository(db)
+	rateCard := domain.RateCard{CarryID: 1, BasePrice: 10, PricePerKg: 1.5, PricePerKm: 0.2, RefrigeratedSurcharge: 25}
+
+	t.Run("Deve retornar a tabela de preços", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"carrier_id", "base_price", "price_per_kg", "price_per_km", "refrigerated_surcharge"}).
+			AddRow(1, 10, 1.5, 0.2, 25)
+
+		mock.ExpectQuery(regexp.QuoteMeta("FROM carrier_rate_cards WHERE carrier_id=?")).WithArgs(1).WillReturnRows(rows)
+
+		result, err := repository.GetRateCard(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, rateCard, result)
+	})
+
+	t.Run("Deve retornar um erro se a Carry não tiver tabela de preços", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("FROM carrier_rate_cards WHERE carrier_id=?")).WithArgs(9).WillReturnError(sql.ErrNoRows)
+
+		_, err := repository.GetRateCard(9)
+
+		assert.EqualError(t, err, usecases.ERR_RATE_CARD_NOT_FOUND)
+	})
+
+	t.Run("Deve salvar a tabela de preços", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO carrier_rate_cards")).WithArgs(1, 10.0, 1.5, 0.2, 25.0).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		result, err := repository.SaveRateCard(rateCard)
+
+		assert.Nil(t, err)
+		assert.Equal(t, rateCard, result)
+	})
+
+	t.Run("Deve retornar um erro ao salvar a tabela de preços", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO carrier_rate_cards")).WillReturnError(fmt.Errorf("xablau"))
+
+		_, err := repository.SaveRateCard(rateCard)
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
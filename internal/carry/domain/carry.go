@@ -8,3 +8,51 @@ type Carry struct {
 	Telephone  string `json:"telephone"`
 	LocalityID int    `json:"locality_id"`
 }
+
+// CarryLocation is a carry with the coordinates of its locality.
+type CarryLocation struct {
+	Carry
+	Latitude  float64
+	Longitude float64
+}
+
+// NearCarry is a carry with its distance to the searched locality.
+type NearCarry struct {
+	Carry
+	DistanceKm float64 `json:"distance_km"`
+}
+
+// Coverage is the set of localities and provinces a carry delivers to. A
+// province covers every locality in it.
+type Coverage struct {
+	CarryID     int   `json:"carry_id"`
+	LocalityIDs []int `json:"locality_ids"`
+	ProvinceIDs []int `json:"province_ids"`
+}
+
+// RateCard prices a delivery as base_price + price_per_kg * weight +
+// price_per_km * distance, plus the refrigerated_surcharge for cold loads.
+type RateCard struct {
+	CarryID               int     `json:"carry_id"`
+	BasePrice             float64 `json:"base_price"`
+	PricePerKg            float64 `json:"price_per_kg"`
+	PricePerKm            float64 `json:"price_per_km"`
+	RefrigeratedSurcharge float64 `json:"refrigerated_surcharge"`
+}
+
+// Price is the cost of carrying weightKg over distanceKm.
+func (r RateCard) Price(weightKg, distanceKm float64, refrigerated bool) float64 {
+	price := r.BasePrice + r.PricePerKg*weightKg + r.PricePerKm*distanceKm
+
+	if refrigerated {
+		price += r.RefrigeratedSurcharge
+	}
+
+	return price
+}
+
+// CarryRate is a carry with its rate card.
+type CarryRate struct {
+	Carry
+	RateCard RateCard `json:"rate_card"`
+}
@@ -12,6 +12,27 @@ type RepositoryCarry struct {
 	mock.Mock
 }
 
+// CountShipments provides a mock function with given fields: id
+func (_m *RepositoryCarry) CountShipments(id int) (int, error) {
+	ret := _m.Called(id)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateCarry provides a mock function with given fields: carry
 func (_m *RepositoryCarry) CreateCarry(carry domain.Carry) (domain.Carry, error) {
 	ret := _m.Called(carry)
@@ -33,6 +54,110 @@ func (_m *RepositoryCarry) CreateCarry(carry domain.Carry) (domain.Carry, error)
 	return r0, r1
 }
 
+// DeleteCarry provides a mock function with given fields: id
+func (_m *RepositoryCarry) DeleteCarry(id int) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields:
+func (_m *RepositoryCarry) GetAll() ([]domain.Carry, error) {
+	ret := _m.Called()
+
+	var r0 []domain.Carry
+	if rf, ok := ret.Get(0).(func() []domain.Carry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Carry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllLocated provides a mock function with given fields:
+func (_m *RepositoryCarry) GetAllLocated() ([]domain.CarryLocation, error) {
+	ret := _m.Called()
+
+	var r0 []domain.CarryLocation
+	if rf, ok := ret.Get(0).(func() []domain.CarryLocation); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CarryLocation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: id
+func (_m *RepositoryCarry) GetByID(id int) (domain.Carry, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Carry
+	if rf, ok := ret.Get(0).(func(int) domain.Carry); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Carry)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByLocality provides a mock function with given fields: localityID
+func (_m *RepositoryCarry) GetByLocality(localityID int) ([]domain.Carry, error) {
+	ret := _m.Called(localityID)
+
+	var r0 []domain.Carry
+	if rf, ok := ret.Get(0).(func(int) []domain.Carry); ok {
+		r0 = rf(localityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Carry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(localityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCarryByCid provides a mock function with given fields: cid
 func (_m *RepositoryCarry) GetCarryByCid(cid string) (domain.Carry, error) {
 	ret := _m.Called(cid)
@@ -54,6 +179,127 @@ func (_m *RepositoryCarry) GetCarryByCid(cid string) (domain.Carry, error) {
 	return r0, r1
 }
 
+// GetCoverage provides a mock function with given fields: id
+func (_m *RepositoryCarry) GetCoverage(id int) (domain.Coverage, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Coverage
+	if rf, ok := ret.Get(0).(func(int) domain.Coverage); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Coverage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRateCard provides a mock function with given fields: id
+func (_m *RepositoryCarry) GetRateCard(id int) (domain.RateCard, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.RateCard
+	if rf, ok := ret.Get(0).(func(int) domain.RateCard); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.RateCard)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServing provides a mock function with given fields: localityID, provinceID
+func (_m *RepositoryCarry) GetServing(localityID int, provinceID int) ([]domain.CarryRate, error) {
+	ret := _m.Called(localityID, provinceID)
+
+	var r0 []domain.CarryRate
+	if rf, ok := ret.Get(0).(func(int, int) []domain.CarryRate); ok {
+		r0 = rf(localityID, provinceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CarryRate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(localityID, provinceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveRateCard provides a mock function with given fields: rateCard
+func (_m *RepositoryCarry) SaveRateCard(rateCard domain.RateCard) (domain.RateCard, error) {
+	ret := _m.Called(rateCard)
+
+	var r0 domain.RateCard
+	if rf, ok := ret.Get(0).(func(domain.RateCard) domain.RateCard); ok {
+		r0 = rf(rateCard)
+	} else {
+		r0 = ret.Get(0).(domain.RateCard)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(domain.RateCard) error); ok {
+		r1 = rf(rateCard)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCoverage provides a mock function with given fields: coverage
+func (_m *RepositoryCarry) SetCoverage(coverage domain.Coverage) error {
+	ret := _m.Called(coverage)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.Coverage) error); ok {
+		r0 = rf(coverage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCarry provides a mock function with given fields: carry
+func (_m *RepositoryCarry) UpdateCarry(carry domain.Carry) (domain.Carry, error) {
+	ret := _m.Called(carry)
+
+	var r0 domain.Carry
+	if rf, ok := ret.Get(0).(func(domain.Carry) domain.Carry); ok {
+		r0 = rf(carry)
+	} else {
+		r0 = ret.Get(0).(domain.Carry)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(domain.Carry) error); ok {
+		r1 = rf(carry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRepositoryCarry interface {
 	mock.TestingT
 	Cleanup(func())
@@ -3,6 +3,8 @@
 package mock_service_carry
 
 import (
+	context "context"
+
 	domain "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -33,6 +35,259 @@ func (_m *ServiceCarry) CreateCarry(carry domain.Carry) (domain.Carry, error) {
 	return r0, r1
 }
 
+// DeleteCarry provides a mock function with given fields: id
+func (_m *ServiceCarry) DeleteCarry(id int) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields:
+func (_m *ServiceCarry) GetAll() ([]domain.Carry, error) {
+	ret := _m.Called()
+
+	var r0 []domain.Carry
+	if rf, ok := ret.Get(0).(func() []domain.Carry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Carry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByCid provides a mock function with given fields: cid
+func (_m *ServiceCarry) GetByCid(cid string) (domain.Carry, error) {
+	ret := _m.Called(cid)
+
+	var r0 domain.Carry
+	if rf, ok := ret.Get(0).(func(string) domain.Carry); ok {
+		r0 = rf(cid)
+	} else {
+		r0 = ret.Get(0).(domain.Carry)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(cid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: id
+func (_m *ServiceCarry) GetByID(id int) (domain.Carry, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Carry
+	if rf, ok := ret.Get(0).(func(int) domain.Carry); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Carry)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByLocality provides a mock function with given fields: localityID
+func (_m *ServiceCarry) GetByLocality(localityID int) ([]domain.Carry, error) {
+	ret := _m.Called(localityID)
+
+	var r0 []domain.Carry
+	if rf, ok := ret.Get(0).(func(int) []domain.Carry); ok {
+		r0 = rf(localityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Carry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(localityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCoverage provides a mock function with given fields: id
+func (_m *ServiceCarry) GetCoverage(id int) (domain.Coverage, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Coverage
+	if rf, ok := ret.Get(0).(func(int) domain.Coverage); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Coverage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNear provides a mock function with given fields: ctx, localityID, radiusKm
+func (_m *ServiceCarry) GetNear(ctx context.Context, localityID int, radiusKm float64) ([]domain.NearCarry, error) {
+	ret := _m.Called(ctx, localityID, radiusKm)
+
+	var r0 []domain.NearCarry
+	if rf, ok := ret.Get(0).(func(context.Context, int, float64) []domain.NearCarry); ok {
+		r0 = rf(ctx, localityID, radiusKm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NearCarry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, float64) error); ok {
+		r1 = rf(ctx, localityID, radiusKm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRateCard provides a mock function with given fields: id
+func (_m *ServiceCarry) GetRateCard(id int) (domain.RateCard, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.RateCard
+	if rf, ok := ret.Get(0).(func(int) domain.RateCard); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.RateCard)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServing provides a mock function with given fields: ctx, localityID
+func (_m *ServiceCarry) GetServing(ctx context.Context, localityID int) ([]domain.CarryRate, error) {
+	ret := _m.Called(ctx, localityID)
+
+	var r0 []domain.CarryRate
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.CarryRate); ok {
+		r0 = rf(ctx, localityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CarryRate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, localityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveRateCard provides a mock function with given fields: rateCard
+func (_m *ServiceCarry) SaveRateCard(rateCard domain.RateCard) (domain.RateCard, error) {
+	ret := _m.Called(rateCard)
+
+	var r0 domain.RateCard
+	if rf, ok := ret.Get(0).(func(domain.RateCard) domain.RateCard); ok {
+		r0 = rf(rateCard)
+	} else {
+		r0 = ret.Get(0).(domain.RateCard)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(domain.RateCard) error); ok {
+		r1 = rf(rateCard)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCoverage provides a mock function with given fields: ctx, coverage
+func (_m *ServiceCarry) SetCoverage(ctx context.Context, coverage domain.Coverage) (domain.Coverage, error) {
+	ret := _m.Called(ctx, coverage)
+
+	var r0 domain.Coverage
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Coverage) domain.Coverage); ok {
+		r0 = rf(ctx, coverage)
+	} else {
+		r0 = ret.Get(0).(domain.Coverage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Coverage) error); ok {
+		r1 = rf(ctx, coverage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCarry provides a mock function with given fields: carry
+func (_m *ServiceCarry) UpdateCarry(carry domain.Carry) (domain.Carry, error) {
+	ret := _m.Called(carry)
+
+	var r0 domain.Carry
+	if rf, ok := ret.Get(0).(func(domain.Carry) domain.Carry); ok {
+		r0 = rf(carry)
+	} else {
+		r0 = ret.Get(0).(domain.Carry)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(domain.Carry) error); ok {
+		r1 = rf(carry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewServiceCarry interface {
 	mock.TestingT
 	Cleanup(func())
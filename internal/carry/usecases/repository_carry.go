@@ -5,4 +5,16 @@ import "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/dom
 type RepositoryCarry interface {
 	CreateCarry(carry domain.Carry) (domain.Carry, error)
 	GetCarryByCid(cid string) (domain.Carry, error)
+	GetAll() ([]domain.Carry, error)
+	GetAllLocated() ([]domain.CarryLocation, error)
+	GetByID(id int) (domain.Carry, error)
+	GetByLocality(localityID int) ([]domain.Carry, error)
+	UpdateCarry(carry domain.Carry) (domain.Carry, error)
+	DeleteCarry(id int) error
+	CountShipments(id int) (int, error)
+	GetCoverage(id int) (domain.Coverage, error)
+	SetCoverage(coverage domain.Coverage) error
+	GetServing(localityID, provinceID int) ([]domain.CarryRate, error)
+	GetRateCard(id int) (domain.RateCard, error)
+	SaveRateCard(rateCard domain.RateCard) (domain.RateCard, error)
 }
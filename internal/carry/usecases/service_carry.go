@@ -1,21 +1,49 @@
 package usecases
 
 import (
+	"context"
 	"fmt"
+	"sort"
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/geo"
+)
+
+const (
+	ERR_CARRY_NOT_FOUND = "a carry não foi encontrada"
+	ERR_CID_IN_USE      = "o `cid` já está em uso"
+	ERR_CARRY_IN_USE    = "a carry possui shipments e não pode ser removida"
+
+	ERR_RATE_CARD_NOT_FOUND = "a carry não possui tabela de preços"
+	ERR_INVALID_RATE_CARD   = "os valores da tabela de preços não podem ser negativos"
 )
 
 type ServiceCarry interface {
 	CreateCarry(carry domain.Carry) (domain.Carry, error)
+	GetAll() ([]domain.Carry, error)
+	GetNear(ctx context.Context, localityID int, radiusKm float64) ([]domain.NearCarry, error)
+	GetByID(id int) (domain.Carry, error)
+	GetByCid(cid string) (domain.Carry, error)
+	GetByLocality(localityID int) ([]domain.Carry, error)
+	UpdateCarry(carry domain.Carry) (domain.Carry, error)
+	DeleteCarry(id int) error
+	GetCoverage(id int) (domain.Coverage, error)
+	SetCoverage(ctx context.Context, coverage domain.Coverage) (domain.Coverage, error)
+	GetServing(ctx context.Context, localityID int) ([]domain.CarryRate, error)
+	GetRateCard(id int) (domain.RateCard, error)
+	SaveRateCard(rateCard domain.RateCard) (domain.RateCard, error)
 }
 
 type serviceCarry struct {
-	repository RepositoryCarry
+	repository      RepositoryCarry
+	localityService locality.Service
+	provinceService province.Service
 }
 
-func NewServiceCarry(r RepositoryCarry) ServiceCarry {
-	return &serviceCarry{r}
+func NewServiceCarry(r RepositoryCarry, localityService locality.Service, provinceService province.Service) ServiceCarry {
+	return &serviceCarry{repository: r, localityService: localityService, provinceService: provinceService}
 }
 
 func (s *serviceCarry) CreateCarry(carry domain.Carry) (domain.Carry, error) {
@@ -23,7 +51,7 @@ func (s *serviceCarry) CreateCarry(carry domain.Carry) (domain.Carry, error) {
 	_, err := s.repository.GetCarryByCid(carry.Cid)
 
 	if err == nil {
-		return domain.Carry{}, fmt.Errorf("o `cid` já está em uso")
+		return domain.Carry{}, fmt.Errorf(ERR_CID_IN_USE)
 	}
 
 	carry, err = s.repository.CreateCarry(carry)
@@ -35,3 +63,207 @@ func (s *serviceCarry) CreateCarry(carry domain.Carry) (domain.Carry, error) {
 	return carry, nil
 
 }
+
+func (s *serviceCarry) GetAll() ([]domain.Carry, error) {
+	carries, err := s.repository.GetAll()
+
+	if err != nil {
+		return []domain.Carry{}, err
+	}
+
+	return carries, nil
+}
+
+func (s *serviceCarry) GetByID(id int) (domain.Carry, error) {
+	carry, err := s.repository.GetByID(id)
+
+	if err != nil {
+		return domain.Carry{}, err
+	}
+
+	return carry, nil
+}
+
+func (s *serviceCarry) GetByCid(cid string) (domain.Carry, error) {
+	carry, err := s.repository.GetCarryByCid(cid)
+
+	if err != nil {
+		return domain.Carry{}, fmt.Errorf(ERR_CARRY_NOT_FOUND)
+	}
+
+	return carry, nil
+}
+
+func (s *serviceCarry) GetByLocality(localityID int) ([]domain.Carry, error) {
+	carries, err := s.repository.GetByLocality(localityID)
+
+	if err != nil {
+		return []domain.Carry{}, err
+	}
+
+	return carries, nil
+}
+
+func (s *serviceCarry) UpdateCarry(carry domain.Carry) (domain.Carry, error) {
+	if _, err := s.repository.GetByID(carry.ID); err != nil {
+		return domain.Carry{}, err
+	}
+
+	found, err := s.repository.GetCarryByCid(carry.Cid)
+
+	if err == nil && found.ID != carry.ID {
+		return domain.Carry{}, fmt.Errorf(ERR_CID_IN_USE)
+	}
+
+	carry, err = s.repository.UpdateCarry(carry)
+
+	if err != nil {
+		return domain.Carry{}, err
+	}
+
+	return carry, nil
+}
+
+// DeleteCarry refuses to remove a carry that already has shipments.
+func (s *serviceCarry) DeleteCarry(id int) error {
+	if _, err := s.repository.GetByID(id); err != nil {
+		return err
+	}
+
+	shipments, err := s.repository.CountShipments(id)
+
+	if err != nil {
+		return err
+	}
+
+	if shipments > 0 {
+		return fmt.Errorf(ERR_CARRY_IN_USE)
+	}
+
+	return s.repository.DeleteCarry(id)
+}
+
+// GetNear lists the carries whose locality is at most radiusKm away from the
+// given locality, closest first.
+func (s *serviceCarry) GetNear(ctx context.Context, localityID int, radiusKm float64) ([]domain.NearCarry, error) {
+	origin, err := s.localityService.GetById(ctx, localityID)
+
+	if err != nil {
+		return []domain.NearCarry{}, err
+	}
+
+	if origin.Latitude == nil || origin.Longitude == nil {
+		return []domain.NearCarry{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_LOCATED)
+	}
+
+	located, err := s.repository.GetAllLocated()
+
+	if err != nil {
+		return []domain.NearCarry{}, err
+	}
+
+	near := []domain.NearCarry{}
+
+	for _, c := range located {
+		distance := geo.Distance(*origin.Latitude, *origin.Longitude, c.Latitude, c.Longitude)
+
+		if distance <= radiusKm {
+			near = append(near, domain.NearCarry{Carry: c.Carry, DistanceKm: distance})
+		}
+	}
+
+	sort.SliceStable(near, func(i, j int) bool {
+		return near[i].DistanceKm < near[j].DistanceKm
+	})
+
+	return near, nil
+}
+
+func (s *serviceCarry) GetCoverage(id int) (domain.Coverage, error) {
+	if _, err := s.repository.GetByID(id); err != nil {
+		return domain.Coverage{}, err
+	}
+
+	return s.repository.GetCoverage(id)
+}
+
+// SetCoverage replaces the localities and provinces served by the carry.
+// Every locality and province must exist; repeated ids are saved once.
+func (s *serviceCarry) SetCoverage(ctx context.Context, coverage domain.Coverage) (domain.Coverage, error) {
+	if _, err := s.repository.GetByID(coverage.CarryID); err != nil {
+		return domain.Coverage{}, err
+	}
+
+	coverage.LocalityIDs = uniqueIDs(coverage.LocalityIDs)
+	coverage.ProvinceIDs = uniqueIDs(coverage.ProvinceIDs)
+
+	for _, localityID := range coverage.LocalityIDs {
+		if _, err := s.localityService.GetById(ctx, localityID); err != nil {
+			return domain.Coverage{}, err
+		}
+	}
+
+	for _, provinceID := range coverage.ProvinceIDs {
+		if _, err := s.provinceService.GetById(ctx, provinceID); err != nil {
+			return domain.Coverage{}, err
+		}
+	}
+
+	if err := s.repository.SetCoverage(coverage); err != nil {
+		return domain.Coverage{}, err
+	}
+
+	return coverage, nil
+}
+
+// GetServing lists the carries with a rate card whose coverage includes the
+// locality, either directly or through its province.
+func (s *serviceCarry) GetServing(ctx context.Context, localityID int) ([]domain.CarryRate, error) {
+	destination, err := s.localityService.GetById(ctx, localityID)
+
+	if err != nil {
+		return []domain.CarryRate{}, err
+	}
+
+	carries, err := s.repository.GetServing(destination.Id, destination.ProvinceID)
+
+	if err != nil {
+		return []domain.CarryRate{}, err
+	}
+
+	return carries, nil
+}
+
+func (s *serviceCarry) GetRateCard(id int) (domain.RateCard, error) {
+	if _, err := s.repository.GetByID(id); err != nil {
+		return domain.RateCard{}, err
+	}
+
+	return s.repository.GetRateCard(id)
+}
+
+func (s *serviceCarry) SaveRateCard(rateCard domain.RateCard) (domain.RateCard, error) {
+	if rateCard.BasePrice < 0 || rateCard.PricePerKg < 0 || rateCard.PricePerKm < 0 || rateCard.RefrigeratedSurcharge < 0 {
+		return domain.RateCard{}, fmt.Errorf(ERR_INVALID_RATE_CARD)
+	}
+
+	if _, err := s.repository.GetByID(rateCard.CarryID); err != nil {
+		return domain.RateCard{}, err
+	}
+
+	return s.repository.SaveRateCard(rateCard)
+}
+
+func uniqueIDs(ids []int) []int {
+	seen := map[int]bool{}
+	unique := []int{}
+
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	return unique
+}
@@ -1,12 +1,17 @@
 package usecases_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/domain"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases/mock/mock_repository_carry"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	localityMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	provinceMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,7 +30,7 @@ func makeValidDBCarry() domain.Carry {
 func Test_CreateCarry(t *testing.T) {
 	t.Run("Deve conter os campos necessários para ser criado uma Carry.", func(t *testing.T) {
 		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
-		service := usecases.NewServiceCarry(mockRepository)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
 
 		data := domain.Carry{
 			Cid:        "CID#5",
@@ -51,7 +56,7 @@ func Test_CreateCarry(t *testing.T) {
 
 	t.Run("Deve retornar uma Carry vazia se já existir um `cid` no banco de dados.", func(t *testing.T) {
 		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
-		service := usecases.NewServiceCarry(mockRepository)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
 
 		data := domain.Carry{
 			ID:         1,
@@ -78,7 +83,7 @@ func Test_CreateCarry(t *testing.T) {
 
 	t.Run("Deve retornar um erro caso CreateCarry, retorne um error", func(t *testing.T) {
 		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
-		service := usecases.NewServiceCarry(mockRepository)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
 
 		data := domain.Carry{
 			ID:         1,
@@ -103,3 +108,460 @@ func Test_CreateCarry(t *testing.T) {
 
 	})
 }
+
+func Test_GetAll(t *testing.T) {
+	t.Run("Deve retornar todas as Carries", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		expected := []domain.Carry{makeValidDBCarry()}
+
+		mockRepository.On("GetAll").Return(expected, nil)
+
+		result, err := service.GetAll()
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Deve retornar um erro caso GetAll, retorne um error", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetAll").Return(nil, fmt.Errorf("erro ao executar a query"))
+
+		result, err := service.GetAll()
+
+		assert.EqualError(t, err, "erro ao executar a query")
+		assert.Empty(t, result)
+	})
+}
+
+func Test_GetNear(t *testing.T) {
+	latitude, longitude := -23.5505, -46.6333
+	saoPaulo := locality.Locality{Id: 1, LocalityName: "São Paulo", Latitude: &latitude, Longitude: &longitude}
+
+	located := []domain.CarryLocation{
+		{Carry: domain.Carry{ID: 1, Cid: "RJ"}, Latitude: -22.9068, Longitude: -43.1729},
+		{Carry: domain.Carry{ID: 2, Cid: "CAMPINAS"}, Latitude: -22.9099, Longitude: -47.0626},
+		{Carry: domain.Carry{ID: 3, Cid: "GRU"}, Latitude: -23.4538, Longitude: -46.5333},
+	}
+
+	t.Run("Deve retornar as Carries dentro do raio ordenadas pela distância", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewServiceCarry(mockRepository, mockLocalityService, nil)
+
+		mockLocalityService.On("GetById", context.Background(), 1).Return(saoPaulo, nil)
+		mockRepository.On("GetAllLocated").Return(located, nil)
+
+		result, err := service.GetNear(context.Background(), 1, 100)
+
+		assert.Nil(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "GRU", result[0].Cid)
+		assert.Equal(t, "CAMPINAS", result[1].Cid)
+	})
+
+	t.Run("Deve retornar um erro se a locality não existir", func(t *testing.T) {
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewServiceCarry(mock_repository_carry.NewRepositoryCarry(t), mockLocalityService, nil)
+
+		mockLocalityService.On("GetById", context.Background(), 9).Return(locality.Locality{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		_, err := service.GetNear(context.Background(), 9, 100)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar um erro se a locality não tiver coordenadas", func(t *testing.T) {
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewServiceCarry(mock_repository_carry.NewRepositoryCarry(t), mockLocalityService, nil)
+
+		mockLocalityService.On("GetById", context.Background(), 1).Return(locality.Locality{Id: 1}, nil)
+
+		_, err := service.GetNear(context.Background(), 1, 100)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_LOCATED)
+	})
+
+	t.Run("Deve retornar um erro caso GetAllLocated, retorne um error", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewServiceCarry(mockRepository, mockLocalityService, nil)
+
+		mockLocalityService.On("GetById", context.Background(), 1).Return(saoPaulo, nil)
+		mockRepository.On("GetAllLocated").Return(nil, fmt.Errorf("erro ao executar a query"))
+
+		_, err := service.GetNear(context.Background(), 1, 100)
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_GetByID(t *testing.T) {
+	t.Run("Deve retornar a Carry do id pesquisado", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+
+		result, err := service.GetByID(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, makeValidDBCarry(), result)
+	})
+
+	t.Run("Deve retornar um erro se a Carry não existir", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 9).Return(domain.Carry{}, fmt.Errorf(usecases.ERR_CARRY_NOT_FOUND))
+
+		_, err := service.GetByID(9)
+
+		assert.EqualError(t, err, usecases.ERR_CARRY_NOT_FOUND)
+	})
+}
+
+func Test_GetByCid(t *testing.T) {
+	t.Run("Deve retornar a Carry do cid pesquisado", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetCarryByCid", "CID#5").Return(makeValidDBCarry(), nil)
+
+		result, err := service.GetByCid("CID#5")
+
+		assert.Nil(t, err)
+		assert.Equal(t, makeValidDBCarry(), result)
+	})
+
+	t.Run("Deve retornar um erro se o cid não existir", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetCarryByCid", "CID#9").Return(domain.Carry{}, fmt.Errorf("a carry com esse `cid`: CID#9 não foi encontrada"))
+
+		_, err := service.GetByCid("CID#9")
+
+		assert.EqualError(t, err, usecases.ERR_CARRY_NOT_FOUND)
+	})
+}
+
+func Test_GetByLocality(t *testing.T) {
+	t.Run("Deve retornar as Carries da locality", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByLocality", 2).Return([]domain.Carry{makeValidDBCarry()}, nil)
+
+		result, err := service.GetByLocality(2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []domain.Carry{makeValidDBCarry()}, result)
+	})
+
+	t.Run("Deve retornar um erro caso GetByLocality, retorne um error", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByLocality", 2).Return(nil, fmt.Errorf("erro ao executar a query"))
+
+		result, err := service.GetByLocality(2)
+
+		assert.Error(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func Test_UpdateCarry(t *testing.T) {
+	t.Run("Deve atualizar a Carry", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		data := makeValidDBCarry()
+		data.Name = "mercado-envios"
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockRepository.On("GetCarryByCid", data.Cid).Return(makeValidDBCarry(), nil)
+		mockRepository.On("UpdateCarry", data).Return(data, nil)
+
+		result, err := service.UpdateCarry(data)
+
+		assert.Nil(t, err)
+		assert.Equal(t, data, result)
+	})
+
+	t.Run("Deve retornar um erro se a Carry não existir", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 1).Return(domain.Carry{}, fmt.Errorf(usecases.ERR_CARRY_NOT_FOUND))
+
+		_, err := service.UpdateCarry(makeValidDBCarry())
+
+		assert.EqualError(t, err, usecases.ERR_CARRY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar um erro se o cid pertencer a outra Carry", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		other := makeValidDBCarry()
+		other.ID = 2
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockRepository.On("GetCarryByCid", other.Cid).Return(other, nil)
+
+		_, err := service.UpdateCarry(makeValidDBCarry())
+
+		assert.EqualError(t, err, usecases.ERR_CID_IN_USE)
+	})
+
+	t.Run("Deve retornar um erro caso UpdateCarry, retorne um error", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockRepository.On("GetCarryByCid", "CID#5").Return(domain.Carry{}, fmt.Errorf("a carry não foi encontrada"))
+		mockRepository.On("UpdateCarry", makeValidDBCarry()).Return(domain.Carry{}, fmt.Errorf("erro ao executar a query"))
+
+		_, err := service.UpdateCarry(makeValidDBCarry())
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_DeleteCarry(t *testing.T) {
+	t.Run("Deve remover a Carry sem shipments", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockRepository.On("CountShipments", 1).Return(0, nil)
+		mockRepository.On("DeleteCarry", 1).Return(nil)
+
+		err := service.DeleteCarry(1)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Deve retornar um erro se a Carry tiver shipments", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockRepository.On("CountShipments", 1).Return(3, nil)
+
+		err := service.DeleteCarry(1)
+
+		assert.EqualError(t, err, usecases.ERR_CARRY_IN_USE)
+	})
+
+	t.Run("Deve retornar um erro se a Carry não existir", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 9).Return(domain.Carry{}, fmt.Errorf(usecases.ERR_CARRY_NOT_FOUND))
+
+		err := service.DeleteCarry(9)
+
+		assert.EqualError(t, err, usecases.ERR_CARRY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar um erro caso CountShipments, retorne um error", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockRepository.On("CountShipments", 1).Return(0, fmt.Errorf("erro ao executar a query"))
+
+		err := service.DeleteCarry(1)
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_Coverage(t *testing.T) {
+	coverage := domain.Coverage{CarryID: 1, LocalityIDs: []int{2, 3}, ProvinceIDs: []int{1}}
+
+	t.Run("Deve retornar a cobertura da Carry", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockRepository.On("GetCoverage", 1).Return(coverage, nil)
+
+		result, err := service.GetCoverage(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, coverage, result)
+	})
+
+	t.Run("Deve retornar um erro ao buscar a cobertura de uma Carry inexistente", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 9).Return(domain.Carry{}, fmt.Errorf(usecases.ERR_CARRY_NOT_FOUND))
+
+		_, err := service.GetCoverage(9)
+
+		assert.EqualError(t, err, usecases.ERR_CARRY_NOT_FOUND)
+	})
+
+	t.Run("Deve salvar a cobertura sem ids repetidos", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		mockLocalityService := localityMocks.NewService(t)
+		mockProvinceService := provinceMocks.NewService(t)
+		service := usecases.NewServiceCarry(mockRepository, mockLocalityService, mockProvinceService)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockLocalityService.On("GetById", context.Background(), 2).Return(locality.Locality{Id: 2}, nil)
+		mockLocalityService.On("GetById", context.Background(), 3).Return(locality.Locality{Id: 3}, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(province.Province{Id: 1}, nil)
+		mockRepository.On("SetCoverage", coverage).Return(nil)
+
+		result, err := service.SetCoverage(context.Background(),
+			domain.Coverage{CarryID: 1, LocalityIDs: []int{2, 3, 2}, ProvinceIDs: []int{1, 1}})
+
+		assert.Nil(t, err)
+		assert.Equal(t, coverage, result)
+	})
+
+	t.Run("Deve retornar um erro se uma locality da cobertura não existir", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewServiceCarry(mockRepository, mockLocalityService, nil)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockLocalityService.On("GetById", context.Background(), 2).Return(locality.Locality{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		_, err := service.SetCoverage(context.Background(), domain.Coverage{CarryID: 1, LocalityIDs: []int{2}})
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar um erro se uma province da cobertura não existir", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		mockProvinceService := provinceMocks.NewService(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, mockProvinceService)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockProvinceService.On("GetById", context.Background(), 9).Return(province.Province{}, fmt.Errorf(province.ERR_PROVINCE_NOT_FOUND))
+
+		_, err := service.SetCoverage(context.Background(), domain.Coverage{CarryID: 1, ProvinceIDs: []int{9}})
+
+		assert.EqualError(t, err, province.ERR_PROVINCE_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar um erro caso SetCoverage, retorne um error", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		mockProvinceService := provinceMocks.NewService(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, mockProvinceService)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(province.Province{Id: 1}, nil)
+		mockRepository.On("SetCoverage", mock.Anything).Return(fmt.Errorf("erro ao executar a query"))
+
+		_, err := service.SetCoverage(context.Background(), domain.Coverage{CarryID: 1, ProvinceIDs: []int{1}})
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_GetServing(t *testing.T) {
+	serving := []domain.CarryRate{{Carry: makeValidDBCarry(), RateCard: domain.RateCard{CarryID: 1, BasePrice: 10}}}
+
+	t.Run("Deve retornar as Carries que atendem a locality ou a sua province", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewServiceCarry(mockRepository, mockLocalityService, nil)
+
+		mockLocalityService.On("GetById", context.Background(), 2).Return(locality.Locality{Id: 2, ProvinceID: 7}, nil)
+		mockRepository.On("GetServing", 2, 7).Return(serving, nil)
+
+		result, err := service.GetServing(context.Background(), 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, serving, result)
+	})
+
+	t.Run("Deve retornar um erro se a locality não existir", func(t *testing.T) {
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewServiceCarry(mock_repository_carry.NewRepositoryCarry(t), mockLocalityService, nil)
+
+		mockLocalityService.On("GetById", context.Background(), 9).Return(locality.Locality{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		_, err := service.GetServing(context.Background(), 9)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar um erro caso GetServing, retorne um error", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewServiceCarry(mockRepository, mockLocalityService, nil)
+
+		mockLocalityService.On("GetById", context.Background(), 2).Return(locality.Locality{Id: 2, ProvinceID: 7}, nil)
+		mockRepository.On("GetServing", 2, 7).Return([]domain.CarryRate{}, fmt.Errorf("erro ao executar a query"))
+
+		_, err := service.GetServing(context.Background(), 2)
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
+
+func Test_RateCard(t *testing.T) {
+	rateCard := domain.RateCard{CarryID: 1, BasePrice: 10, PricePerKg: 1.5, PricePerKm: 0.2, RefrigeratedSurcharge: 25}
+
+	t.Run("Deve retornar a tabela de preços da Carry", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockRepository.On("GetRateCard", 1).Return(rateCard, nil)
+
+		result, err := service.GetRateCard(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, rateCard, result)
+	})
+
+	t.Run("Deve salvar a tabela de preços da Carry", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 1).Return(makeValidDBCarry(), nil)
+		mockRepository.On("SaveRateCard", rateCard).Return(rateCard, nil)
+
+		result, err := service.SaveRateCard(rateCard)
+
+		assert.Nil(t, err)
+		assert.Equal(t, rateCard, result)
+	})
+
+	t.Run("Deve retornar um erro se algum valor for negativo", func(t *testing.T) {
+		service := usecases.NewServiceCarry(mock_repository_carry.NewRepositoryCarry(t), nil, nil)
+
+		_, err := service.SaveRateCard(domain.RateCard{CarryID: 1, PricePerKm: -1})
+
+		assert.EqualError(t, err, usecases.ERR_INVALID_RATE_CARD)
+	})
+
+	t.Run("Deve retornar um erro ao salvar a tabela de uma Carry inexistente", func(t *testing.T) {
+		mockRepository := mock_repository_carry.NewRepositoryCarry(t)
+		service := usecases.NewServiceCarry(mockRepository, nil, nil)
+
+		mockRepository.On("GetByID", 9).Return(domain.Carry{}, fmt.Errorf(usecases.ERR_CARRY_NOT_FOUND))
+
+		_, err := service.SaveRateCard(domain.RateCard{CarryID: 9})
+
+		assert.EqualError(t, err, usecases.ERR_CARRY_NOT_FOUND)
+	})
+
+	t.Run("Deve calcular o preço com e sem refrigeração", func(t *testing.T) {
+		assert.InDelta(t, 10+1.5*4+0.2*100, rateCard.Price(4, 100, false), 0.0001)
+		assert.InDelta(t, 10+1.5*4+0.2*100+25, rateCard.Price(4, 100, true), 0.0001)
+	})
+}
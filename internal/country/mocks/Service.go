@@ -0,0 +1,130 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	country "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, countryName
+func (_m *Service) Create(ctx context.Context, countryName string) (country.Country, error) {
+	ret := _m.Called(ctx, countryName)
+
+	var r0 country.Country
+	if rf, ok := ret.Get(0).(func(context.Context, string) country.Country); ok {
+		r0 = rf(ctx, countryName)
+	} else {
+		r0 = ret.Get(0).(country.Country)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, countryName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Service) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *Service) GetAll(ctx context.Context) ([]country.Country, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []country.Country
+	if rf, ok := ret.Get(0).(func(context.Context) []country.Country); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]country.Country)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *Service) GetById(ctx context.Context, id int) (country.Country, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 country.Country
+	if rf, ok := ret.Get(0).(func(context.Context, int) country.Country); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(country.Country)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, countryName
+func (_m *Service) Update(ctx context.Context, id int, countryName string) (country.Country, error) {
+	ret := _m.Called(ctx, id, countryName)
+
+	var r0 country.Country
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) country.Country); ok {
+		r0 = rf(ctx, id, countryName)
+	} else {
+		r0 = ret.Get(0).(country.Country)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, countryName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
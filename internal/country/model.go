@@ -0,0 +1,6 @@
+package country
+
+type Country struct {
+	Id          int    `json:"id"`
+	CountryName string `json:"country_name"`
+}
@@ -0,0 +1,133 @@
+package country
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type Repository interface {
+	GetAll(ctx context.Context) ([]Country, error)
+	GetById(ctx context.Context, id int) (Country, error)
+	Create(ctx context.Context, countryName string) (Country, error)
+	Update(ctx context.Context, id int, countryName string) (Country, error)
+	Delete(ctx context.Context, id int) error
+	CountProvinces(ctx context.Context, id int) (int, error)
+}
+
+const (
+	GETALL          = "SELECT id, country_name FROM countries"
+	GETBYID         = "SELECT id, country_name FROM countries WHERE id = ?"
+	INSERT          = "INSERT INTO countries (country_name) VALUES (?)"
+	UPDATE          = "UPDATE countries SET country_name = ? WHERE id = ?"
+	DELETE          = "DELETE FROM countries WHERE id = ?"
+	COUNT_PROVINCES = "SELECT COUNT(*) FROM provinces WHERE id_country = ?"
+)
+
+type mariaDBRepository struct {
+	db *sql.DB
+}
+
+func NewMariaDBRepository(db *sql.DB) Repository {
+	return &mariaDBRepository{db: db}
+}
+
+func (m mariaDBRepository) GetAll(ctx context.Context) ([]Country, error) {
+	var countryList []Country
+
+	rows, err := m.db.QueryContext(ctx, GETALL)
+
+	if err != nil {
+		return countryList, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var country Country
+
+		err = rows.Scan(&country.Id, &country.CountryName)
+
+		if err != nil {
+			return countryList, err
+		}
+
+		countryList = append(countryList, country)
+	}
+
+	return countryList, nil
+}
+
+func (m mariaDBRepository) GetById(ctx context.Context, id int) (Country, error) {
+	var country Country
+
+	err := m.db.QueryRowContext(ctx, GETBYID, id).Scan(&country.Id, &country.CountryName)
+
+	if err == sql.ErrNoRows {
+		return Country{}, fmt.Errorf(ERR_COUNTRY_NOT_FOUND)
+	}
+
+	if err != nil {
+		return Country{}, err
+	}
+
+	return country, nil
+}
+
+func (m mariaDBRepository) Create(ctx context.Context, countryName string) (Country, error) {
+	country := Country{CountryName: countryName}
+
+	res, err := m.db.ExecContext(ctx, INSERT, &country.CountryName)
+
+	if err != nil {
+		return Country{}, err
+	}
+
+	lastID, err := res.LastInsertId()
+
+	if err != nil {
+		return Country{}, err
+	}
+
+	country.Id = int(lastID)
+
+	return country, nil
+}
+
+func (m mariaDBRepository) Update(ctx context.Context, id int, countryName string) (Country, error) {
+	_, err := m.db.ExecContext(ctx, UPDATE, countryName, id)
+
+	if err != nil {
+		return Country{}, err
+	}
+
+	return Country{Id: id, CountryName: countryName}, nil
+}
+
+func (m mariaDBRepository) Delete(ctx context.Context, id int) error {
+	res, err := m.db.ExecContext(ctx, DELETE, id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+
+	if rowsAffected == 0 {
+		return fmt.Errorf(ERR_COUNTRY_NOT_FOUND)
+	}
+
+	return nil
+}
+
+func (m mariaDBRepository) CountProvinces(ctx context.Context, id int) (int, error) {
+	var count int
+
+	err := m.db.QueryRowContext(ctx, COUNT_PROVINCES, id).Scan(&count)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
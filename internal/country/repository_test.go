@@ -0,0 +1,229 @@
+package country_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestRepository_GetAll(t *testing.T) {
+	t.Run("Deve retornar lista de countries com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expected := []country.Country{{Id: 1, CountryName: "Brasil"}, {Id: 2, CountryName: "Argentina"}}
+
+		rows := sqlmock.NewRows([]string{"id", "country_name"}).
+			AddRow(expected[0].Id, expected[0].CountryName).
+			AddRow(expected[1].Id, expected[1].CountryName)
+
+		mock.ExpectQuery(regexp.QuoteMeta(country.GETALL)).WillReturnRows(rows)
+
+		result, err := country.NewMariaDBRepository(db).GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Deve retornar erro no Scan", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "country_name"}).AddRow("", "")
+
+		mock.ExpectQuery(regexp.QuoteMeta(country.GETALL)).WillReturnRows(rows)
+
+		_, err = country.NewMariaDBRepository(db).GetAll(context.Background())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Deve retornar erro ao realizar o select", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(country.GETALL)).WillReturnError(fmt.Errorf("error"))
+
+		_, err = country.NewMariaDBRepository(db).GetAll(context.Background())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_GetById(t *testing.T) {
+	t.Run("Deve retornar o country com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "country_name"}).AddRow(1, "Brasil")
+
+		mock.ExpectQuery(regexp.QuoteMeta(country.GETBYID)).WithArgs(1).WillReturnRows(rows)
+
+		result, err := country.NewMariaDBRepository(db).GetById(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, country.Country{Id: 1, CountryName: "Brasil"}, result)
+	})
+
+	t.Run("Deve retornar erro quando o id não existir", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(country.GETBYID)).WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+		_, err = country.NewMariaDBRepository(db).GetById(context.Background(), 2)
+
+		assert.EqualError(t, err, country.ERR_COUNTRY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro ao realizar o select", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(country.GETBYID)).WithArgs(2).WillReturnError(fmt.Errorf("error"))
+
+		_, err = country.NewMariaDBRepository(db).GetById(context.Background(), 2)
+
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("Deve criar um country com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(country.INSERT)).WithArgs("Brasil").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		result, err := country.NewMariaDBRepository(db).Create(context.Background(), "Brasil")
+
+		assert.NoError(t, err)
+		assert.Equal(t, country.Country{Id: 1, CountryName: "Brasil"}, result)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(country.INSERT)).WithArgs("Brasil").
+			WillReturnError(fmt.Errorf("error"))
+
+		_, err = country.NewMariaDBRepository(db).Create(context.Background(), "Brasil")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	t.Run("Deve atualizar o country com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(country.UPDATE)).WithArgs("Brazil", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		result, err := country.NewMariaDBRepository(db).Update(context.Background(), 1, "Brazil")
+
+		assert.NoError(t, err)
+		assert.Equal(t, country.Country{Id: 1, CountryName: "Brazil"}, result)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(country.UPDATE)).WithArgs("Brazil", 1).
+			WillReturnError(fmt.Errorf("error"))
+
+		_, err = country.NewMariaDBRepository(db).Update(context.Background(), 1, "Brazil")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_Delete(t *testing.T) {
+	t.Run("Deve remover o country com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(country.DELETE)).WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = country.NewMariaDBRepository(db).Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Deve retornar erro quando o id não existir", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(country.DELETE)).WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = country.NewMariaDBRepository(db).Delete(context.Background(), 2)
+
+		assert.EqualError(t, err, country.ERR_COUNTRY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(country.DELETE)).WithArgs(1).
+			WillReturnError(fmt.Errorf("error"))
+
+		err = country.NewMariaDBRepository(db).Delete(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_CountProvinces(t *testing.T) {
+	t.Run("Deve retornar a quantidade de provinces", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"count"}).AddRow(3)
+
+		mock.ExpectQuery(regexp.QuoteMeta(country.COUNT_PROVINCES)).WithArgs(1).WillReturnRows(rows)
+
+		result, err := country.NewMariaDBRepository(db).CountProvinces(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(country.COUNT_PROVINCES)).WithArgs(1).WillReturnError(fmt.Errorf("error"))
+
+		_, err = country.NewMariaDBRepository(db).CountProvinces(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+}
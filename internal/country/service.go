@@ -0,0 +1,92 @@
+package country
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	ERR_COUNTRY_NOT_FOUND   = "country does not exists"
+	ERR_UNIQUE_COUNTRY_NAME = "country_name already exists"
+	ERR_COUNTRY_IN_USE      = "country has provinces"
+)
+
+type Service interface {
+	GetAll(ctx context.Context) ([]Country, error)
+	GetById(ctx context.Context, id int) (Country, error)
+	Create(ctx context.Context, countryName string) (Country, error)
+	Update(ctx context.Context, id int, countryName string) (Country, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type service struct {
+	repository Repository
+}
+
+func NewService(r Repository) Service {
+	return &service{repository: r}
+}
+
+func (s service) GetAll(ctx context.Context) ([]Country, error) {
+	return s.repository.GetAll(ctx)
+}
+
+func (s service) GetById(ctx context.Context, id int) (Country, error) {
+	return s.repository.GetById(ctx, id)
+}
+
+func (s service) Create(ctx context.Context, countryName string) (Country, error) {
+	err := s.countryNameExists(ctx, 0, countryName)
+
+	if err != nil {
+		return Country{}, err
+	}
+
+	return s.repository.Create(ctx, countryName)
+}
+
+func (s service) Update(ctx context.Context, id int, countryName string) (Country, error) {
+	_, err := s.repository.GetById(ctx, id)
+
+	if err != nil {
+		return Country{}, err
+	}
+
+	err = s.countryNameExists(ctx, id, countryName)
+
+	if err != nil {
+		return Country{}, err
+	}
+
+	return s.repository.Update(ctx, id, countryName)
+}
+
+func (s service) Delete(ctx context.Context, id int) error {
+	provinces, err := s.repository.CountProvinces(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if provinces > 0 {
+		return fmt.Errorf(ERR_COUNTRY_IN_USE)
+	}
+
+	return s.repository.Delete(ctx, id)
+}
+
+func (s service) countryNameExists(ctx context.Context, id int, countryName string) error {
+	countries, err := s.repository.GetAll(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range countries {
+		if countries[i].Id != id && strings.EqualFold(countries[i].CountryName, countryName) {
+			return fmt.Errorf(ERR_UNIQUE_COUNTRY_NAME)
+		}
+	}
+	return nil
+}
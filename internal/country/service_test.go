@@ -0,0 +1,136 @@
+package country_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country/mocks"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+var countryList = []country.Country{{Id: 1, CountryName: "Brasil"}, {Id: 2, CountryName: "Argentina"}}
+
+func TestService_GetAll(t *testing.T) {
+	t.Run("Deve retornar a lista de countries", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetAll", context.Background()).Return(countryList, nil)
+
+		result, err := country.NewService(mockRepo).GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, countryList, result)
+	})
+}
+
+func TestService_GetById(t *testing.T) {
+	t.Run("Deve retornar o country", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetById", context.Background(), 1).Return(countryList[0], nil)
+
+		result, err := country.NewService(mockRepo).GetById(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, countryList[0], result)
+	})
+}
+
+func TestService_Create(t *testing.T) {
+	t.Run("Deve criar um country com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		expected := country.Country{Id: 3, CountryName: "Chile"}
+
+		mockRepo.On("GetAll", context.Background()).Return(countryList, nil)
+		mockRepo.On("Create", context.Background(), "Chile").Return(expected, nil)
+
+		result, err := country.NewService(mockRepo).Create(context.Background(), "Chile")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Deve retornar erro quando o country_name já existir", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetAll", context.Background()).Return(countryList, nil)
+
+		_, err := country.NewService(mockRepo).Create(context.Background(), "brasil")
+
+		assert.EqualError(t, err, country.ERR_UNIQUE_COUNTRY_NAME)
+	})
+
+	t.Run("Deve retornar erro ao consultar a lista de countries", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetAll", context.Background()).Return([]country.Country{}, fmt.Errorf("error"))
+
+		_, err := country.NewService(mockRepo).Create(context.Background(), "Chile")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	t.Run("Deve atualizar o country com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		expected := country.Country{Id: 1, CountryName: "Brazil"}
+
+		mockRepo.On("GetById", context.Background(), 1).Return(countryList[0], nil)
+		mockRepo.On("GetAll", context.Background()).Return(countryList, nil)
+		mockRepo.On("Update", context.Background(), 1, "Brazil").Return(expected, nil)
+
+		result, err := country.NewService(mockRepo).Update(context.Background(), 1, "Brazil")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Deve retornar erro quando o country não existir", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetById", context.Background(), 9).
+			Return(country.Country{}, fmt.Errorf(country.ERR_COUNTRY_NOT_FOUND))
+
+		_, err := country.NewService(mockRepo).Update(context.Background(), 9, "Brazil")
+
+		assert.EqualError(t, err, country.ERR_COUNTRY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro quando o country_name pertencer a outro country", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetById", context.Background(), 1).Return(countryList[0], nil)
+		mockRepo.On("GetAll", context.Background()).Return(countryList, nil)
+
+		_, err := country.NewService(mockRepo).Update(context.Background(), 1, "Argentina")
+
+		assert.EqualError(t, err, country.ERR_UNIQUE_COUNTRY_NAME)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Run("Deve remover o country com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("CountProvinces", context.Background(), 1).Return(0, nil)
+		mockRepo.On("Delete", context.Background(), 1).Return(nil)
+
+		err := country.NewService(mockRepo).Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Deve retornar erro quando o country possuir provinces", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("CountProvinces", context.Background(), 1).Return(2, nil)
+
+		err := country.NewService(mockRepo).Delete(context.Background(), 1)
+
+		assert.EqualError(t, err, country.ERR_COUNTRY_IN_USE)
+	})
+
+	t.Run("Deve retornar erro ao contar as provinces", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("CountProvinces", context.Background(), 1).Return(0, fmt.Errorf("error"))
+
+		err := country.NewService(mockRepo).Delete(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+}
@@ -12,6 +12,27 @@ type Repository struct {
 	mock.Mock
 }
 
+// CountByWarehouse provides a mock function with given fields: warehouseId
+func (_m *Repository) CountByWarehouse(warehouseId int) (int, error) {
+	ret := _m.Called(warehouseId)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(warehouseId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(warehouseId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: cardNum, firstName, lastName, warehouseId
 func (_m *Repository) Create(cardNum int, firstName string, lastName string, warehouseId int) (employee.Employee, error) {
 	ret := _m.Called(cardNum, firstName, lastName, warehouseId)
@@ -91,20 +112,20 @@ func (_m *Repository) GetById(id int) (employee.Employee, error) {
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, firstName, lastName, warehouseId
-func (_m *Repository) Update(id int, firstName string, lastName string, warehouseId int) (employee.Employee, error) {
-	ret := _m.Called(id, firstName, lastName, warehouseId)
+// Update provides a mock function with given fields: id, cardNum, firstName, lastName, warehouseId
+func (_m *Repository) Update(id int, cardNum int, firstName string, lastName string, warehouseId int) (employee.Employee, error) {
+	ret := _m.Called(id, cardNum, firstName, lastName, warehouseId)
 
 	var r0 employee.Employee
-	if rf, ok := ret.Get(0).(func(int, string, string, int) employee.Employee); ok {
-		r0 = rf(id, firstName, lastName, warehouseId)
+	if rf, ok := ret.Get(0).(func(int, int, string, string, int) employee.Employee); ok {
+		r0 = rf(id, cardNum, firstName, lastName, warehouseId)
 	} else {
 		r0 = ret.Get(0).(employee.Employee)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, string, string, int) error); ok {
-		r1 = rf(id, firstName, lastName, warehouseId)
+	if rf, ok := ret.Get(1).(func(int, int, string, string, int) error); ok {
+		r1 = rf(id, cardNum, firstName, lastName, warehouseId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -9,7 +9,9 @@ const (
 
 	SqlUpdateFirstName = "UPDATE employees SET first_name=? WHERE id=?"
 
-	SqlUpdate = "UPDATE employees SET first_name=?, last_name=?, warehouse_id=? WHERE id=?"
+	SqlUpdate = "UPDATE employees SET card_number_id=?, first_name=?, last_name=?, warehouse_id=? WHERE id=?"
 
 	SqlDelete = "DELETE FROM employees WHERE id=?"
+
+	SqlCountByWarehouse = "SELECT COUNT(*) FROM employees WHERE warehouse_id=?"
 )
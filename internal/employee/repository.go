@@ -27,7 +27,8 @@ type Repository interface {
 	GetAll() ([]Employee, error)
 	Delete(id int) error
 	GetById(id int) (Employee, error)
-	Update(id int, firstName string, lastName string, warehouseId int) (Employee, error)
+	CountByWarehouse(warehouseId int) (int, error)
+	Update(id int, cardNum int, firstName string, lastName string, warehouseId int) (Employee, error)
 }
 
 type repository struct {
@@ -81,10 +82,10 @@ func (r repository) GetAll() ([]Employee, error) {
 	return employees, err
 }
 
-func (r repository) Update(id int, firstName string, lastName string, warehouseId int) (Employee, error) {
+func (r repository) Update(id int, cardNum int, firstName string, lastName string, warehouseId int) (Employee, error) {
 	olderEmployee, _ := r.GetById(id)
-	newEmployee := Employee{id, olderEmployee.CardNumber, firstName, lastName, warehouseId}
-	res, err := r.db.Exec(SqlUpdate, firstName, lastName, warehouseId, id)
+	newEmployee := Employee{id, cardNum, firstName, lastName, warehouseId}
+	res, err := r.db.Exec(SqlUpdate, cardNum, firstName, lastName, warehouseId, id)
 	if err != nil {
 		return Employee{}, fmt.Errorf("funcionario nao existe")
 	}
@@ -131,3 +132,14 @@ func (r repository) GetById(id int) (Employee, error) {
 
 	return emp, nil
 }
+
+func (r repository) CountByWarehouse(warehouseId int) (int, error) {
+	var count int
+
+	err := r.db.QueryRow(SqlCountByWarehouse, warehouseId).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
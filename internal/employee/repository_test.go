@@ -69,11 +69,11 @@ func TestRepositoryUpdate(t *testing.T) {
 
 		rows := mockRow()
 		emp := createEmployeeArray()[0]
-		mock.ExpectExec(regexp.QuoteMeta(employees.SqlUpdate)).WithArgs(&emp.FirstName,
+		mock.ExpectExec(regexp.QuoteMeta(employees.SqlUpdate)).WithArgs(&emp.CardNumber, &emp.FirstName,
 			&emp.LastName, &emp.WareHouseID, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectQuery(regexp.QuoteMeta(employees.SqlGetById)).WithArgs(1).WillReturnRows(rows)
 		employeesRepo := employees.NewRepository(db)
-		result, err := employeesRepo.Update(1, emp.FirstName, emp.LastName, emp.WareHouseID)
+		result, err := employeesRepo.Update(1, emp.CardNumber, emp.FirstName, emp.LastName, emp.WareHouseID)
 		assert.NoError(t, err)
 		assert.Equal(t, result, emp)
 	})
@@ -85,7 +85,7 @@ func TestRepositoryUpdate(t *testing.T) {
 		expectedError := fmt.Errorf("funcionario nao existe")
 		mock.ExpectQuery(regexp.QuoteMeta(employees.SqlUpdate)).WithArgs(13).WillReturnError(expectedError)
 		employeesRepo := employees.NewRepository(db)
-		result, err := employeesRepo.Update(13, "novo", "nome", 3)
+		result, err := employeesRepo.Update(13, 4321, "novo", "nome", 3)
 
 		assert.Equal(t, err, expectedError)
 		assert.Equal(t, result, employees.Employee{})
@@ -193,3 +193,26 @@ func TestRepositoryGetById(t *testing.T) {
 	})
 
 }
+
+func TestRepositoryCountByWarehouse(t *testing.T) {
+	t.Run("count_by_warehouse_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"count"}).AddRow(4)
+		mock.ExpectQuery(regexp.QuoteMeta(employees.SqlCountByWarehouse)).WithArgs(1).WillReturnRows(rows)
+		employeesRepo := employees.NewRepository(db)
+		count, err := employeesRepo.CountByWarehouse(1)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, count)
+	})
+	t.Run("count_by_warehouse_fail_select", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(employees.SqlCountByWarehouse)).WithArgs(1).WillReturnError(sql.ErrConnDone)
+		employeesRepo := employees.NewRepository(db)
+		_, err = employeesRepo.CountByWarehouse(1)
+		assert.Error(t, err)
+	})
+}
@@ -4,6 +4,10 @@ import (
 	"fmt"
 )
 
+const (
+	ERROR_UNIQUE_CARD_NUMBER = "funcionario com cartão n: %d ja existe no banco de dados"
+)
+
 type EmployeeOrderCount struct {
 	ID          int    `json:"id"`
 	CardNumber  int    `json:"card_number_id"`
@@ -43,7 +47,7 @@ func (s *service) validateCardNumber(cardNum int) bool {
 
 func (s *service) Create(cardNum int, firstName string, lastName string, warehouseId int) (Employee, error) {
 	if !s.validateCardNumber(cardNum) {
-		return Employee{}, fmt.Errorf("funcionario com cartão n: %d ja existe no banco de dados", cardNum)
+		return Employee{}, fmt.Errorf(ERROR_UNIQUE_CARD_NUMBER, cardNum)
 	}
 	emps, err := s.repository.Create(cardNum, firstName, lastName, warehouseId)
 	if err != nil {
@@ -93,6 +97,14 @@ func (s service) GetById(id int) (Employee, error) {
 func (s *service) Update(emp Employee, id int) (Employee, error) {
 	empToMatch, _ := s.repository.GetById(id)
 
+	if emp.CardNumber == 0 {
+		emp.CardNumber = empToMatch.CardNumber
+	}
+
+	if emp.CardNumber != empToMatch.CardNumber && !s.validateCardNumber(emp.CardNumber) {
+		return Employee{}, fmt.Errorf(ERROR_UNIQUE_CARD_NUMBER, emp.CardNumber)
+	}
+
 	if emp.FirstName == "" {
 		emp.FirstName = empToMatch.FirstName
 	}
@@ -105,7 +117,7 @@ func (s *service) Update(emp Employee, id int) (Employee, error) {
 		emp.WareHouseID = empToMatch.WareHouseID
 	}
 
-	employee, err := s.repository.Update(id, emp.FirstName, emp.LastName, emp.WareHouseID)
+	employee, err := s.repository.Update(id, emp.CardNumber, emp.FirstName, emp.LastName, emp.WareHouseID)
 	if err != nil {
 		return Employee{}, fmt.Errorf("funcionario nao existe")
 	}
@@ -119,7 +119,7 @@ func TestUpdate(t *testing.T) {
 		}
 
 		mockRepository.On("GetById", 2).Return(expected, nil)
-		mockRepository.On("Update", 2, expected.FirstName, expected.LastName, expected.WareHouseID).Return(expected, nil)
+		mockRepository.On("Update", 2, expected.CardNumber, expected.FirstName, expected.LastName, expected.WareHouseID).Return(expected, nil)
 		employee, err := service.Update(expected, 2)
 		assert.Nil(t, err)
 		assert.Equal(t, expected, employee)
@@ -135,8 +135,32 @@ func TestUpdate(t *testing.T) {
 		e := fmt.Errorf("funcionario nao existe")
 
 		mockRepository.On("GetById", 15).Return(expected, nil)
-		mockRepository.On("Update", 15, expected.FirstName, expected.LastName, expected.WareHouseID).Return(expected, e)
+		mockRepository.On("Update", 15, expected.CardNumber, expected.FirstName, expected.LastName, expected.WareHouseID).Return(expected, e)
 		_, err := service.Update(expected, 15)
 		assert.Equal(t, e, err)
 	})
+	t.Run("update_card_number", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := employee.NewService(mockRepository)
+		employees := createEmployeeArray()
+		expected := employees[0]
+		expected.CardNumber = 555
+
+		mockRepository.On("GetById", 1).Return(employees[0], nil)
+		mockRepository.On("GetAll").Return(employees, nil)
+		mockRepository.On("Update", 1, 555, expected.FirstName, expected.LastName, expected.WareHouseID).Return(expected, nil)
+		emp, err := service.Update(employee.Employee{CardNumber: 555}, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, emp)
+	})
+	t.Run("update_card_number_conflict", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := employee.NewService(mockRepository)
+		employees := createEmployeeArray()
+
+		mockRepository.On("GetById", 1).Return(employees[0], nil)
+		mockRepository.On("GetAll").Return(employees, nil)
+		_, err := service.Update(employee.Employee{CardNumber: employees[1].CardNumber}, 1)
+		assert.Equal(t, fmt.Errorf("funcionario com cartão n: 7878447 ja existe no banco de dados"), err)
+	})
 }
@@ -33,6 +33,27 @@ func (_m *Repository) Create(orderDate string, orderNumber string, employeeId in
 	return r0, r1
 }
 
+// GetActivityByWarehouse provides a mock function with given fields: warehouseId, from, to
+func (_m *Repository) GetActivityByWarehouse(warehouseId int, from string, to string) (inboundorders.WarehouseActivity, error) {
+	ret := _m.Called(warehouseId, from, to)
+
+	var r0 inboundorders.WarehouseActivity
+	if rf, ok := ret.Get(0).(func(int, string, string) inboundorders.WarehouseActivity); ok {
+		r0 = rf(warehouseId, from, to)
+	} else {
+		r0 = ret.Get(0).(inboundorders.WarehouseActivity)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, string, string) error); ok {
+		r1 = rf(warehouseId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCountByEmployee provides a mock function with given fields: id
 func (_m *Repository) GetCountByEmployee(id int) int {
 	ret := _m.Called(id)
@@ -5,5 +5,7 @@ const (
 
 	SqlGetAllbyId = "SELECT id FROM inbound_orders WHERE employee_id=?;"
 
+	SqlActivityByWarehouse = "SELECT COUNT(*), COUNT(DISTINCT employee_id), COUNT(DISTINCT product_batch_id) FROM inbound_orders WHERE warehouse_id=? AND order_date >= ? AND order_date < ?"
+
 	SqlCreate = "INSERT INTO inbound_orders (`order_date`, `order_number`, `employee_id`, `product_batch_id`, `warehouse_id`) VALUES (?, ?, ?, ?, ?)"
 )
@@ -15,9 +15,18 @@ type InboundOrder struct {
 	WarehouseId    int    `json:"warehouse_id"`
 }
 
+// WarehouseActivity is what a warehouse received in a period: its inbound
+// orders, the employees that took them and the product batches they brought.
+type WarehouseActivity struct {
+	InboundOrders  int `json:"inbound_orders"`
+	Employees      int `json:"employees"`
+	ProductBatches int `json:"product_batches"`
+}
+
 type Repository interface {
 	Create(orderDate string, orderNumber string, employeeId int, productBatchId int, warehouseId int) (InboundOrder, error)
 	GetCountByEmployee(id int) (count int)
+	GetActivityByWarehouse(warehouseId int, from string, to string) (WarehouseActivity, error)
 }
 
 type repository struct {
@@ -62,3 +71,17 @@ func (r repository) GetCountByEmployee(id int) (count int) {
 
 	return counter
 }
+
+// GetActivityByWarehouse counts the inbound orders of the warehouse dated from
+// from, inclusive, to to, exclusive.
+func (r repository) GetActivityByWarehouse(warehouseId int, from string, to string) (WarehouseActivity, error) {
+	var activity WarehouseActivity
+
+	err := r.db.QueryRow(SqlActivityByWarehouse, warehouseId, from, to).
+		Scan(&activity.InboundOrders, &activity.Employees, &activity.ProductBatches)
+	if err != nil {
+		return WarehouseActivity{}, err
+	}
+
+	return activity, nil
+}
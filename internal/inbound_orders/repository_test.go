@@ -72,3 +72,28 @@ func TestGetCountByEmployee(t *testing.T) {
 		assert.Equal(t, result, io)
 	})
 }
+
+func TestRepositoryGetActivityByWarehouse(t *testing.T) {
+	t.Run("activity_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"inbound_orders", "employees", "product_batches"}).AddRow(5, 2, 4)
+		mock.ExpectQuery(regexp.QuoteMeta(inboundorders.SqlActivityByWarehouse)).
+			WithArgs(1, "2026-10-01 00:00:00", "2026-10-20 00:00:00").WillReturnRows(rows)
+		inboundordersRepo := inboundorders.NewRepository(db)
+		activity, err := inboundordersRepo.GetActivityByWarehouse(1, "2026-10-01 00:00:00", "2026-10-20 00:00:00")
+		assert.NoError(t, err)
+		assert.Equal(t, inboundorders.WarehouseActivity{InboundOrders: 5, Employees: 2, ProductBatches: 4}, activity)
+	})
+	t.Run("activity_fail_select", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(inboundorders.SqlActivityByWarehouse)).
+			WithArgs(1, "2026-10-01 00:00:00", "2026-10-20 00:00:00").WillReturnError(fmt.Errorf("connection refused"))
+		inboundordersRepo := inboundorders.NewRepository(db)
+		_, err = inboundordersRepo.GetActivityByWarehouse(1, "2026-10-01 00:00:00", "2026-10-20 00:00:00")
+		assert.Error(t, err)
+	})
+}
@@ -14,20 +14,20 @@ type Repository struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, zipCode, localityName, provinceName, countryName
-func (_m *Repository) Create(ctx context.Context, zipCode string, localityName string, provinceName string, countryName string) (locality.Locality, error) {
-	ret := _m.Called(ctx, zipCode, localityName, provinceName, countryName)
+// Create provides a mock function with given fields: ctx, zipCode, localityName, provinceID, latitude, longitude
+func (_m *Repository) Create(ctx context.Context, zipCode string, localityName string, provinceID int, latitude *float64, longitude *float64) (locality.Locality, error) {
+	ret := _m.Called(ctx, zipCode, localityName, provinceID, latitude, longitude)
 
 	var r0 locality.Locality
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) locality.Locality); ok {
-		r0 = rf(ctx, zipCode, localityName, provinceName, countryName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, *float64, *float64) locality.Locality); ok {
+		r0 = rf(ctx, zipCode, localityName, provinceID, latitude, longitude)
 	} else {
 		r0 = ret.Get(0).(locality.Locality)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
-		r1 = rf(ctx, zipCode, localityName, provinceName, countryName)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, *float64, *float64) error); ok {
+		r1 = rf(ctx, zipCode, localityName, provinceID, latitude, longitude)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -35,6 +35,20 @@ func (_m *Repository) Create(ctx context.Context, zipCode string, localityName s
 	return r0, r1
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Repository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAll provides a mock function with given fields: ctx
 func (_m *Repository) GetAll(ctx context.Context) ([]locality.Locality, error) {
 	ret := _m.Called(ctx)
@@ -79,15 +93,36 @@ func (_m *Repository) GetById(ctx context.Context, id int) (locality.Locality, e
 	return r0, r1
 }
 
-// ReportSellers provides a mock function with given fields: ctx, id
-func (_m *Repository) ReportSellers(ctx context.Context, id int) (locality.ReportSeller, error) {
+// GetByZipCode provides a mock function with given fields: ctx, zipCode
+func (_m *Repository) GetByZipCode(ctx context.Context, zipCode string) (locality.Locality, error) {
+	ret := _m.Called(ctx, zipCode)
+
+	var r0 locality.Locality
+	if rf, ok := ret.Get(0).(func(context.Context, string) locality.Locality); ok {
+		r0 = rf(ctx, zipCode)
+	} else {
+		r0 = ret.Get(0).(locality.Locality)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, zipCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Report provides a mock function with given fields: ctx, id
+func (_m *Repository) Report(ctx context.Context, id int) (locality.Report, error) {
 	ret := _m.Called(ctx, id)
 
-	var r0 locality.ReportSeller
-	if rf, ok := ret.Get(0).(func(context.Context, int) locality.ReportSeller); ok {
+	var r0 locality.Report
+	if rf, ok := ret.Get(0).(func(context.Context, int) locality.Report); ok {
 		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(locality.ReportSeller)
+		r0 = ret.Get(0).(locality.Report)
 	}
 
 	var r1 error
@@ -100,6 +135,50 @@ func (_m *Repository) ReportSellers(ctx context.Context, id int) (locality.Repor
 	return r0, r1
 }
 
+// ReportAll provides a mock function with given fields: ctx
+func (_m *Repository) ReportAll(ctx context.Context) ([]locality.Report, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []locality.Report
+	if rf, ok := ret.Get(0).(func(context.Context) []locality.Report); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]locality.Report)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, zipCode, localityName, provinceID, latitude, longitude
+func (_m *Repository) Update(ctx context.Context, id int, zipCode string, localityName string, provinceID int, latitude *float64, longitude *float64) (locality.Locality, error) {
+	ret := _m.Called(ctx, id, zipCode, localityName, provinceID, latitude, longitude)
+
+	var r0 locality.Locality
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, int, *float64, *float64) locality.Locality); ok {
+		r0 = rf(ctx, id, zipCode, localityName, provinceID, latitude, longitude)
+	} else {
+		r0 = ret.Get(0).(locality.Locality)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string, int, *float64, *float64) error); ok {
+		r1 = rf(ctx, id, zipCode, localityName, provinceID, latitude, longitude)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -1,15 +1,22 @@
 package locality
 
 type Locality struct {
-	Id           int    `json:"id"`
-	ZipCode      string `json:"zip_code"`
-	LocalityName string `json:"locality_name"`
-	ProvinceName string `json:"province_name"`
-	CountryName  string `json:"country_name"`
+	Id           int      `json:"id"`
+	ZipCode      string   `json:"zip_code"`
+	LocalityName string   `json:"locality_name"`
+	ProvinceID   int      `json:"province_id"`
+	ProvinceName string   `json:"province_name"`
+	CountryID    int      `json:"country_id"`
+	CountryName  string   `json:"country_name"`
+	Latitude     *float64 `json:"latitude"`
+	Longitude    *float64 `json:"longitude"`
 }
 
-type ReportSeller struct {
-	LocalityID   int    `json:"locality_id"`
-	LocalityName string `json:"locality_name"`
-	SellersCount int    `json:"sellers_count"`
+type Report struct {
+	LocalityID      int    `json:"locality_id"`
+	LocalityName    string `json:"locality_name"`
+	SellersCount    int    `json:"sellers_count"`
+	CarriersCount   int    `json:"carriers_count"`
+	WarehousesCount int    `json:"warehouses_count"`
+	BuyersCount     int    `json:"buyers_count"`
 }
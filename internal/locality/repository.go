@@ -9,15 +9,37 @@ import (
 type Repository interface {
 	GetAll(ctx context.Context) ([]Locality, error)
 	GetById(ctx context.Context, id int) (Locality, error)
-	Create(ctx context.Context, zipCode, localityName, provinceName, countryName string) (Locality, error)
-	ReportSellers(ctx context.Context, id int) (ReportSeller, error)
+	GetByZipCode(ctx context.Context, zipCode string) (Locality, error)
+	Create(ctx context.Context, zipCode, localityName string, provinceID int, latitude, longitude *float64) (Locality, error)
+	Update(ctx context.Context, id int, zipCode, localityName string, provinceID int, latitude, longitude *float64) (Locality, error)
+	Delete(ctx context.Context, id int) error
+	Report(ctx context.Context, id int) (Report, error)
+	ReportAll(ctx context.Context) ([]Report, error)
 }
 
 const (
-	GET_REPORT_SELLER = "SELECT l.id, l.locality_name, COUNT(sellers.id) FROM localities l LEFT JOIN sellers ON l.id=sellers.locality_id WHERE l.id = ?"
-	INSERT            = "INSERT INTO localities (zip_code, locality_name, province_name, country_name) VALUES (?,?,?,?)"
-	GETALL            = "SELECT * FROM localities"
-	GETBYID           = "SELECT * FROM localities WHERE id = ?"
+	INSERT = "INSERT INTO localities (zip_code, locality_name, province_id, latitude, longitude) VALUES (?,?,?,?,?)"
+	UPDATE = "UPDATE localities SET zip_code = ?, locality_name = ?, province_id = ?, latitude = ?, longitude = ? WHERE id = ?"
+	DELETE = "DELETE FROM localities WHERE id = ?"
+	GETALL = `SELECT l.id, l.zip_code, l.locality_name, p.id, p.province_name, c.id, c.country_name, l.latitude, l.longitude
+							FROM localities l
+							JOIN provinces p ON p.id = l.province_id JOIN countries c ON c.id = p.id_country`
+	GETBYID      = GETALL + " WHERE l.id = ?"
+	GETBYZIPCODE = GETALL + " WHERE l.zip_code = ?"
+
+	// The buyers of a locality are the ones that ordered products sold by its sellers.
+	GET_REPORT_ALL = `SELECT l.id, l.locality_name,
+				(SELECT COUNT(*) FROM sellers s WHERE s.locality_id = l.id),
+				(SELECT COUNT(*) FROM carriers ca WHERE ca.locality_id = l.id),
+				(SELECT COUNT(*) FROM warehouse w WHERE w.locality_id = l.id),
+				(SELECT COUNT(DISTINCT po.buyer_id) FROM purchase_orders po
+					JOIN order_details od ON od.purchase_order_id = po.id
+					JOIN product_records pr ON pr.id = od.product_record_id
+					JOIN products pd ON pd.id = pr.product_id
+					JOIN sellers s ON s.id = pd.seller_id
+					WHERE s.locality_id = l.id)
+				FROM localities l`
+	GET_REPORT = GET_REPORT_ALL + " WHERE l.id = ?"
 )
 
 type mariaDBRepository struct {
@@ -28,33 +50,55 @@ func NewMariaDBRepository(db *sql.DB) Repository {
 	return &mariaDBRepository{db: db}
 }
 
-func (m mariaDBRepository) ReportSellers(ctx context.Context, id int) (ReportSeller, error) {
-	var reportSeller ReportSeller
+func (m mariaDBRepository) Report(ctx context.Context, id int) (Report, error) {
+	var report Report
 
-	rows, err := m.db.QueryContext(ctx, GET_REPORT_SELLER, id)
+	err := m.db.QueryRowContext(ctx, GET_REPORT, id).Scan(&report.LocalityID, &report.LocalityName,
+		&report.SellersCount, &report.CarriersCount, &report.WarehousesCount, &report.BuyersCount)
+
+	if err == sql.ErrNoRows {
+		return Report{}, fmt.Errorf(ERR_LOCALITY_NOT_FOUND)
+	}
+
+	if err != nil {
+		return Report{}, err
+	}
+
+	return report, nil
+}
+
+func (m mariaDBRepository) ReportAll(ctx context.Context) ([]Report, error) {
+	reportList := []Report{}
+
+	rows, err := m.db.QueryContext(ctx, GET_REPORT_ALL)
 
 	if err != nil {
-		return ReportSeller{}, err
+		return reportList, err
 	}
 
 	defer rows.Close()
 
 	for rows.Next() {
+		var report Report
 
-		rows.Scan(&reportSeller.LocalityID, &reportSeller.LocalityName, &reportSeller.SellersCount)
+		err = rows.Scan(&report.LocalityID, &report.LocalityName,
+			&report.SellersCount, &report.CarriersCount, &report.WarehousesCount, &report.BuyersCount)
 
 		if err != nil {
-			return ReportSeller{}, err
+			return []Report{}, err
 		}
+
+		reportList = append(reportList, report)
 	}
-	return reportSeller, nil
+
+	return reportList, nil
 }
 
-func (m mariaDBRepository) Create(ctx context.Context, zipCode, localityName, provinceName, countryName string) (Locality, error) {
+func (m mariaDBRepository) Create(ctx context.Context, zipCode, localityName string, provinceID int, latitude, longitude *float64) (Locality, error) {
 
-	locality := Locality{ZipCode: zipCode, LocalityName: localityName, ProvinceName: provinceName, CountryName: countryName}
+	locality := Locality{ZipCode: zipCode, LocalityName: localityName, ProvinceID: provinceID, Latitude: latitude, Longitude: longitude}
 
-	res, err := m.db.ExecContext(ctx, INSERT, &locality.ZipCode, &locality.LocalityName, &locality.ProvinceName, &locality.CountryName)
+	res, err := m.db.ExecContext(ctx, INSERT, locality.ZipCode, locality.LocalityName, locality.ProvinceID, locality.Latitude, locality.Longitude)
 
 	if err != nil {
 		return Locality{}, err
@@ -85,7 +129,7 @@ func (m mariaDBRepository) GetAll(ctx context.Context) ([]Locality, error) {
 	for rows.Next() {
 		var locality Locality
 
-		err = rows.Scan(&locality.Id, &locality.ZipCode, &locality.LocalityName, &locality.ProvinceName, &locality.CountryName)
+		err = rows.Scan(&locality.Id, &locality.ZipCode, &locality.LocalityName, &locality.ProvinceID, &locality.ProvinceName, &locality.CountryID, &locality.CountryName, &locality.Latitude, &locality.Longitude)
 
 		if err != nil {
 			return localityList, err
@@ -109,7 +153,7 @@ func (m mariaDBRepository) GetById(ctx context.Context, id int) (Locality, error
 	defer rows.Close()
 
 	for rows.Next() {
-		err := rows.Scan(&locality.Id, &locality.ZipCode, &locality.LocalityName, &locality.ProvinceName, &locality.CountryName)
+		err := rows.Scan(&locality.Id, &locality.ZipCode, &locality.LocalityName, &locality.ProvinceID, &locality.ProvinceName, &locality.CountryID, &locality.CountryName, &locality.Latitude, &locality.Longitude)
 
 		if err != nil {
 			return locality, err
@@ -122,5 +166,48 @@ func (m mariaDBRepository) GetById(ctx context.Context, id int) (Locality, error
 		return locality, err
 	}
 
-	return locality, fmt.Errorf("id does not exists")
+	return locality, fmt.Errorf(ERR_LOCALITY_NOT_FOUND)
+}
+
+func (m mariaDBRepository) GetByZipCode(ctx context.Context, zipCode string) (Locality, error) {
+	var locality Locality
+
+	err := m.db.QueryRowContext(ctx, GETBYZIPCODE, zipCode).Scan(&locality.Id, &locality.ZipCode, &locality.LocalityName,
+		&locality.ProvinceID, &locality.ProvinceName, &locality.CountryID, &locality.CountryName, &locality.Latitude, &locality.Longitude)
+
+	if err == sql.ErrNoRows {
+		return Locality{}, fmt.Errorf(ERR_LOCALITY_NOT_FOUND)
+	}
+
+	if err != nil {
+		return Locality{}, err
+	}
+
+	return locality, nil
+}
+
+func (m mariaDBRepository) Update(ctx context.Context, id int, zipCode, localityName string, provinceID int, latitude, longitude *float64) (Locality, error) {
+	_, err := m.db.ExecContext(ctx, UPDATE, zipCode, localityName, provinceID, latitude, longitude, id)
+
+	if err != nil {
+		return Locality{}, err
+	}
+
+	return Locality{Id: id, ZipCode: zipCode, LocalityName: localityName, ProvinceID: provinceID, Latitude: latitude, Longitude: longitude}, nil
+}
+
+func (m mariaDBRepository) Delete(ctx context.Context, id int) error {
+	res, err := m.db.ExecContext(ctx, DELETE, id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+
+	if rowsAffected == 0 {
+		return fmt.Errorf(ERR_LOCALITY_NOT_FOUND)
+	}
+
+	return nil
 }
@@ -17,14 +17,14 @@ func TestRepository_GetAll(t *testing.T) {
 
 	defer db.Close()
 
-	mockLocality := []locality.Locality{{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"},
-		{Id: 1, ZipCode: "6701", LocalityName: "Manaus", ProvinceName: "Amazonia", CountryName: "BRA"}}
+	mockLocality := []locality.Locality{{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1, ProvinceName: "SP", CountryID: 1, CountryName: "BRA"},
+		{Id: 1, ZipCode: "6701", LocalityName: "Manaus", ProvinceID: 2, ProvinceName: "Amazonia", CountryID: 1, CountryName: "BRA"}}
 
 	t.Run("Deve retornar lista de localities com sucesso", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
-			"id", "zip_code", "locality_name", "province_name", "country_name",
-		}).AddRow(mockLocality[0].Id, mockLocality[0].ZipCode, mockLocality[0].LocalityName, mockLocality[0].ProvinceName, mockLocality[0].CountryName).
-			AddRow(mockLocality[1].Id, mockLocality[1].ZipCode, mockLocality[1].LocalityName, mockLocality[1].ProvinceName, mockLocality[1].CountryName)
+			"id", "zip_code", "locality_name", "province_id", "province_name", "country_id", "country_name", "latitude", "longitude",
+		}).AddRow(mockLocality[0].Id, mockLocality[0].ZipCode, mockLocality[0].LocalityName, mockLocality[0].ProvinceID, mockLocality[0].ProvinceName, mockLocality[0].CountryID, mockLocality[0].CountryName, mockLocality[0].Latitude, mockLocality[0].Longitude).
+			AddRow(mockLocality[1].Id, mockLocality[1].ZipCode, mockLocality[1].LocalityName, mockLocality[1].ProvinceID, mockLocality[1].ProvinceName, mockLocality[1].CountryID, mockLocality[1].CountryName, mockLocality[1].Latitude, mockLocality[1].Longitude)
 
 		mock.ExpectQuery(regexp.QuoteMeta(locality.GETALL)).WillReturnRows(rows)
 
@@ -37,8 +37,8 @@ func TestRepository_GetAll(t *testing.T) {
 	t.Run("Deve retornar erro no Scan", func(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{
-			"id", "zip_code", "locality_name", "province_name", "country_name",
-		}).AddRow("", "", "", "", "")
+			"id", "zip_code", "locality_name", "province_id", "province_name", "country_id", "country_name", "latitude", "longitude",
+		}).AddRow("", "", "", "", "", "", "", "", "")
 
 		mock.ExpectQuery(regexp.QuoteMeta(locality.GETALL)).WillReturnRows(rows)
 
@@ -71,11 +71,11 @@ func TestRepository_GetById(t *testing.T) {
 
 		defer db.Close()
 
-		localityOne := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
+		localityOne := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1, ProvinceName: "SP", CountryID: 1, CountryName: "BRA"}
 
 		rows := sqlmock.NewRows([]string{
-			"id", "zip_code", "locality_name", "province_name", "country_name",
-		}).AddRow(localityOne.Id, localityOne.ZipCode, localityOne.LocalityName, localityOne.ProvinceName, localityOne.CountryName)
+			"id", "zip_code", "locality_name", "province_id", "province_name", "country_id", "country_name", "latitude", "longitude",
+		}).AddRow(localityOne.Id, localityOne.ZipCode, localityOne.LocalityName, localityOne.ProvinceID, localityOne.ProvinceName, localityOne.CountryID, localityOne.CountryName, localityOne.Latitude, localityOne.Longitude)
 
 		mock.ExpectQuery("SELECT").WithArgs(1).WillReturnRows(rows)
 
@@ -110,8 +110,8 @@ func TestRepository_GetById(t *testing.T) {
 		defer db.Close()
 
 		rows := sqlmock.NewRows([]string{
-			"id", "zip_code", "locality_name", "province_name", "country_name",
-		}).AddRow("", "", "", "", "")
+			"id", "zip_code", "locality_name", "province_id", "province_name", "country_id", "country_name", "latitude", "longitude",
+		}).AddRow("", "", "", "", "", "", "", "", "")
 
 		mock.ExpectQuery("SELECT").WithArgs(1).WillReturnRows(rows)
 
@@ -135,6 +135,58 @@ func TestRepository_GetById(t *testing.T) {
 	})
 }
 
+func TestRepository_GetByZipCode(t *testing.T) {
+
+	t.Run("Deve retornar a locality do zip code com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		localityOne := locality.Locality{Id: 1, ZipCode: "07010000", LocalityName: "Guarulhos", ProvinceID: 1, ProvinceName: "São Paulo", CountryID: 1, CountryName: "Brasil"}
+
+		rows := sqlmock.NewRows([]string{
+			"id", "zip_code", "locality_name", "province_id", "province_name", "country_id", "country_name", "latitude", "longitude",
+		}).AddRow(localityOne.Id, localityOne.ZipCode, localityOne.LocalityName, localityOne.ProvinceID, localityOne.ProvinceName, localityOne.CountryID, localityOne.CountryName, localityOne.Latitude, localityOne.Longitude)
+
+		mock.ExpectQuery(regexp.QuoteMeta(locality.GETBYZIPCODE)).WithArgs("07010000").WillReturnRows(rows)
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		result, err := localityRepo.GetByZipCode(context.Background(), "07010000")
+
+		assert.NoError(t, err)
+		assert.Equal(t, result, localityOne)
+	})
+
+	t.Run("Deve retornar error quando o zip code não existir", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(locality.GETBYZIPCODE)).WithArgs("07010000").WillReturnError(sql.ErrNoRows)
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		_, err = localityRepo.GetByZipCode(context.Background(), "07010000")
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro ao realizar o select", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(locality.GETBYZIPCODE)).WithArgs("07010000").WillReturnError(fmt.Errorf("error"))
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		_, err = localityRepo.GetByZipCode(context.Background(), "07010000")
+
+		assert.EqualError(t, err, "error")
+	})
+}
+
 func TestMariaDBRepository_Create(t *testing.T) {
 	t.Run("Deve criar uma locality com sucesso", func(t *testing.T) {
 
@@ -144,14 +196,14 @@ func TestMariaDBRepository_Create(t *testing.T) {
 
 		defer db.Close()
 
-		expected := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
-		input := locality.Locality{ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
+		expected := locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1}
+		input := locality.Locality{ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1}
 
-		mock.ExpectExec("INSERT INTO localities").WithArgs(input.ZipCode, input.LocalityName, input.ProvinceName, input.CountryName).
+		mock.ExpectExec("INSERT INTO localities").WithArgs(input.ZipCode, input.LocalityName, input.ProvinceID, input.Latitude, input.Longitude).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		localityRepo := locality.NewMariaDBRepository(db)
-		result, err := localityRepo.Create(context.Background(), input.ZipCode, input.LocalityName, input.ProvinceName, input.CountryName)
+		result, err := localityRepo.Create(context.Background(), input.ZipCode, input.LocalityName, input.ProvinceID, input.Latitude, input.Longitude)
 
 		assert.NoError(t, err)
 		assert.Equal(t, result, expected)
@@ -164,38 +216,52 @@ func TestMariaDBRepository_Create(t *testing.T) {
 
 		defer db.Close()
 
-		input := locality.Locality{ZipCode: "6700", LocalityName: "Gru", ProvinceName: "SP", CountryName: "BRA"}
+		input := locality.Locality{ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1}
 
-		mock.ExpectExec("INSERT INTO localities").WithArgs(input.ZipCode, input.LocalityName, input.ProvinceName, input.CountryName).
+		mock.ExpectExec("INSERT INTO localities").WithArgs(input.ZipCode, input.LocalityName, input.ProvinceID, input.Latitude, input.Longitude).
 			WillReturnError(fmt.Errorf("error"))
 
 		localityRepo := locality.NewMariaDBRepository(db)
-		_, err = localityRepo.Create(context.Background(), input.ZipCode, input.LocalityName, input.ProvinceName, input.CountryName)
+		_, err = localityRepo.Create(context.Background(), input.ZipCode, input.LocalityName, input.ProvinceID, input.Latitude, input.Longitude)
 
 		assert.Error(t, err)
 	})
 }
-func TestRepository_ReportSellers(t *testing.T) {
-	t.Run("Deve retornar o report sellers com sucesso", func(t *testing.T) {
+func TestRepository_Report(t *testing.T) {
+	reportColumns := []string{"id", "locality_name", "sellers_count", "carriers_count", "warehouses_count", "buyers_count"}
+
+	t.Run("Deve retornar o report da locality com sucesso", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		assert.NoError(t, err)
 
 		defer db.Close()
 
-		mockReportSellers := locality.ReportSeller{LocalityID: 1, LocalityName: "São Paulo", SellersCount: 2}
+		expected := locality.Report{LocalityID: 1, LocalityName: "São Paulo", SellersCount: 2, CarriersCount: 1, WarehousesCount: 3, BuyersCount: 5}
 
-		rows := sqlmock.NewRows([]string{
-			"locality_id", "locality_name", "sellers_count",
-		}).AddRow(mockReportSellers.LocalityID, mockReportSellers.LocalityName, mockReportSellers.SellersCount)
+		rows := sqlmock.NewRows(reportColumns).
+			AddRow(expected.LocalityID, expected.LocalityName, expected.SellersCount, expected.CarriersCount, expected.WarehousesCount, expected.BuyersCount)
 
-		mock.ExpectQuery(regexp.QuoteMeta(locality.GET_REPORT_SELLER)).
-			WithArgs(1).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(locality.GET_REPORT)).WithArgs(1).WillReturnRows(rows)
 
 		localityRepo := locality.NewMariaDBRepository(db)
-		result, err := localityRepo.ReportSellers(context.Background(), 1)
+		result, err := localityRepo.Report(context.Background(), 1)
 
 		assert.NoError(t, err)
-		assert.Equal(t, result, mockReportSellers)
+		assert.Equal(t, result, expected)
+	})
+
+	t.Run("Deve retornar erro quando a locality não existir", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(locality.GET_REPORT)).WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		_, err = localityRepo.Report(context.Background(), 2)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
 	})
 
 	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
@@ -204,13 +270,146 @@ func TestRepository_ReportSellers(t *testing.T) {
 
 		defer db.Close()
 
-		mock.ExpectQuery(regexp.QuoteMeta(locality.GET_REPORT_SELLER)).
-			WithArgs(1).WillReturnError(fmt.Errorf("error"))
+		mock.ExpectQuery(regexp.QuoteMeta(locality.GET_REPORT)).WithArgs(1).WillReturnError(fmt.Errorf("error"))
 
 		localityRepo := locality.NewMariaDBRepository(db)
-		_, err = localityRepo.ReportSellers(context.Background(), 1)
+		_, err = localityRepo.Report(context.Background(), 1)
+
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestRepository_ReportAll(t *testing.T) {
+	reportColumns := []string{"id", "locality_name", "sellers_count", "carriers_count", "warehouses_count", "buyers_count"}
+
+	t.Run("Deve retornar o report de todas as localities com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		expected := []locality.Report{
+			{LocalityID: 1, LocalityName: "São Paulo", SellersCount: 2, CarriersCount: 1, WarehousesCount: 3, BuyersCount: 5},
+			{LocalityID: 2, LocalityName: "Rio", SellersCount: 0, CarriersCount: 0, WarehousesCount: 0, BuyersCount: 0},
+		}
+
+		rows := sqlmock.NewRows(reportColumns).
+			AddRow(1, "São Paulo", 2, 1, 3, 5).
+			AddRow(2, "Rio", 0, 0, 0, 0)
+
+		mock.ExpectQuery(regexp.QuoteMeta(locality.GET_REPORT_ALL)).WillReturnRows(rows)
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		result, err := localityRepo.ReportAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, result, expected)
+	})
+
+	t.Run("Deve retornar erro no Scan", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		rows := sqlmock.NewRows(reportColumns).AddRow("", "", "", "", "", "")
+
+		mock.ExpectQuery(regexp.QuoteMeta(locality.GET_REPORT_ALL)).WillReturnRows(rows)
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		_, err = localityRepo.ReportAll(context.Background())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(locality.GET_REPORT_ALL)).WillReturnError(fmt.Errorf("error"))
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		_, err = localityRepo.ReportAll(context.Background())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	t.Run("Deve atualizar a locality com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(locality.UPDATE)).WithArgs("6700", "Guarulhos", 1, nil, nil, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		result, err := localityRepo.Update(context.Background(), 1, "6700", "Guarulhos", 1, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, result, locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Guarulhos", ProvinceID: 1})
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(locality.UPDATE)).WithArgs("6700", "Guarulhos", 1, nil, nil, 1).
+			WillReturnError(fmt.Errorf("error"))
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		_, err = localityRepo.Update(context.Background(), 1, "6700", "Guarulhos", 1, nil, nil)
 
 		assert.Error(t, err)
+	})
+}
 
+func TestRepository_Delete(t *testing.T) {
+	t.Run("Deve remover a locality com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(locality.DELETE)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		err = localityRepo.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Deve retornar erro quando a locality não existir", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(locality.DELETE)).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		err = localityRepo.Delete(context.Background(), 2)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(locality.DELETE)).WithArgs(1).WillReturnError(fmt.Errorf("error"))
+
+		localityRepo := locality.NewMariaDBRepository(db)
+		err = localityRepo.Delete(context.Background(), 1)
+
+		assert.Error(t, err)
 	})
 }
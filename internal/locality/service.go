@@ -3,54 +3,159 @@ package locality
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/geo"
+)
+
+const (
+	ERR_LOCALITY_NOT_FOUND      = "id does not exists"
+	ERR_UNIQUE_ZIPCODE          = "zip_code already exists"
+	ERR_PROVINCE_NOT_IN_COUNTRY = "province does not belong to the country"
+	ERR_LOCALITY_IN_USE         = "locality has sellers, carriers or warehouses"
+	ERR_PROVINCE_NOT_RESOLVED   = "province_id is mandatory when the zip_code province is not registered"
+	ERR_INVALID_COORDINATES     = "latitude must be between -90 and 90 and longitude between -180 and 180, both informed together"
+	ERR_LOCALITY_NOT_LOCATED    = "locality has no latitude and longitude"
 )
 
 type Service interface {
 	GetAll(ctx context.Context) ([]Locality, error)
 	GetById(ctx context.Context, id int) (Locality, error)
-	ReportSellers(ctx context.Context, id int) (ReportSeller, error)
-	Create(ctx context.Context, zipCode, localityName, provinceName, countryName string) (Locality, error)
+	Lookup(ctx context.Context, zipCode string) (Locality, error)
+	Create(ctx context.Context, zipCode, localityName string, provinceID, countryID int, latitude, longitude *float64) (Locality, error)
+	Update(ctx context.Context, id int, zipCode, localityName string, provinceID, countryID int, latitude, longitude *float64) (Locality, error)
+	Delete(ctx context.Context, id int) error
+	Report(ctx context.Context, id int) (Report, error)
+	ReportAll(ctx context.Context) ([]Report, error)
 }
 
 type service struct {
-	repository Repository
+	repository      Repository
+	provinceService province.Service
+	zipCodeService  zipcode.Service
 }
 
-func NewService(r Repository) Service {
-	return &service{repository: r}
+func NewService(r Repository, provinceService province.Service, zipCodeService zipcode.Service) Service {
+	return &service{repository: r, provinceService: provinceService, zipCodeService: zipCodeService}
 }
 
-func (s service) ReportSellers(ctx context.Context, id int) (ReportSeller, error) {
-	var reportSeller ReportSeller
+func (s service) Report(ctx context.Context, id int) (Report, error) {
+	report, err := s.repository.Report(ctx, id)
 
-	locality, err := s.repository.GetById(ctx, id)
+	if err != nil {
+		return Report{}, err
+	}
+
+	return report, nil
+}
+
+func (s service) ReportAll(ctx context.Context) ([]Report, error) {
+	reportList, err := s.repository.ReportAll(ctx)
 
 	if err != nil {
-		return ReportSeller{}, err
+		return []Report{}, err
+	}
+
+	return reportList, nil
+}
+
+// Create fills the locality_name and the province from the zip code dataset
+// when they are not informed.
+func (s service) Create(ctx context.Context, zipCode, localityName string, provinceID, countryID int, latitude, longitude *float64) (Locality, error) {
+
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return Locality{}, err
 	}
 
-	reportSeller, err = s.repository.ReportSellers(ctx, locality.Id)
+	zipCode = zipcode.Normalize(zipCode)
+
+	err := s.zipCodeExists(ctx, 0, zipCode)
+
+	if err != nil {
+		return Locality{}, err
+	}
+
+	if localityName == "" || provinceID == 0 {
+		localityName, provinceID, err = s.fillFromZipCode(ctx, zipCode, localityName, provinceID, countryID)
+
+		if err != nil {
+			return Locality{}, err
+		}
+	}
+
+	p, err := s.validateProvince(ctx, provinceID, countryID)
+
 	if err != nil {
-		return ReportSeller{}, err
+		return Locality{}, err
 	}
 
-	return reportSeller, nil
+	if err = zipcode.ValidateFormat(p.CountryName, zipCode); err != nil {
+		return Locality{}, err
+	}
+
+	newLocality, err := s.repository.Create(ctx, zipCode, localityName, p.Id, latitude, longitude)
+
+	if err != nil {
+		return Locality{}, err
+	}
+
+	return withProvince(newLocality, p), nil
 }
 
-func (s service) Create(ctx context.Context, zipCode, localityName, provinceName, countryName string) (Locality, error) {
+func (s service) Update(ctx context.Context, id int, zipCode, localityName string, provinceID, countryID int, latitude, longitude *float64) (Locality, error) {
+
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return Locality{}, err
+	}
+
+	_, err := s.repository.GetById(ctx, id)
+
+	if err != nil {
+		return Locality{}, err
+	}
+
+	zipCode = zipcode.Normalize(zipCode)
 
-	err := s.zipCodeExists(ctx, zipCode)
+	err = s.zipCodeExists(ctx, id, zipCode)
 
 	if err != nil {
 		return Locality{}, err
 	}
 
-	newLocality, err := s.repository.Create(ctx, zipCode, localityName, provinceName, countryName)
+	p, err := s.validateProvince(ctx, provinceID, countryID)
+
+	if err != nil {
+		return Locality{}, err
+	}
+
+	if err = zipcode.ValidateFormat(p.CountryName, zipCode); err != nil {
+		return Locality{}, err
+	}
+
+	updatedLocality, err := s.repository.Update(ctx, id, zipCode, localityName, p.Id, latitude, longitude)
 
 	if err != nil {
 		return Locality{}, err
 	}
-	return newLocality, nil
+
+	return withProvince(updatedLocality, p), nil
+}
+
+func (s service) Delete(ctx context.Context, id int) error {
+
+	report, err := s.repository.Report(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if report.SellersCount+report.CarriersCount+report.WarehousesCount > 0 {
+		return fmt.Errorf(ERR_LOCALITY_IN_USE)
+	}
+
+	return s.repository.Delete(ctx, id)
 }
 
 func (s service) GetAll(ctx context.Context) ([]Locality, error) {
@@ -75,7 +180,77 @@ func (s service) GetById(ctx context.Context, id int) (Locality, error) {
 	return locality, nil
 }
 
-func (s service) zipCodeExists(ctx context.Context, zipCode string) error {
+func (s service) Lookup(ctx context.Context, zipCode string) (Locality, error) {
+
+	locality, err := s.repository.GetByZipCode(ctx, zipcode.Normalize(zipCode))
+
+	if err != nil {
+		return Locality{}, err
+	}
+
+	return locality, nil
+}
+
+func (s service) fillFromZipCode(ctx context.Context, zipCode, localityName string, provinceID, countryID int) (string, int, error) {
+
+	var provinces []province.Province
+	var countryName string
+	var err error
+
+	if provinceID == 0 {
+		provinces, err = s.provinceService.GetAll(ctx)
+
+		if err != nil {
+			return "", 0, err
+		}
+
+		for _, p := range provinces {
+			if countryID != 0 && p.CountryID == countryID {
+				countryName = p.CountryName
+				break
+			}
+		}
+	}
+
+	entry, err := s.zipCodeService.Lookup(ctx, zipCode, countryName)
+
+	if err != nil {
+		return "", 0, err
+	}
+
+	if localityName == "" {
+		localityName = entry.LocalityName
+	}
+
+	if provinceID != 0 {
+		return localityName, provinceID, nil
+	}
+
+	for _, p := range provinces {
+		if strings.EqualFold(p.ProvinceName, entry.ProvinceName) && strings.EqualFold(p.CountryName, entry.CountryName) {
+			return localityName, p.Id, nil
+		}
+	}
+
+	return "", 0, fmt.Errorf(ERR_PROVINCE_NOT_RESOLVED)
+}
+
+func (s service) validateProvince(ctx context.Context, provinceID, countryID int) (province.Province, error) {
+
+	p, err := s.provinceService.GetById(ctx, provinceID)
+
+	if err != nil {
+		return province.Province{}, err
+	}
+
+	if countryID != 0 && p.CountryID != countryID {
+		return province.Province{}, fmt.Errorf(ERR_PROVINCE_NOT_IN_COUNTRY)
+	}
+
+	return p, nil
+}
+
+func (s service) zipCodeExists(ctx context.Context, id int, zipCode string) error {
 
 	localities, err := s.GetAll(ctx)
 
@@ -84,9 +259,30 @@ func (s service) zipCodeExists(ctx context.Context, zipCode string) error {
 	}
 
 	for i := range localities {
-		if localities[i].ZipCode == zipCode {
-			return fmt.Errorf("zip_code already exists")
+		if localities[i].Id != id && localities[i].ZipCode == zipCode {
+			return fmt.Errorf(ERR_UNIQUE_ZIPCODE)
 		}
 	}
 	return nil
 }
+
+func validateCoordinates(latitude, longitude *float64) error {
+
+	if latitude == nil && longitude == nil {
+		return nil
+	}
+
+	if latitude == nil || longitude == nil || !geo.ValidCoordinates(*latitude, *longitude) {
+		return fmt.Errorf(ERR_INVALID_COORDINATES)
+	}
+
+	return nil
+}
+
+func withProvince(locality Locality, p province.Province) Locality {
+	locality.ProvinceName = p.ProvinceName
+	locality.CountryID = p.CountryID
+	locality.CountryName = p.CountryName
+
+	return locality
+}
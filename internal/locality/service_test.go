@@ -4,73 +4,91 @@ import (
 	"fmt"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	provinceMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
+	zipCodeMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode/mocks"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 	"testing"
 )
 
-func TestService_ReportSellers(t *testing.T) {
-	t.Run("Deve retornar o report de sellers com sucesso", func(t *testing.T) {
+var noCoordinate *float64
+
+func TestService_Report(t *testing.T) {
+	t.Run("Deve retornar o report da locality com sucesso", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
 
-		localityOne := locality.Locality{1, "6700", "Gru", "SP", "BRA"}
-		reportSeller := locality.ReportSeller{1, "Gru", 3}
+		report := locality.Report{1, "Gru", 3, 2, 1, 4}
 
-		mockRepo.On("GetById", context.Background(), 1).Return(localityOne, nil)
-		mockRepo.On("ReportSellers", context.Background(), 1).Return(reportSeller, nil)
+		mockRepo.On("Report", context.Background(), 1).Return(report, nil)
 
-		service := locality.NewService(mockRepo)
-		result, err := service.ReportSellers(context.Background(), 1)
+		service := locality.NewService(mockRepo, nil, nil)
+		result, err := service.Report(context.Background(), 1)
 
 		assert.NoError(t, err)
-		assert.Equal(t, result, reportSeller)
+		assert.Equal(t, result, report)
 	})
 
-	t.Run("Deve retornar erro no report sellers report", func(t *testing.T) {
+	t.Run("Deve retornar erro quando a locality não existir", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
 
-		localityOne := locality.Locality{1, "6700", "Gru", "SP", "BRA"}
-
-		mockRepo.On("GetById", context.Background(), 1).Return(localityOne, nil)
-		mockRepo.On("ReportSellers", context.Background(), 1).
-			Return(locality.ReportSeller{}, fmt.Errorf("error"))
+		mockRepo.On("Report", context.Background(), 1).
+			Return(locality.Report{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
 
-		service := locality.NewService(mockRepo)
-		result, err := service.ReportSellers(context.Background(), 1)
+		service := locality.NewService(mockRepo, nil, nil)
+		result, err := service.Report(context.Background(), 1)
 
-		assert.Error(t, err)
-		assert.Equal(t, result, locality.ReportSeller{})
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+		assert.Equal(t, result, locality.Report{})
 	})
+}
 
-	t.Run("Deve retornar erro ao localizar a locality", func(t *testing.T) {
+func TestService_ReportAll(t *testing.T) {
+	t.Run("Deve retornar o report de todas as localities com sucesso", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
 
-		localityOne := locality.Locality{1, "6700", "Gru", "SP", "BRA"}
-		//reportSeller := locality.ReportSeller{1, "Gru", 3}
+		reportList := []locality.Report{{1, "Gru", 3, 2, 1, 4}, {2, "Rio", 0, 1, 0, 0}}
+
+		mockRepo.On("ReportAll", context.Background()).Return(reportList, nil)
+
+		service := locality.NewService(mockRepo, nil, nil)
+		result, err := service.ReportAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, result, reportList)
+	})
+
+	t.Run("Deve retornar erro ao consultar o report", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
 
-		mockRepo.On("GetById", context.Background(), 1).Return(localityOne, fmt.Errorf("error"))
+		mockRepo.On("ReportAll", context.Background()).Return(nil, fmt.Errorf("error"))
 
-		service := locality.NewService(mockRepo)
-		result, err := service.ReportSellers(context.Background(), 1)
+		service := locality.NewService(mockRepo, nil, nil)
+		result, err := service.ReportAll(context.Background())
 
 		assert.Error(t, err)
-		assert.Equal(t, result, locality.ReportSeller{})
+		assert.Equal(t, result, []locality.Report{})
 	})
 }
 
 func TestService_Create(t *testing.T) {
+	provinceOne := province.Province{Id: 1, ProvinceName: "SP", CountryID: 1, CountryName: "BRA"}
+
 	t.Run("Deve criar uma locality com sucesso", func(t *testing.T) {
 
 		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
 
-		expectedResult := locality.Locality{1, "6700", "Gru", "SP", "BRA"}
+		expectedResult := locality.Locality{1, "6700", "Gru", 1, "SP", 1, "BRA", nil, nil}
 
 		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
-		mockRepo.On("Create", context.Background(), expectedResult.ZipCode, expectedResult.LocalityName, expectedResult.ProvinceName, expectedResult.CountryName).
-			Return(expectedResult, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceOne, nil)
+		mockRepo.On("Create", context.Background(), expectedResult.ZipCode, expectedResult.LocalityName, expectedResult.ProvinceID, noCoordinate, noCoordinate).
+			Return(locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1}, nil)
 
-		service := locality.NewService(mockRepo)
-		result, err := service.Create(context.Background(), "6700", "Gru", "SP", "BRA")
+		service := locality.NewService(mockRepo, mockProvinceService, nil)
+		result, err := service.Create(context.Background(), "6700", "Gru", 1, 1, nil, nil)
 
 		assert.NoError(t, err)
 		assert.Equal(t, result, expectedResult)
@@ -81,14 +99,14 @@ func TestService_Create(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
 
 		localityList := []locality.Locality{
-			{1, "6700", "Gru", "SP", "BRA"},
-			{2, "9999", "Rio", "RJ", "BRA"},
+			{1, "6700", "Gru", 1, "SP", 1, "BRA", nil, nil},
+			{2, "9999", "Rio", 2, "RJ", 1, "BRA", nil, nil},
 		}
 
 		mockRepo.On("GetAll", context.Background()).Return(localityList, nil)
 
-		service := locality.NewService(mockRepo)
-		result, err := service.Create(context.Background(), "6700", "Gru", "SP", "BRA")
+		service := locality.NewService(mockRepo, nil, nil)
+		result, err := service.Create(context.Background(), "6700", "Gru", 1, 1, nil, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, result, locality.Locality{})
@@ -100,22 +118,354 @@ func TestService_Create(t *testing.T) {
 
 		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, fmt.Errorf("error"))
 
-		service := locality.NewService(mockRepo)
-		result, err := service.Create(context.Background(), "6700", "Gru", "SP", "BRA")
+		service := locality.NewService(mockRepo, nil, nil)
+		result, err := service.Create(context.Background(), "6700", "Gru", 1, 1, nil, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, result, locality.Locality{})
 	})
+
+	t.Run("Deve retornar erro quando a province não existir", func(t *testing.T) {
+
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+
+		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
+		mockProvinceService.On("GetById", context.Background(), 2).
+			Return(province.Province{}, fmt.Errorf(province.ERR_PROVINCE_NOT_FOUND))
+
+		service := locality.NewService(mockRepo, mockProvinceService, nil)
+		result, err := service.Create(context.Background(), "6700", "Gru", 2, 0, nil, nil)
+
+		assert.EqualError(t, err, province.ERR_PROVINCE_NOT_FOUND)
+		assert.Equal(t, result, locality.Locality{})
+	})
+
+	t.Run("Deve retornar erro quando a province não pertencer ao country", func(t *testing.T) {
+
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+
+		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceOne, nil)
+
+		service := locality.NewService(mockRepo, mockProvinceService, nil)
+		result, err := service.Create(context.Background(), "6700", "Gru", 1, 2, nil, nil)
+
+		assert.EqualError(t, err, locality.ERR_PROVINCE_NOT_IN_COUNTRY)
+		assert.Equal(t, result, locality.Locality{})
+	})
+
+	t.Run("Deve retornar erro ao salvar a locality", func(t *testing.T) {
+
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+
+		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceOne, nil)
+		mockRepo.On("Create", context.Background(), "6700", "Gru", 1, noCoordinate, noCoordinate).Return(locality.Locality{}, fmt.Errorf("error"))
+
+		service := locality.NewService(mockRepo, mockProvinceService, nil)
+		result, err := service.Create(context.Background(), "6700", "Gru", 1, 0, nil, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, result, locality.Locality{})
+	})
+}
+
+func TestService_CreateWithCoordinates(t *testing.T) {
+	provinceOne := province.Province{Id: 1, ProvinceName: "SP", CountryID: 1, CountryName: "BRA"}
+	latitude, longitude := -23.4538, -46.5333
+
+	t.Run("Deve criar uma locality com latitude e longitude", func(t *testing.T) {
+
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+
+		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceOne, nil)
+		mockRepo.On("Create", context.Background(), "6700", "Gru", 1, &latitude, &longitude).
+			Return(locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Gru", ProvinceID: 1, Latitude: &latitude, Longitude: &longitude}, nil)
+
+		service := locality.NewService(mockRepo, mockProvinceService, nil)
+		result, err := service.Create(context.Background(), "6700", "Gru", 1, 0, &latitude, &longitude)
+
+		assert.NoError(t, err)
+		assert.Equal(t, locality.Locality{1, "6700", "Gru", 1, "SP", 1, "BRA", &latitude, &longitude}, result)
+	})
+
+	t.Run("Deve retornar erro quando somente a latitude for informada", func(t *testing.T) {
+
+		service := locality.NewService(mocks.NewRepository(t), nil, nil)
+		result, err := service.Create(context.Background(), "6700", "Gru", 1, 0, &latitude, nil)
+
+		assert.EqualError(t, err, locality.ERR_INVALID_COORDINATES)
+		assert.Equal(t, result, locality.Locality{})
+	})
+
+	t.Run("Deve retornar erro quando a latitude estiver fora do intervalo", func(t *testing.T) {
+		invalidLatitude := 123.0
+
+		service := locality.NewService(mocks.NewRepository(t), nil, nil)
+		result, err := service.Update(context.Background(), 1, "6700", "Gru", 1, 0, &invalidLatitude, &longitude)
+
+		assert.EqualError(t, err, locality.ERR_INVALID_COORDINATES)
+		assert.Equal(t, result, locality.Locality{})
+	})
+}
+
+func TestService_CreateFromZipCode(t *testing.T) {
+	provinceList := []province.Province{
+		{Id: 1, ProvinceName: "São Paulo", CountryID: 1, CountryName: "Brasil"},
+		{Id: 2, ProvinceName: "Córdoba", CountryID: 2, CountryName: "Argentina"},
+	}
+	entry := zipcode.ZipCode{ZipCode: "07010000", LocalityName: "Guarulhos", ProvinceName: "SÃO PAULO", CountryName: "Brasil"}
+
+	t.Run("Deve preencher o locality_name e a province a partir do zip code", func(t *testing.T) {
+
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+		mockZipCodeService := zipCodeMocks.NewService(t)
+
+		expectedResult := locality.Locality{1, "07010000", "Guarulhos", 1, "São Paulo", 1, "Brasil", nil, nil}
+
+		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
+		mockProvinceService.On("GetAll", context.Background()).Return(provinceList, nil)
+		mockZipCodeService.On("Lookup", context.Background(), "07010000", "Brasil").Return(entry, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceList[0], nil)
+		mockRepo.On("Create", context.Background(), "07010000", "Guarulhos", 1, noCoordinate, noCoordinate).
+			Return(locality.Locality{Id: 1, ZipCode: "07010000", LocalityName: "Guarulhos", ProvinceID: 1}, nil)
+
+		service := locality.NewService(mockRepo, mockProvinceService, mockZipCodeService)
+		result, err := service.Create(context.Background(), "07010-000", "", 0, 1, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, result, expectedResult)
+	})
+
+	t.Run("Deve preencher somente o locality_name quando a province for informada", func(t *testing.T) {
+
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+		mockZipCodeService := zipCodeMocks.NewService(t)
+
+		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
+		mockZipCodeService.On("Lookup", context.Background(), "07010000", "").Return(entry, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceList[0], nil)
+		mockRepo.On("Create", context.Background(), "07010000", "Guarulhos", 1, noCoordinate, noCoordinate).
+			Return(locality.Locality{Id: 1, ZipCode: "07010000", LocalityName: "Guarulhos", ProvinceID: 1}, nil)
+
+		service := locality.NewService(mockRepo, mockProvinceService, mockZipCodeService)
+		result, err := service.Create(context.Background(), "07010000", "", 1, 0, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Guarulhos", result.LocalityName)
+	})
+
+	t.Run("Deve retornar erro quando o zip code não estiver no dataset", func(t *testing.T) {
+
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+		mockZipCodeService := zipCodeMocks.NewService(t)
+
+		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
+		mockProvinceService.On("GetAll", context.Background()).Return(provinceList, nil)
+		mockZipCodeService.On("Lookup", context.Background(), "07010000", "").
+			Return(zipcode.ZipCode{}, fmt.Errorf(zipcode.ERR_ZIP_CODE_NOT_FOUND))
+
+		service := locality.NewService(mockRepo, mockProvinceService, mockZipCodeService)
+		result, err := service.Create(context.Background(), "07010000", "Guarulhos", 0, 0, nil, nil)
+
+		assert.EqualError(t, err, zipcode.ERR_ZIP_CODE_NOT_FOUND)
+		assert.Equal(t, result, locality.Locality{})
+	})
+
+	t.Run("Deve retornar erro quando a province do zip code não estiver cadastrada", func(t *testing.T) {
+
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+		mockZipCodeService := zipCodeMocks.NewService(t)
+
+		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
+		mockProvinceService.On("GetAll", context.Background()).Return(provinceList[1:], nil)
+		mockZipCodeService.On("Lookup", context.Background(), "07010000", "").Return(entry, nil)
+
+		service := locality.NewService(mockRepo, mockProvinceService, mockZipCodeService)
+		result, err := service.Create(context.Background(), "07010000", "", 0, 0, nil, nil)
+
+		assert.EqualError(t, err, locality.ERR_PROVINCE_NOT_RESOLVED)
+		assert.Equal(t, result, locality.Locality{})
+	})
+
+	t.Run("Deve retornar erro quando o zip code estiver fora do formato do country", func(t *testing.T) {
+
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+
+		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceList[0], nil)
+
+		service := locality.NewService(mockRepo, mockProvinceService, nil)
+		result, err := service.Create(context.Background(), "6700", "Gru", 1, 0, nil, nil)
+
+		assert.EqualError(t, err, zipcode.ERR_INVALID_ZIP_CODE)
+		assert.Equal(t, result, locality.Locality{})
+	})
+}
+
+func TestService_Lookup(t *testing.T) {
+	t.Run("Deve retornar a locality do zip code com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+
+		localityOne := locality.Locality{1, "07010000", "Guarulhos", 1, "São Paulo", 1, "Brasil", nil, nil}
+
+		mockRepo.On("GetByZipCode", context.Background(), "07010000").Return(localityOne, nil)
+
+		service := locality.NewService(mockRepo, nil, nil)
+		result, err := service.Lookup(context.Background(), "07010-000")
+
+		assert.NoError(t, err)
+		assert.Equal(t, result, localityOne)
+	})
+
+	t.Run("Deve retornar erro quando o zip code não estiver cadastrado", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+
+		mockRepo.On("GetByZipCode", context.Background(), "07010000").
+			Return(locality.Locality{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		service := locality.NewService(mockRepo, nil, nil)
+		result, err := service.Lookup(context.Background(), "07010000")
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+		assert.Equal(t, result, locality.Locality{})
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	provinceOne := province.Province{Id: 1, ProvinceName: "SP", CountryID: 1, CountryName: "BRA"}
+	localityList := []locality.Locality{
+		{1, "6700", "Gru", 1, "SP", 1, "BRA", nil, nil},
+		{2, "9999", "Rio", 2, "RJ", 1, "BRA", nil, nil},
+	}
+
+	t.Run("Deve atualizar uma locality com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+
+		mockRepo.On("GetById", context.Background(), 1).Return(localityList[0], nil)
+		mockRepo.On("GetAll", context.Background()).Return(localityList, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceOne, nil)
+		mockRepo.On("Update", context.Background(), 1, "6700", "Guarulhos", 1, noCoordinate, noCoordinate).
+			Return(locality.Locality{Id: 1, ZipCode: "6700", LocalityName: "Guarulhos", ProvinceID: 1}, nil)
+
+		service := locality.NewService(mockRepo, mockProvinceService, nil)
+		result, err := service.Update(context.Background(), 1, "6700", "Guarulhos", 1, 0, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, result, locality.Locality{1, "6700", "Guarulhos", 1, "SP", 1, "BRA", nil, nil})
+	})
+
+	t.Run("Deve retornar erro quando a locality não existir", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+
+		mockRepo.On("GetById", context.Background(), 3).
+			Return(locality.Locality{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		service := locality.NewService(mockRepo, nil, nil)
+		_, err := service.Update(context.Background(), 3, "6700", "Gru", 1, 0, nil, nil)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro quando o zipcode pertencer a outra locality", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+
+		mockRepo.On("GetById", context.Background(), 1).Return(localityList[0], nil)
+		mockRepo.On("GetAll", context.Background()).Return(localityList, nil)
+
+		service := locality.NewService(mockRepo, nil, nil)
+		_, err := service.Update(context.Background(), 1, "9999", "Gru", 1, 0, nil, nil)
+
+		assert.EqualError(t, err, locality.ERR_UNIQUE_ZIPCODE)
+	})
+
+	t.Run("Deve retornar erro quando a province não pertencer ao country", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+
+		mockRepo.On("GetById", context.Background(), 1).Return(localityList[0], nil)
+		mockRepo.On("GetAll", context.Background()).Return(localityList, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceOne, nil)
+
+		service := locality.NewService(mockRepo, mockProvinceService, nil)
+		_, err := service.Update(context.Background(), 1, "6700", "Gru", 1, 2, nil, nil)
+
+		assert.EqualError(t, err, locality.ERR_PROVINCE_NOT_IN_COUNTRY)
+	})
+
+	t.Run("Deve retornar erro ao atualizar a locality", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockProvinceService := provinceMocks.NewService(t)
+
+		mockRepo.On("GetById", context.Background(), 1).Return(localityList[0], nil)
+		mockRepo.On("GetAll", context.Background()).Return(localityList, nil)
+		mockProvinceService.On("GetById", context.Background(), 1).Return(provinceOne, nil)
+		mockRepo.On("Update", context.Background(), 1, "6700", "Gru", 1, noCoordinate, noCoordinate).Return(locality.Locality{}, fmt.Errorf("error"))
+
+		service := locality.NewService(mockRepo, mockProvinceService, nil)
+		_, err := service.Update(context.Background(), 1, "6700", "Gru", 1, 0, nil, nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Run("Deve remover uma locality com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+
+		mockRepo.On("Report", context.Background(), 1).Return(locality.Report{LocalityID: 1, LocalityName: "Gru"}, nil)
+		mockRepo.On("Delete", context.Background(), 1).Return(nil)
+
+		service := locality.NewService(mockRepo, nil, nil)
+		err := service.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Deve retornar erro quando a locality estiver em uso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+
+		mockRepo.On("Report", context.Background(), 1).
+			Return(locality.Report{LocalityID: 1, LocalityName: "Gru", WarehousesCount: 1}, nil)
+
+		service := locality.NewService(mockRepo, nil, nil)
+		err := service.Delete(context.Background(), 1)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_IN_USE)
+	})
+
+	t.Run("Deve retornar erro quando a locality não existir", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+
+		mockRepo.On("Report", context.Background(), 3).
+			Return(locality.Report{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		service := locality.NewService(mockRepo, nil, nil)
+		err := service.Delete(context.Background(), 3)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+	})
 }
 
 func TestService_GetById(t *testing.T) {
 	t.Run("Deve retornar uma locality com sucesso", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		localityOne := locality.Locality{1, "6700", "Gru", "SP", "BRA"}
+		localityOne := locality.Locality{1, "6700", "Gru", 1, "SP", 1, "BRA", nil, nil}
 
 		mockRepo.On("GetById", context.Background(), 1).Return(localityOne, nil)
 
-		service := locality.NewService(mockRepo)
+		service := locality.NewService(mockRepo, nil, nil)
 		result, err := service.GetById(context.Background(), 1)
 
 		assert.NoError(t, err)
@@ -128,7 +478,7 @@ func TestService_GetById(t *testing.T) {
 		mockRepo.On("GetById", context.Background(), 1).
 			Return(locality.Locality{}, fmt.Errorf("id does not exists"))
 
-		service := locality.NewService(mockRepo)
+		service := locality.NewService(mockRepo, nil, nil)
 		result, err := service.GetById(context.Background(), 1)
 
 		assert.Error(t, err)
@@ -141,13 +491,13 @@ func TestService_GetAll(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
 
 		expectedResult := []locality.Locality{
-			{1, "6700", "Gru", "SP", "BRA"},
-			{2, "9999", "Rio", "RJ", "BRA"},
+			{1, "6700", "Gru", 1, "SP", 1, "BRA", nil, nil},
+			{2, "9999", "Rio", 2, "RJ", 1, "BRA", nil, nil},
 		}
 
 		mockRepo.On("GetAll", context.Background()).Return(expectedResult, nil)
 
-		service := locality.NewService(mockRepo)
+		service := locality.NewService(mockRepo, nil, nil)
 		result, err := service.GetAll(context.Background())
 
 		assert.NoError(t, err)
@@ -159,7 +509,7 @@ func TestService_GetAll(t *testing.T) {
 
 		mockRepo.On("GetAll", context.Background()).Return([]locality.Locality{}, fmt.Errorf("error"))
 
-		service := locality.NewService(mockRepo)
+		service := locality.NewService(mockRepo, nil, nil)
 		result, err := service.GetAll(context.Background())
 
 		assert.Error(t, err)
@@ -53,7 +53,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_ok", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -86,7 +86,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_inexistent_seller", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -115,7 +115,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_inexistent_product_type", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -142,7 +142,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_conflict", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -173,7 +173,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_error", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -209,7 +209,7 @@ func TestGetAll(t *testing.T) {
 	t.Run("find_all", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -225,7 +225,7 @@ func TestGetById(t *testing.T) {
 	t.Run("find_by_id_existent", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -238,7 +238,7 @@ func TestGetById(t *testing.T) {
 	t.Run("find_by_id_non_existent", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -255,7 +255,7 @@ func TestUpdate(t *testing.T) {
 	t.Run("update_existent", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -288,7 +288,7 @@ func TestUpdate(t *testing.T) {
 	t.Run("update_inexistent_product_type", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -315,7 +315,7 @@ func TestUpdate(t *testing.T) {
 	t.Run("update_inexistent_seller", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -344,7 +344,7 @@ func TestUpdate(t *testing.T) {
 	t.Run("update_non_existent", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -378,7 +378,7 @@ func TestUpdate(t *testing.T) {
 	t.Run("update_conflict", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -412,7 +412,7 @@ func TestDelete(t *testing.T) {
 	t.Run("delete_ok", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
@@ -423,7 +423,7 @@ func TestDelete(t *testing.T) {
 	t.Run("delete_non_existent", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockRepository := mocks.NewRepository(t)
 		service := products.NewService(mockRepository, sellerService)
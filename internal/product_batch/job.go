@@ -0,0 +1,30 @@
+package productbatch
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunExpiryJob flags the expired batches every interval until the context
+// is done.
+func RunExpiryJob(ctx context.Context, s Services, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			flagged, err := s.FlagExpired(ctx)
+			if err != nil {
+				log.Printf("product batches: could not flag the expired batches: %v", err)
+				continue
+			}
+			if flagged > 0 {
+				log.Printf("product batches: flagged %d expired batches", flagged)
+			}
+		}
+	}
+}
@@ -14,6 +14,43 @@ type Repository struct {
 	mock.Mock
 }
 
+// AllocatableBatches provides a mock function with given fields: ctx, productID, purchaseOrderID, minDueDate
+func (_m *Repository) AllocatableBatches(ctx context.Context, productID int, purchaseOrderID int, minDueDate string) ([]productbatch.BatchStock, error) {
+	ret := _m.Called(ctx, productID, purchaseOrderID, minDueDate)
+
+	var r0 []productbatch.BatchStock
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) []productbatch.BatchStock); ok {
+		r0 = rf(ctx, productID, purchaseOrderID, minDueDate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.BatchStock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string) error); ok {
+		r1 = rf(ctx, productID, purchaseOrderID, minDueDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfirmPicks provides a mock function with given fields: ctx, productID, purchaseOrderID, minDueDate, picks
+func (_m *Repository) ConfirmPicks(ctx context.Context, productID int, purchaseOrderID int, minDueDate string, picks []productbatch.Pick) error {
+	ret := _m.Called(ctx, productID, purchaseOrderID, minDueDate, picks)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string, []productbatch.Pick) error); ok {
+		r0 = rf(ctx, productID, purchaseOrderID, minDueDate, picks)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields: ctx, pb
 func (_m *Repository) Create(ctx context.Context, pb productbatch.ProductBatch) (productbatch.ProductBatch, error) {
 	ret := _m.Called(ctx, pb)
@@ -35,6 +72,87 @@ func (_m *Repository) Create(ctx context.Context, pb productbatch.ProductBatch)
 	return r0, r1
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Repository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Expiring provides a mock function with given fields: ctx, today, until, filter
+func (_m *Repository) Expiring(ctx context.Context, today string, until string, filter productbatch.ExpiringFilter) ([]productbatch.ExpiringBatch, error) {
+	ret := _m.Called(ctx, today, until, filter)
+
+	var r0 []productbatch.ExpiringBatch
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, productbatch.ExpiringFilter) []productbatch.ExpiringBatch); ok {
+		r0 = rf(ctx, today, until, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.ExpiringBatch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, productbatch.ExpiringFilter) error); ok {
+		r1 = rf(ctx, today, until, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FlagExpired provides a mock function with given fields: ctx, today
+func (_m *Repository) FlagExpired(ctx context.Context, today string) (int, error) {
+	ret := _m.Called(ctx, today)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, today)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, today)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *Repository) GetAll(ctx context.Context, filter productbatch.BatchFilter) ([]productbatch.ProductBatch, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []productbatch.ProductBatch
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.BatchFilter) []productbatch.ProductBatch); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.ProductBatch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.BatchFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByBatchNum provides a mock function with given fields: ctx, bn
 func (_m *Repository) GetByBatchNum(ctx context.Context, bn int) (productbatch.ProductBatch, error) {
 	ret := _m.Called(ctx, bn)
@@ -56,13 +174,120 @@ func (_m *Repository) GetByBatchNum(ctx context.Context, bn int) (productbatch.P
 	return r0, r1
 }
 
-// Report provides a mock function with given fields: ctx
-func (_m *Repository) Report(ctx context.Context) ([]productbatch.Report, error) {
-	ret := _m.Called(ctx)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Repository) GetByID(ctx context.Context, id int) (productbatch.ProductBatch, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 productbatch.ProductBatch
+	if rf, ok := ret.Get(0).(func(context.Context, int) productbatch.ProductBatch); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(productbatch.ProductBatch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShelfLife provides a mock function with given fields: ctx, buyerID
+func (_m *Repository) GetShelfLife(ctx context.Context, buyerID int) (productbatch.ShelfLife, error) {
+	ret := _m.Called(ctx, buyerID)
+
+	var r0 productbatch.ShelfLife
+	if rf, ok := ret.Get(0).(func(context.Context, int) productbatch.ShelfLife); ok {
+		r0 = rf(ctx, buyerID)
+	} else {
+		r0 = ret.Get(0).(productbatch.ShelfLife)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, buyerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageProduct provides a mock function with given fields: ctx, productID
+func (_m *Repository) GetStorageProduct(ctx context.Context, productID int) (productbatch.StorageProduct, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 productbatch.StorageProduct
+	if rf, ok := ret.Get(0).(func(context.Context, int) productbatch.StorageProduct); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		r0 = ret.Get(0).(productbatch.StorageProduct)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageSection provides a mock function with given fields: ctx, sectionID
+func (_m *Repository) GetStorageSection(ctx context.Context, sectionID int) (productbatch.StorageSection, error) {
+	ret := _m.Called(ctx, sectionID)
+
+	var r0 productbatch.StorageSection
+	if rf, ok := ret.Get(0).(func(context.Context, int) productbatch.StorageSection); ok {
+		r0 = rf(ctx, sectionID)
+	} else {
+		r0 = ret.Get(0).(productbatch.StorageSection)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, sectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductSections provides a mock function with given fields: ctx, productID
+func (_m *Repository) ProductSections(ctx context.Context, productID int) ([]int, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []int
+	if rf, ok := ret.Get(0).(func(context.Context, int) []int); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Report provides a mock function with given fields: ctx, filter
+func (_m *Repository) Report(ctx context.Context, filter productbatch.ReportFilter) ([]productbatch.Report, error) {
+	ret := _m.Called(ctx, filter)
 
 	var r0 []productbatch.Report
-	if rf, ok := ret.Get(0).(func(context.Context) []productbatch.Report); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.ReportFilter) []productbatch.Report); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]productbatch.Report)
@@ -70,8 +295,8 @@ func (_m *Repository) Report(ctx context.Context) ([]productbatch.Report, error)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.ReportFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,6 +325,138 @@ func (_m *Repository) ReportByID(ctx context.Context, id int) (productbatch.Repo
 	return r0, r1
 }
 
+// SetShelfLife provides a mock function with given fields: ctx, shelfLife
+func (_m *Repository) SetShelfLife(ctx context.Context, shelfLife productbatch.ShelfLife) (productbatch.ShelfLife, error) {
+	ret := _m.Called(ctx, shelfLife)
+
+	var r0 productbatch.ShelfLife
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.ShelfLife) productbatch.ShelfLife); ok {
+		r0 = rf(ctx, shelfLife)
+	} else {
+		r0 = ret.Get(0).(productbatch.ShelfLife)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.ShelfLife) error); ok {
+		r1 = rf(ctx, shelfLife)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageSections provides a mock function with given fields: ctx
+func (_m *Repository) StorageSections(ctx context.Context) ([]productbatch.StorageSection, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []productbatch.StorageSection
+	if rf, ok := ret.Get(0).(func(context.Context) []productbatch.StorageSection); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.StorageSection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, changes
+func (_m *Repository) Update(ctx context.Context, id int, changes productbatch.BatchUpdate) (productbatch.ProductBatch, error) {
+	ret := _m.Called(ctx, id, changes)
+
+	var r0 productbatch.ProductBatch
+	if rf, ok := ret.Get(0).(func(context.Context, int, productbatch.BatchUpdate) productbatch.ProductBatch); ok {
+		r0 = rf(ctx, id, changes)
+	} else {
+		r0 = ret.Get(0).(productbatch.ProductBatch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, productbatch.BatchUpdate) error); ok {
+		r1 = rf(ctx, id, changes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WarehouseExists provides a mock function with given fields: ctx, warehouseID
+func (_m *Repository) WarehouseExists(ctx context.Context, warehouseID int) (bool, error) {
+	ret := _m.Called(ctx, warehouseID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = rf(ctx, warehouseID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, warehouseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WarehouseSections provides a mock function with given fields: ctx, warehouseID
+func (_m *Repository) WarehouseSections(ctx context.Context, warehouseID int) ([]productbatch.StorageSection, error) {
+	ret := _m.Called(ctx, warehouseID)
+
+	var r0 []productbatch.StorageSection
+	if rf, ok := ret.Get(0).(func(context.Context, int) []productbatch.StorageSection); ok {
+		r0 = rf(ctx, warehouseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.StorageSection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, warehouseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WarehouseStock provides a mock function with given fields: ctx, warehouseID
+func (_m *Repository) WarehouseStock(ctx context.Context, warehouseID int) ([]productbatch.ProductStock, error) {
+	ret := _m.Called(ctx, warehouseID)
+
+	var r0 []productbatch.ProductStock
+	if rf, ok := ret.Get(0).(func(context.Context, int) []productbatch.ProductStock); ok {
+		r0 = rf(ctx, warehouseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.ProductStock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, warehouseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -14,6 +14,71 @@ type Services struct {
 	mock.Mock
 }
 
+// Allocate provides a mock function with given fields: ctx, a
+func (_m *Services) Allocate(ctx context.Context, a productbatch.Allocation) (productbatch.Allocation, error) {
+	ret := _m.Called(ctx, a)
+
+	var r0 productbatch.Allocation
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.Allocation) productbatch.Allocation); ok {
+		r0 = rf(ctx, a)
+	} else {
+		r0 = ret.Get(0).(productbatch.Allocation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.Allocation) error); ok {
+		r1 = rf(ctx, a)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompatibleSections provides a mock function with given fields: ctx, productID
+func (_m *Services) CompatibleSections(ctx context.Context, productID int) ([]productbatch.StorageSection, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []productbatch.StorageSection
+	if rf, ok := ret.Get(0).(func(context.Context, int) []productbatch.StorageSection); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.StorageSection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfirmPick provides a mock function with given fields: ctx, a
+func (_m *Services) ConfirmPick(ctx context.Context, a productbatch.Allocation) (productbatch.Allocation, error) {
+	ret := _m.Called(ctx, a)
+
+	var r0 productbatch.Allocation
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.Allocation) productbatch.Allocation); ok {
+		r0 = rf(ctx, a)
+	} else {
+		r0 = ret.Get(0).(productbatch.Allocation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.Allocation) error); ok {
+		r1 = rf(ctx, a)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: ctx, pb
 func (_m *Services) Create(ctx context.Context, pb productbatch.ProductBatch) (productbatch.ProductBatch, error) {
 	ret := _m.Called(ctx, pb)
@@ -35,13 +100,159 @@ func (_m *Services) Create(ctx context.Context, pb productbatch.ProductBatch) (p
 	return r0, r1
 }
 
-// Report provides a mock function with given fields: ctx
-func (_m *Services) Report(ctx context.Context) ([]productbatch.Report, error) {
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Services) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Expiring provides a mock function with given fields: ctx, filter
+func (_m *Services) Expiring(ctx context.Context, filter productbatch.ExpiringFilter) ([]productbatch.ExpiringBatch, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []productbatch.ExpiringBatch
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.ExpiringFilter) []productbatch.ExpiringBatch); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.ExpiringBatch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.ExpiringFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FlagExpired provides a mock function with given fields: ctx
+func (_m *Services) FlagExpired(ctx context.Context) (int, error) {
 	ret := _m.Called(ctx)
 
-	var r0 []productbatch.Report
-	if rf, ok := ret.Get(0).(func(context.Context) []productbatch.Report); ok {
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
 		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *Services) GetAll(ctx context.Context, filter productbatch.BatchFilter) ([]productbatch.ProductBatch, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []productbatch.ProductBatch
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.BatchFilter) []productbatch.ProductBatch); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.ProductBatch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.BatchFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Services) GetByID(ctx context.Context, id int) (productbatch.ProductBatch, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 productbatch.ProductBatch
+	if rf, ok := ret.Get(0).(func(context.Context, int) productbatch.ProductBatch); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(productbatch.ProductBatch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShelfLife provides a mock function with given fields: ctx, buyerID
+func (_m *Services) GetShelfLife(ctx context.Context, buyerID int) (productbatch.ShelfLife, error) {
+	ret := _m.Called(ctx, buyerID)
+
+	var r0 productbatch.ShelfLife
+	if rf, ok := ret.Get(0).(func(context.Context, int) productbatch.ShelfLife); ok {
+		r0 = rf(ctx, buyerID)
+	} else {
+		r0 = ret.Get(0).(productbatch.ShelfLife)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, buyerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Recommend provides a mock function with given fields: ctx, slotting
+func (_m *Services) Recommend(ctx context.Context, slotting productbatch.Slotting) ([]productbatch.Recommendation, error) {
+	ret := _m.Called(ctx, slotting)
+
+	var r0 []productbatch.Recommendation
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.Slotting) []productbatch.Recommendation); ok {
+		r0 = rf(ctx, slotting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]productbatch.Recommendation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.Slotting) error); ok {
+		r1 = rf(ctx, slotting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Report provides a mock function with given fields: ctx, filter
+func (_m *Services) Report(ctx context.Context, filter productbatch.ReportFilter) ([]productbatch.Report, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []productbatch.Report
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.ReportFilter) []productbatch.Report); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]productbatch.Report)
@@ -49,8 +260,8 @@ func (_m *Services) Report(ctx context.Context) ([]productbatch.Report, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.ReportFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -79,6 +290,48 @@ func (_m *Services) ReportByID(ctx context.Context, id int) (productbatch.Report
 	return r0, r1
 }
 
+// SetShelfLife provides a mock function with given fields: ctx, shelfLife
+func (_m *Services) SetShelfLife(ctx context.Context, shelfLife productbatch.ShelfLife) (productbatch.ShelfLife, error) {
+	ret := _m.Called(ctx, shelfLife)
+
+	var r0 productbatch.ShelfLife
+	if rf, ok := ret.Get(0).(func(context.Context, productbatch.ShelfLife) productbatch.ShelfLife); ok {
+		r0 = rf(ctx, shelfLife)
+	} else {
+		r0 = ret.Get(0).(productbatch.ShelfLife)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, productbatch.ShelfLife) error); ok {
+		r1 = rf(ctx, shelfLife)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, changes
+func (_m *Services) Update(ctx context.Context, id int, changes productbatch.BatchUpdate) (productbatch.ProductBatch, error) {
+	ret := _m.Called(ctx, id, changes)
+
+	var r0 productbatch.ProductBatch
+	if rf, ok := ret.Get(0).(func(context.Context, int, productbatch.BatchUpdate) productbatch.ProductBatch); ok {
+		r0 = rf(ctx, id, changes)
+	} else {
+		r0 = ret.Get(0).(productbatch.ProductBatch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, productbatch.BatchUpdate) error); ok {
+		r1 = rf(ctx, id, changes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewServices interface {
 	mock.TestingT
 	Cleanup(func())
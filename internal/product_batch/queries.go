@@ -1,11 +1,122 @@
 package productbatch
 
 const (
-	SqlReportBatchAll = "SELECT a.section_id, b.section_number, COUNT(*) FROM product_batches as a INNER JOIN section as b ON a.section_id = b.id GROUP BY section_id"
+	// Every section is reported, the ones without batches with zero counts.
+	SqlReportBatchAll = "SELECT b.id, b.section_number, COUNT(a.id), COALESCE(SUM(a.current_quantity), 0), COUNT(DISTINCT a.product_id), " +
+		"COALESCE(MIN(a.due_date), ''), COALESCE(b.maximum_capacity, 0) FROM section as b LEFT JOIN product_batches as a ON a.section_id = b.id"
 
-	SqlReportBatchByID = "SELECT a.section_id, b.section_number, COUNT(*) FROM product_batches as a INNER JOIN section as b ON a.section_id = b.id WHERE a.section_id = ? GROUP BY section_id"
+	SqlReportGroupBy = " GROUP BY b.id, b.section_number, b.maximum_capacity ORDER BY b.id"
+
+	SqlReportBatchByID = SqlReportBatchAll + " WHERE b.id = ?" + SqlReportGroupBy
 
 	SqlCreateBatch = "INSERT INTO product_batches (batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
 
 	SqlGetByBatchNum = "SELECT batch_number FROM product_batches WHERE batch_number = ?"
 )
+
+const (
+	// A batch can be allocated up to its current quantity minus the stock
+	// that is reserved for other purchase orders.
+	sqlAllocatable = `COALESCE(b.current_quantity, 0) - COALESCE((SELECT SUM(r.quantity) FROM stock_reservations r
+			WHERE r.product_batch_id = b.id AND r.status = 'active' AND r.purchase_order_id <> ?), 0)`
+
+	SqlAllocatableBatches = `SELECT b.id, b.batch_number, b.section_id, b.due_date,
+		` + sqlAllocatable + `
+		FROM product_batches b WHERE b.product_id = ? AND b.expired = 0 AND b.due_date >= ? ORDER BY b.due_date, b.id`
+
+	// The batch is locked until the pick is saved, and only found when it
+	// could have been allocated to the product for the buyer.
+	SqlLockPickBatch = `SELECT ` + sqlAllocatable + `
+		FROM product_batches b WHERE b.id = ? AND b.product_id = ? AND b.expired = 0 AND b.due_date >= ? FOR UPDATE`
+
+	SqlPickBatch = "UPDATE product_batches SET current_quantity = current_quantity - ? WHERE id = ?"
+
+	SqlPickedReservations = `SELECT id, quantity FROM stock_reservations
+		WHERE purchase_order_id = ? AND product_batch_id = ? AND status = 'active' ORDER BY id FOR UPDATE`
+
+	SqlConsumeReservation = "UPDATE stock_reservations SET status = 'consumed' WHERE id = ?"
+
+	// A reservation picked in part keeps the rest active; the picked part is
+	// saved as a consumed copy of it.
+	SqlConsumePartOfReservation = `INSERT INTO stock_reservations (purchase_order_id, product_batch_id, quantity, status, created_at, expires_at)
+		SELECT purchase_order_id, product_batch_id, ?, 'consumed', created_at, expires_at FROM stock_reservations WHERE id = ?`
+
+	SqlReduceReservation = "UPDATE stock_reservations SET quantity = quantity - ? WHERE id = ?"
+
+	SqlGetShelfLife = "SELECT minimum_days FROM buyer_shelf_life WHERE buyer_id = ?"
+
+	SqlSetShelfLife = "INSERT INTO buyer_shelf_life (buyer_id, minimum_days) VALUES (?, ?) ON DUPLICATE KEY UPDATE minimum_days = VALUES(minimum_days)"
+)
+
+const (
+	SqlExpiring = `SELECT b.id, b.batch_number, b.product_id, b.section_id, COALESCE(s.warehouse_id, 0), b.due_date,
+		b.current_quantity, b.expired, DATEDIFF(b.due_date, ?)
+		FROM product_batches b JOIN section s ON s.id = b.section_id`
+
+	SqlExpiringOrderBy = " ORDER BY b.due_date, b.id"
+
+	// Batches due today are still good; they expire from the start of the
+	// next day.
+	SqlFlagExpired = "UPDATE product_batches SET expired = 1 WHERE expired = 0 AND due_date < ?"
+
+	// The stock held in batches about to be flagged can't be picked anymore,
+	// so its reservations are released before the batches are flagged.
+	SqlReleaseExpiredReservations = `UPDATE stock_reservations r JOIN product_batches b ON b.id = r.product_batch_id
+		SET r.status = 'released' WHERE r.status = 'active' AND b.expired = 0 AND b.due_date < ?`
+)
+
+const (
+	SqlGetBatches = `SELECT id, COALESCE(batch_number, 0), COALESCE(current_quantity, 0), COALESCE(current_temperature, 0),
+		COALESCE(due_date, ''), COALESCE(initial_quantity, 0), COALESCE(manufacturing_date, ''), COALESCE(manufacturing_hour, 0),
+		COALESCE(minimum_temperature, 0), COALESCE(product_id, 0), COALESCE(section_id, 0)
+		FROM product_batches`
+
+	SqlGetBatchesOrderBy = " ORDER BY due_date, id"
+
+	SqlUpdateBatch = "UPDATE product_batches SET current_quantity = ?, current_temperature = ?, section_id = ? WHERE id = ?"
+
+	SqlLockBatch = SqlGetBatches + " WHERE id = ? FOR UPDATE"
+
+	// Reservations are created while the batch is locked, so the sum can't
+	// grow until the batch is saved.
+	SqlReservedStock = "SELECT COALESCE(SUM(quantity), 0) FROM stock_reservations WHERE product_batch_id = ? AND status = 'active'"
+
+	// Batches are only deleted when they are empty, even if they got stock
+	// after being read.
+	SqlDeleteBatch = "DELETE FROM product_batches WHERE id = ? AND current_quantity = 0"
+)
+
+const (
+	SqlLockSection = "SELECT current_capacity, maximum_capacity FROM section WHERE id = ? FOR UPDATE"
+
+	SqlOccupyCapacity = "UPDATE section SET current_capacity = current_capacity + ? WHERE id = ?"
+
+	// The capacity never goes below zero, even for sections whose capacity
+	// was set by hand before the batches updated it.
+	SqlFreeCapacity = "UPDATE section SET current_capacity = GREATEST(current_capacity - ?, 0) WHERE id = ?"
+
+	SqlFreePickedCapacity = "UPDATE section SET current_capacity = GREATEST(current_capacity - ?, 0) " +
+		"WHERE id = (SELECT section_id FROM product_batches WHERE id = ?)"
+)
+
+const (
+	SqlGetStorageProduct = "SELECT id, COALESCE(product_type_id, 0), recommended_freezing_temperature FROM products WHERE id = ?"
+
+	SqlGetStorageSections = `SELECT id, COALESCE(section_number, 0), COALESCE(warehouse_id, 0), COALESCE(product_type_id, 0),
+		COALESCE(minimum_temperature, 0), COALESCE(current_temperature, 0), COALESCE(current_capacity, 0),
+		COALESCE(maximum_capacity, 0)
+		FROM section`
+
+	SqlWarehouseExists = "SELECT COUNT(*) FROM warehouse WHERE id = ?"
+)
+
+const (
+	SqlWarehouseStock = `SELECT a.product_id, COUNT(*), COALESCE(SUM(a.current_quantity), 0),
+		COALESCE(SUM(a.initial_quantity), 0), COALESCE(SUM(a.expired), 0)
+		FROM product_batches a JOIN section b ON a.section_id = b.id
+		WHERE b.warehouse_id = ?
+		GROUP BY a.product_id ORDER BY a.product_id`
+
+	SqlProductSections = `SELECT DISTINCT section_id FROM product_batches
+		WHERE product_id = ? AND current_quantity > 0 ORDER BY section_id`
+)
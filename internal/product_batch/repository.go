@@ -3,7 +3,39 @@ package productbatch
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+)
+
+const (
+	ERR_INVALID_QUANTITY    = "the quantity must be greater than zero"
+	ERR_INVALID_SHELF_LIFE  = "the minimum shelf life can't be negative"
+	ERR_NOT_ENOUGH_STOCK    = "not enough stock with the shelf life required by the buyer"
+	ERR_NO_PICKS            = "the pick needs at least one batch"
+	ERR_PICK_EXCEEDS_STOCK  = "the pick exceeds the current quantity of batch %d"
+	ERR_PICK_NOT_ALLOWED    = "batch %d doesn't hold the product with the shelf life required by the buyer"
+	ERR_INVALID_DAYS        = "the days must be zero or more"
+	ERR_BATCH_NOT_FOUND     = "product batch with id (%d) not found"
+	ERR_BATCH_NOT_EMPTY     = "the product batch can only be deleted when its current quantity is zero"
+	ERR_INVALID_DATE        = "dates must be in the format YYYY-MM-DD"
+	ERR_SECTION_NOT_FOUND   = "section with id (%d) not found"
+	ERR_SECTION_FULL        = "the section doesn't have enough free capacity for the product batch"
+	ERR_PRODUCT_NOT_FOUND   = "product with id (%d) not found"
+	ERR_WAREHOUSE_NOT_FOUND = "warehouse with id (%d) not found"
+	ERR_INCOMPATIBLE        = "the product can't be stored in the section"
+	ERR_BELOW_RESERVED      = "the quantity can't be less than the stock reserved for purchase orders"
+
+	// EXPIRING_DAYS is the window of the expiring batches report when no
+	// days are given.
+	EXPIRING_DAYS = 7
+
+	// The weights of the temperature fit, the free capacity left and the
+	// proximity to batches of the same product in the score of a section
+	// recommended for an incoming batch.
+	WEIGHT_TEMPERATURE = 0.3
+	WEIGHT_CAPACITY    = 0.3
+	WEIGHT_PROXIMITY   = 0.4
 )
 
 type ProductBatch struct {
@@ -20,17 +52,171 @@ type ProductBatch struct {
 	SectionID       int    `json:"section_id" binding:"required"`
 }
 
+// Report is the inventory of a section: its batches, the quantity they hold
+// and how much of the maximum capacity of the section they use, in percent.
 type Report struct {
-	SecID     int `json:"section_id"`
-	SecNum    int `json:"section_number"`
-	ProdCount int `json:"products_count"`
+	SecID            int     `json:"section_id"`
+	SecNum           int     `json:"section_number"`
+	ProdCount        int     `json:"products_count"`
+	TotalQuantity    int     `json:"total_quantity"`
+	DistinctProducts int     `json:"distinct_products"`
+	EarliestDueDate  string  `json:"earliest_due_date"`
+	MaxCapacity      int     `json:"maximum_capacity"`
+	Utilization      float64 `json:"utilization_percentage"`
+}
+
+// ReportFilter narrows the inventory report to the sections of a warehouse
+// or product type. Zero values don't filter.
+type ReportFilter struct {
+	WarehouseID   int
+	ProductTypeID int
+}
+
+// BatchFilter narrows the product batches listing. Zero values don't
+// filter; the due dates are inclusive.
+type BatchFilter struct {
+	ProductID int
+	SectionID int
+	From      string
+	To        string
+}
+
+// BatchUpdate holds the changes to a product batch. Nil fields keep their
+// saved values.
+type BatchUpdate struct {
+	CurQuantity    *int
+	CurTemperature *int
+	SectionID      *int
+}
+
+// Pick is a quantity taken from a product batch, stored in a section.
+type Pick struct {
+	ProductBatchID int    `json:"product_batch_id" binding:"required"`
+	BatchNumber    int    `json:"batch_number"`
+	SectionID      int    `json:"section_id"`
+	DueDate        string `json:"due_date"`
+	Quantity       int    `json:"quantity" binding:"required,gt=0"`
+}
+
+// Allocation is the plan to pick a quantity of a product for a buyer,
+// taking first the batches that are due first.
+type Allocation struct {
+	ProductID       int    `json:"product_id"`
+	BuyerID         int    `json:"buyer_id"`
+	PurchaseOrderID int    `json:"purchase_order_id"`
+	Quantity        int    `json:"quantity"`
+	Picks           []Pick `json:"picks"`
+}
+
+// ShelfLife is how many days a product must still have before its due date
+// to be shipped to the buyer.
+type ShelfLife struct {
+	BuyerID     int `json:"buyer_id"`
+	MinimumDays int `json:"minimum_days"`
+}
+
+// BatchStock is the quantity of a batch that can still be allocated.
+type BatchStock struct {
+	ID          int
+	BatchNumber int
+	SectionID   int
+	DueDate     string
+	Available   int
+}
+
+// ExpiringBatch is a batch with stock left that is due within the window
+// of the report. Expired batches have negative days left.
+type ExpiringBatch struct {
+	ID          int    `json:"id"`
+	BatchNumber int    `json:"batch_number"`
+	ProductID   int    `json:"product_id"`
+	SectionID   int    `json:"section_id"`
+	WarehouseID int    `json:"warehouse_id"`
+	DueDate     string `json:"due_date"`
+	CurQuantity int    `json:"current_quantity"`
+	Expired     bool   `json:"expired"`
+	DaysLeft    int    `json:"days_left"`
+}
+
+// ExpiringFilter narrows the expiring batches report to the batches due in
+// the next days. Zero ids don't filter.
+type ExpiringFilter struct {
+	Days        int
+	WarehouseID int
+	SectionID   int
+}
+
+// StorageProduct is what a product needs from the section storing it.
+type StorageProduct struct {
+	ID                  int     `json:"id"`
+	ProductTypeID       int     `json:"product_type_id"`
+	FreezingTemperature float64 `json:"recommended_freezing_temperature"`
+}
+
+// StorageSection is what a section offers to the products stored in it.
+type StorageSection struct {
+	ID             int `json:"id"`
+	SectionNumber  int `json:"section_number"`
+	WarehouseID    int `json:"warehouse_id"`
+	ProductTypeID  int `json:"product_type_id"`
+	MinTemperature int `json:"minimum_temperature"`
+	CurTemperature int `json:"current_temperature"`
+	CurCapacity    int `json:"current_capacity"`
+	MaxCapacity    int `json:"maximum_capacity"`
+}
+
+// ProductStock is what the batches of a warehouse hold of a product.
+type ProductStock struct {
+	ProductID       int `json:"product_id"`
+	Batches         int `json:"batches"`
+	CurrentQuantity int `json:"current_quantity"`
+	InitialQuantity int `json:"initial_quantity"`
+	ExpiredBatches  int `json:"expired_batches"`
+}
+
+// Slotting is an incoming batch looking for a section of the warehouse.
+type Slotting struct {
+	ProductID   int `json:"product_id"`
+	Quantity    int `json:"quantity"`
+	WarehouseID int `json:"warehouse_id"`
+}
+
+// Recommendation is how well a section suits an incoming batch. The fits go
+// from 0 to 1 and the score from 0 to 100; sections that can't take the
+// batch score nothing and list the reasons why.
+type Recommendation struct {
+	Section        StorageSection `json:"section"`
+	FreeCapacity   int            `json:"free_capacity"`
+	Suitable       bool           `json:"suitable"`
+	Score          float64        `json:"score"`
+	TemperatureFit float64        `json:"temperature_fit"`
+	CapacityFit    float64        `json:"capacity_fit"`
+	Proximity      float64        `json:"proximity"`
+	Reasons        []string       `json:"reasons,omitempty"`
 }
 
 type Repository interface {
 	Create(ctx context.Context, pb ProductBatch) (ProductBatch, error)
-	Report(ctx context.Context) ([]Report, error)
+	Report(ctx context.Context, filter ReportFilter) ([]Report, error)
 	ReportByID(ctx context.Context, id int) (Report, error)
 	GetByBatchNum(ctx context.Context, bn int) (ProductBatch, error)
+	AllocatableBatches(ctx context.Context, productID, purchaseOrderID int, minDueDate string) ([]BatchStock, error)
+	ConfirmPicks(ctx context.Context, productID, purchaseOrderID int, minDueDate string, picks []Pick) error
+	GetShelfLife(ctx context.Context, buyerID int) (ShelfLife, error)
+	SetShelfLife(ctx context.Context, shelfLife ShelfLife) (ShelfLife, error)
+	Expiring(ctx context.Context, today, until string, filter ExpiringFilter) ([]ExpiringBatch, error)
+	FlagExpired(ctx context.Context, today string) (int, error)
+	GetByID(ctx context.Context, id int) (ProductBatch, error)
+	GetAll(ctx context.Context, filter BatchFilter) ([]ProductBatch, error)
+	Update(ctx context.Context, id int, changes BatchUpdate) (ProductBatch, error)
+	Delete(ctx context.Context, id int) error
+	GetStorageProduct(ctx context.Context, productID int) (StorageProduct, error)
+	GetStorageSection(ctx context.Context, sectionID int) (StorageSection, error)
+	StorageSections(ctx context.Context) ([]StorageSection, error)
+	WarehouseExists(ctx context.Context, warehouseID int) (bool, error)
+	WarehouseSections(ctx context.Context, warehouseID int) ([]StorageSection, error)
+	WarehouseStock(ctx context.Context, warehouseID int) ([]ProductStock, error)
+	ProductSections(ctx context.Context, productID int) ([]int, error)
 }
 
 type repository struct {
@@ -41,26 +227,84 @@ func NewRepository(db *sql.DB) Repository {
 	return &repository{db: db}
 }
 
+// Create stores the batch and takes its quantity from the free capacity of
+// its section in the same transaction.
 func (r repository) Create(ctx context.Context, pb ProductBatch) (ProductBatch, error) {
-	res, err := r.db.ExecContext(ctx, SqlCreateBatch, pb.BatchNumber, pb.CurQuantity, pb.CurTemperature, pb.DueDate,
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ProductBatch{}, err
+	}
+
+	err = occupyCapacity(ctx, tx, pb.SectionID, pb.CurQuantity)
+	if err != nil {
+		tx.Rollback()
+		return ProductBatch{}, err
+	}
+
+	res, err := tx.ExecContext(ctx, SqlCreateBatch, pb.BatchNumber, pb.CurQuantity, pb.CurTemperature, pb.DueDate,
 		pb.InitialQuantity, pb.ManufactDate, pb.ManufactHour, pb.MinTemperature, pb.ProductTypeID, pb.SectionID)
 	if err != nil {
+		tx.Rollback()
 		return ProductBatch{}, err
 	}
 
 	rowsAffected, _ := res.RowsAffected()
 	if rowsAffected <= 0 {
+		tx.Rollback()
 		return ProductBatch{}, fmt.Errorf("sql: rows not affected")
 	}
 
 	lastID, _ := res.LastInsertId()
 	pb.ID = int(lastID)
 
+	if err = tx.Commit(); err != nil {
+		return ProductBatch{}, err
+	}
+
 	return pb, nil
 }
 
-func (r repository) Report(ctx context.Context) ([]Report, error) {
-	rows, err := r.db.QueryContext(ctx, SqlReportBatchAll)
+// occupyCapacity adds the quantity to the current capacity of the section,
+// locking it first so concurrent placements can't exceed its maximum.
+func occupyCapacity(ctx context.Context, tx *sql.Tx, sectionID, quantity int) error {
+	var current, maximum int
+
+	err := tx.QueryRowContext(ctx, SqlLockSection, sectionID).Scan(&current, &maximum)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf(ERR_SECTION_NOT_FOUND, sectionID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if current+quantity > maximum {
+		return fmt.Errorf(ERR_SECTION_FULL)
+	}
+
+	_, err = tx.ExecContext(ctx, SqlOccupyCapacity, quantity, sectionID)
+	return err
+}
+
+func (r repository) Report(ctx context.Context, filter ReportFilter) ([]Report, error) {
+	var where []string
+	var args []interface{}
+
+	if filter.WarehouseID != 0 {
+		where = append(where, "b.warehouse_id = ?")
+		args = append(args, filter.WarehouseID)
+	}
+	if filter.ProductTypeID != 0 {
+		where = append(where, "b.product_type_id = ?")
+		args = append(args, filter.ProductTypeID)
+	}
+
+	query := SqlReportBatchAll
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += SqlReportGroupBy
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return []Report{}, err
 	}
@@ -69,9 +313,7 @@ func (r repository) Report(ctx context.Context) ([]Report, error) {
 
 	var rep []Report
 	for rows.Next() {
-		var row Report
-
-		err = rows.Scan(&row.SecID, &row.SecNum, &row.ProdCount)
+		row, err := scanReport(rows)
 		if err != nil {
 			return []Report{}, err
 		}
@@ -82,11 +324,15 @@ func (r repository) Report(ctx context.Context) ([]Report, error) {
 	return rep, nil
 }
 
+// ReportByID reports the batches of the section, which is only missing when
+// the section doesn't exist.
 func (r repository) ReportByID(ctx context.Context, id int) (Report, error) {
 	rows := r.db.QueryRowContext(ctx, SqlReportBatchByID, id)
 
-	var rep Report
-	err := rows.Scan(&rep.SecID, &rep.SecNum, &rep.ProdCount)
+	rep, err := scanReport(rows)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Report{}, fmt.Errorf(ERR_SECTION_NOT_FOUND, id)
+	}
 	if err != nil {
 		return Report{}, err
 	}
@@ -94,6 +340,15 @@ func (r repository) ReportByID(ctx context.Context, id int) (Report, error) {
 	return rep, nil
 }
 
+func scanReport(row scanner) (Report, error) {
+	var rep Report
+
+	err := row.Scan(&rep.SecID, &rep.SecNum, &rep.ProdCount, &rep.TotalQuantity, &rep.DistinctProducts,
+		&rep.EarliestDueDate, &rep.MaxCapacity)
+
+	return rep, err
+}
+
 func (r repository) GetByBatchNum(ctx context.Context, bn int) (ProductBatch, error) {
 	rows := r.db.QueryRowContext(ctx, SqlGetByBatchNum, bn)
 
@@ -105,3 +360,513 @@ func (r repository) GetByBatchNum(ctx context.Context, bn int) (ProductBatch, er
 
 	return pb, nil
 }
+
+// AllocatableBatches returns the batches of the product due from the given
+// date on, in the order they are due. The stock reserved for the purchase
+// order is left available to it.
+func (r repository) AllocatableBatches(ctx context.Context, productID, purchaseOrderID int,
+	minDueDate string) ([]BatchStock, error) {
+	rows, err := r.db.QueryContext(ctx, SqlAllocatableBatches, purchaseOrderID, productID, minDueDate)
+	if err != nil {
+		return []BatchStock{}, err
+	}
+
+	defer rows.Close()
+
+	var batches []BatchStock
+	for rows.Next() {
+		var batch BatchStock
+
+		err = rows.Scan(&batch.ID, &batch.BatchNumber, &batch.SectionID, &batch.DueDate, &batch.Available)
+		if err != nil {
+			return []BatchStock{}, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return batches, nil
+}
+
+// ConfirmPicks takes the picked quantities out of the batches and consumes
+// the reservations of the purchase order on them, if any, in the same
+// transaction. Like an allocation, every batch must hold the product, not be
+// due before minDueDate and have the quantity free of other orders'
+// reservations.
+func (r repository) ConfirmPicks(ctx context.Context, productID, purchaseOrderID int, minDueDate string, picks []Pick) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, pick := range picks {
+		var available int
+		err = tx.QueryRowContext(ctx, SqlLockPickBatch, purchaseOrderID, pick.ProductBatchID, productID, minDueDate).
+			Scan(&available)
+		if err == sql.ErrNoRows {
+			tx.Rollback()
+			return fmt.Errorf(ERR_PICK_NOT_ALLOWED, pick.ProductBatchID)
+		}
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if available < pick.Quantity {
+			tx.Rollback()
+			return fmt.Errorf(ERR_PICK_EXCEEDS_STOCK, pick.ProductBatchID)
+		}
+
+		_, err = tx.ExecContext(ctx, SqlPickBatch, pick.Quantity, pick.ProductBatchID)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, SqlFreePickedCapacity, pick.Quantity, pick.ProductBatchID)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if purchaseOrderID != 0 {
+			if err = consumeReservations(ctx, tx, purchaseOrderID, pick); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// consumeReservations consumes the reservations of the purchase order on the
+// batch of the pick, up to the picked quantity.
+func consumeReservations(ctx context.Context, tx *sql.Tx, purchaseOrderID int, pick Pick) error {
+	rows, err := tx.QueryContext(ctx, SqlPickedReservations, purchaseOrderID, pick.ProductBatchID)
+	if err != nil {
+		return err
+	}
+
+	type reserved struct{ id, quantity int }
+
+	reservations := []reserved{}
+	for rows.Next() {
+		var reservation reserved
+		if err = rows.Scan(&reservation.id, &reservation.quantity); err != nil {
+			rows.Close()
+			return err
+		}
+		reservations = append(reservations, reservation)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	remaining := pick.Quantity
+	for _, reservation := range reservations {
+		if remaining == 0 {
+			break
+		}
+
+		if reservation.quantity <= remaining {
+			_, err = tx.ExecContext(ctx, SqlConsumeReservation, reservation.id)
+			if err != nil {
+				return err
+			}
+			remaining -= reservation.quantity
+			continue
+		}
+
+		_, err = tx.ExecContext(ctx, SqlConsumePartOfReservation, remaining, reservation.id)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, SqlReduceReservation, remaining, reservation.id)
+		if err != nil {
+			return err
+		}
+		remaining = 0
+	}
+
+	return nil
+}
+
+// GetShelfLife returns the minimum shelf life of the buyer, zero days when
+// the buyer has none.
+func (r repository) GetShelfLife(ctx context.Context, buyerID int) (ShelfLife, error) {
+	shelfLife := ShelfLife{BuyerID: buyerID}
+
+	err := r.db.QueryRowContext(ctx, SqlGetShelfLife, buyerID).Scan(&shelfLife.MinimumDays)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return ShelfLife{}, err
+	}
+
+	return shelfLife, nil
+}
+
+func (r repository) SetShelfLife(ctx context.Context, shelfLife ShelfLife) (ShelfLife, error) {
+	_, err := r.db.ExecContext(ctx, SqlSetShelfLife, shelfLife.BuyerID, shelfLife.MinimumDays)
+	if err != nil {
+		return ShelfLife{}, err
+	}
+
+	return shelfLife, nil
+}
+
+// Expiring returns the batches with stock left that are due up to the given
+// date, counting the days left from today.
+func (r repository) Expiring(ctx context.Context, today, until string, filter ExpiringFilter) ([]ExpiringBatch, error) {
+	where := []string{"b.current_quantity > 0", "b.due_date <= ?"}
+	args := []interface{}{today, until}
+
+	if filter.WarehouseID != 0 {
+		where = append(where, "s.warehouse_id = ?")
+		args = append(args, filter.WarehouseID)
+	}
+	if filter.SectionID != 0 {
+		where = append(where, "b.section_id = ?")
+		args = append(args, filter.SectionID)
+	}
+
+	query := SqlExpiring + " WHERE " + strings.Join(where, " AND ") + SqlExpiringOrderBy
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []ExpiringBatch{}, err
+	}
+
+	defer rows.Close()
+
+	batches := []ExpiringBatch{}
+	for rows.Next() {
+		var row ExpiringBatch
+
+		err = rows.Scan(&row.ID, &row.BatchNumber, &row.ProductID, &row.SectionID, &row.WarehouseID, &row.DueDate,
+			&row.CurQuantity, &row.Expired, &row.DaysLeft)
+		if err != nil {
+			return []ExpiringBatch{}, err
+		}
+
+		batches = append(batches, row)
+	}
+
+	return batches, nil
+}
+
+// FlagExpired flags the batches due before now as expired, which keeps them
+// out of the allocations, and returns how many were flagged.
+// FlagExpired flags the batches due before today as expired and releases
+// the stock reserved in them in the same transaction.
+func (r repository) FlagExpired(ctx context.Context, today string) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = tx.ExecContext(ctx, SqlReleaseExpiredReservations, today); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	res, err := tx.ExecContext(ctx, SqlFlagExpired, today)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	return int(rowsAffected), nil
+}
+
+func (r repository) GetByID(ctx context.Context, id int) (ProductBatch, error) {
+	row := r.db.QueryRowContext(ctx, SqlGetBatches+" WHERE id = ?", id)
+
+	pb, err := scanBatch(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ProductBatch{}, fmt.Errorf(ERR_BATCH_NOT_FOUND, id)
+	}
+	if err != nil {
+		return ProductBatch{}, err
+	}
+
+	return pb, nil
+}
+
+func (r repository) GetAll(ctx context.Context, filter BatchFilter) ([]ProductBatch, error) {
+	var where []string
+	var args []interface{}
+
+	if filter.ProductID != 0 {
+		where = append(where, "product_id = ?")
+		args = append(args, filter.ProductID)
+	}
+	if filter.SectionID != 0 {
+		where = append(where, "section_id = ?")
+		args = append(args, filter.SectionID)
+	}
+	if filter.From != "" {
+		where = append(where, "due_date >= ?")
+		args = append(args, filter.From)
+	}
+	if filter.To != "" {
+		where = append(where, "due_date <= ?")
+		args = append(args, filter.To)
+	}
+
+	query := SqlGetBatches
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += SqlGetBatchesOrderBy
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []ProductBatch{}, err
+	}
+
+	defer rows.Close()
+
+	batches := []ProductBatch{}
+	for rows.Next() {
+		pb, err := scanBatch(rows)
+		if err != nil {
+			return []ProductBatch{}, err
+		}
+
+		batches = append(batches, pb)
+	}
+
+	return batches, nil
+}
+
+// Update applies the changes to the batch read under lock, so a quantity
+// left out of them keeps the one saved by picks in the meantime. A new
+// quantity can't go below the stock reserved in the batch. The capacity it
+// takes is moved between sections, or resized within its section, in the
+// same transaction.
+func (r repository) Update(ctx context.Context, id int, changes BatchUpdate) (ProductBatch, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ProductBatch{}, err
+	}
+
+	pb, err := scanBatch(tx.QueryRowContext(ctx, SqlLockBatch, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return ProductBatch{}, fmt.Errorf(ERR_BATCH_NOT_FOUND, id)
+	}
+	if err != nil {
+		tx.Rollback()
+		return ProductBatch{}, err
+	}
+
+	sectionID, quantity := pb.SectionID, pb.CurQuantity
+
+	if changes.CurQuantity != nil {
+		var reserved int
+		if err = tx.QueryRowContext(ctx, SqlReservedStock, id).Scan(&reserved); err != nil {
+			tx.Rollback()
+			return ProductBatch{}, err
+		}
+		if *changes.CurQuantity < reserved {
+			tx.Rollback()
+			return ProductBatch{}, fmt.Errorf(ERR_BELOW_RESERVED)
+		}
+		pb.CurQuantity = *changes.CurQuantity
+	}
+	if changes.CurTemperature != nil {
+		pb.CurTemperature = *changes.CurTemperature
+	}
+	if changes.SectionID != nil {
+		pb.SectionID = *changes.SectionID
+	}
+
+	switch {
+	case sectionID != pb.SectionID:
+		_, err = tx.ExecContext(ctx, SqlFreeCapacity, quantity, sectionID)
+		if err == nil {
+			err = occupyCapacity(ctx, tx, pb.SectionID, pb.CurQuantity)
+		}
+	case pb.CurQuantity > quantity:
+		err = occupyCapacity(ctx, tx, pb.SectionID, pb.CurQuantity-quantity)
+	case pb.CurQuantity < quantity:
+		_, err = tx.ExecContext(ctx, SqlFreeCapacity, quantity-pb.CurQuantity, sectionID)
+	}
+	if err != nil {
+		tx.Rollback()
+		return ProductBatch{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, SqlUpdateBatch, pb.CurQuantity, pb.CurTemperature, pb.SectionID, pb.ID)
+	if err != nil {
+		tx.Rollback()
+		return ProductBatch{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return ProductBatch{}, err
+	}
+
+	return pb, nil
+}
+
+func (r repository) Delete(ctx context.Context, id int) error {
+	res, err := r.db.ExecContext(ctx, SqlDeleteBatch, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected <= 0 {
+		return fmt.Errorf(ERR_BATCH_NOT_EMPTY)
+	}
+
+	return nil
+}
+
+func (r repository) GetStorageProduct(ctx context.Context, productID int) (StorageProduct, error) {
+	var product StorageProduct
+
+	err := r.db.QueryRowContext(ctx, SqlGetStorageProduct, productID).Scan(&product.ID, &product.ProductTypeID,
+		&product.FreezingTemperature)
+	if errors.Is(err, sql.ErrNoRows) {
+		return StorageProduct{}, fmt.Errorf(ERR_PRODUCT_NOT_FOUND, productID)
+	}
+	if err != nil {
+		return StorageProduct{}, err
+	}
+
+	return product, nil
+}
+
+func (r repository) GetStorageSection(ctx context.Context, sectionID int) (StorageSection, error) {
+	row := r.db.QueryRowContext(ctx, SqlGetStorageSections+" WHERE id = ?", sectionID)
+
+	section, err := scanStorageSection(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return StorageSection{}, fmt.Errorf(ERR_SECTION_NOT_FOUND, sectionID)
+	}
+	if err != nil {
+		return StorageSection{}, err
+	}
+
+	return section, nil
+}
+
+func (r repository) StorageSections(ctx context.Context) ([]StorageSection, error) {
+	return r.storageSections(ctx, SqlGetStorageSections+" ORDER BY id")
+}
+
+func (r repository) WarehouseExists(ctx context.Context, warehouseID int) (bool, error) {
+	var count int
+
+	err := r.db.QueryRowContext(ctx, SqlWarehouseExists, warehouseID).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// WarehouseSections lists the sections of the warehouse.
+func (r repository) WarehouseSections(ctx context.Context, warehouseID int) ([]StorageSection, error) {
+	return r.storageSections(ctx, SqlGetStorageSections+" WHERE warehouse_id = ? ORDER BY id", warehouseID)
+}
+
+func (r repository) storageSections(ctx context.Context, query string, args ...interface{}) ([]StorageSection, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []StorageSection{}, err
+	}
+
+	defer rows.Close()
+
+	sections := []StorageSection{}
+	for rows.Next() {
+		section, err := scanStorageSection(rows)
+		if err != nil {
+			return []StorageSection{}, err
+		}
+
+		sections = append(sections, section)
+	}
+
+	return sections, rows.Err()
+}
+
+func scanStorageSection(row scanner) (StorageSection, error) {
+	var section StorageSection
+
+	err := row.Scan(&section.ID, &section.SectionNumber, &section.WarehouseID, &section.ProductTypeID,
+		&section.MinTemperature, &section.CurTemperature, &section.CurCapacity, &section.MaxCapacity)
+
+	return section, err
+}
+
+// WarehouseStock sums the batches stored in the sections of the warehouse
+// by product.
+func (r repository) WarehouseStock(ctx context.Context, warehouseID int) ([]ProductStock, error) {
+	rows, err := r.db.QueryContext(ctx, SqlWarehouseStock, warehouseID)
+	if err != nil {
+		return []ProductStock{}, err
+	}
+
+	defer rows.Close()
+
+	stock := []ProductStock{}
+	for rows.Next() {
+		var product ProductStock
+
+		err := rows.Scan(&product.ProductID, &product.Batches, &product.CurrentQuantity,
+			&product.InitialQuantity, &product.ExpiredBatches)
+		if err != nil {
+			return []ProductStock{}, err
+		}
+
+		stock = append(stock, product)
+	}
+
+	return stock, rows.Err()
+}
+
+// ProductSections lists the sections holding stock of the product.
+func (r repository) ProductSections(ctx context.Context, productID int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, SqlProductSections, productID)
+	if err != nil {
+		return []int{}, err
+	}
+
+	defer rows.Close()
+
+	sections := []int{}
+	for rows.Next() {
+		var sectionID int
+		if err := rows.Scan(&sectionID); err != nil {
+			return []int{}, err
+		}
+
+		sections = append(sections, sectionID)
+	}
+
+	return sections, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanBatch(row scanner) (ProductBatch, error) {
+	var pb ProductBatch
+
+	err := row.Scan(&pb.ID, &pb.BatchNumber, &pb.CurQuantity, &pb.CurTemperature, &pb.DueDate, &pb.InitialQuantity,
+		&pb.ManufactDate, &pb.ManufactHour, &pb.MinTemperature, &pb.ProductTypeID, &pb.SectionID)
+
+	return pb, err
+}
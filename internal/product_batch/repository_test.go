@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -19,9 +20,9 @@ const (
 
 func CreateReportArray() []productbatch.Report {
 	var exp = []productbatch.Report{
-		{1, 22, 5},
-		{3, 483, 28},
-		{5, 7843, 90},
+		{1, 22, 5, 120, 3, "2022-04-04", 300, 0},
+		{3, 483, 28, 450, 12, "2022-05-10", 0, 0},
+		{5, 7843, 90, 1000, 40, "2022-06-01", 800, 0},
 	}
 	return exp
 }
@@ -29,15 +30,17 @@ func CreateReportArray() []productbatch.Report {
 func MockRowsArray(flag bool) *sqlmock.Rows {
 	exp := CreateReportArray()
 
-	rows := sqlmock.NewRows([]string{"section_id", "section_number", "products_count"})
+	rows := sqlmock.NewRows([]string{"section_id", "section_number", "products_count", "total_quantity",
+		"distinct_products", "earliest_due_date", "maximum_capacity"})
 
 	if !flag {
-		rows.AddRow("", "", "")
+		rows.AddRow("", "", "", "", "", "", "")
 		return rows
 	}
 
 	for i := range exp {
-		rows.AddRow(exp[i].SecID, exp[i].SecNum, exp[i].ProdCount)
+		rows.AddRow(exp[i].SecID, exp[i].SecNum, exp[i].ProdCount, exp[i].TotalQuantity, exp[i].DistinctProducts,
+			exp[i].EarliestDueDate, exp[i].MaxCapacity)
 	}
 
 	return rows
@@ -46,14 +49,16 @@ func MockRowsArray(flag bool) *sqlmock.Rows {
 func MockRow(flag bool) *sqlmock.Rows {
 	exp := CreateReportArray()[0]
 
-	rows := sqlmock.NewRows([]string{"section_id", "section_number", "products_count"})
+	rows := sqlmock.NewRows([]string{"section_id", "section_number", "products_count", "total_quantity",
+		"distinct_products", "earliest_due_date", "maximum_capacity"})
 
 	if !flag {
-		rows.AddRow("", "", "")
+		rows.AddRow("", "", "", "", "", "", "")
 		return rows
 	}
 
-	rows.AddRow(exp.SecID, exp.SecNum, exp.ProdCount)
+	rows.AddRow(exp.SecID, exp.SecNum, exp.ProdCount, exp.TotalQuantity, exp.DistinctProducts, exp.EarliestDueDate,
+		exp.MaxCapacity)
 
 	return rows
 }
@@ -66,27 +71,65 @@ func InitTestRepository(t *testing.T) (sqlmock.Sqlmock, productbatch.Repository)
 	return mock, mockRepository
 }
 
+func ExpectLockSection(mock sqlmock.Sqlmock, sectionID, current, maximum int) {
+	mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockSection)).WithArgs(sectionID).
+		WillReturnRows(sqlmock.NewRows([]string{"current_capacity", "maximum_capacity"}).AddRow(current, maximum))
+}
+
 func TestRepositoryCreate(t *testing.T) {
 	mock, mockRepository := InitTestRepository(t)
 
 	exp := productbatch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1}
 
 	t.Run("create_ok", func(t *testing.T) {
+		mock.ExpectBegin()
+		ExpectLockSection(mock, 1, 100, 300)
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlOccupyCapacity)).WithArgs(200, 1).WillReturnResult(sqlmock.NewResult(0, 1))
 		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlCreateBatch)).WithArgs(&exp.BatchNumber, &exp.CurQuantity,
 			&exp.CurTemperature, &exp.DueDate, &exp.InitialQuantity, &exp.ManufactDate, &exp.ManufactHour,
 			&exp.MinTemperature, &exp.ProductTypeID, &exp.SectionID).WillReturnResult(sqlmock.NewResult(15, 1))
+		mock.ExpectCommit()
 
 		pb, err := mockRepository.Create(context.TODO(), exp)
 
 		exp.ID = 15
 		assert.Equal(t, exp, pb)
 		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("create_fail_section_full", func(t *testing.T) {
+		mock.ExpectBegin()
+		ExpectLockSection(mock, 1, 150, 300)
+		mock.ExpectRollback()
+
+		pb, err := mockRepository.Create(context.TODO(), exp)
+
+		assert.Equal(t, productbatch.ProductBatch{}, pb)
+		assert.Equal(t, errors.New(productbatch.ERR_SECTION_FULL), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("create_fail_section_not_found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockSection)).WithArgs(1).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		pb, err := mockRepository.Create(context.TODO(), exp)
+
+		assert.Equal(t, productbatch.ProductBatch{}, pb)
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_SECTION_NOT_FOUND, 1), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("create_fail_exec", func(t *testing.T) {
+		mock.ExpectBegin()
+		ExpectLockSection(mock, 1, 0, 300)
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlOccupyCapacity)).WithArgs(200, 1).WillReturnResult(sqlmock.NewResult(0, 1))
 		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlCreateBatch)).WithArgs(&exp.BatchNumber, &exp.CurQuantity,
 			&exp.CurTemperature, &exp.DueDate, &exp.InitialQuantity, &exp.ManufactDate, &exp.ManufactHour,
 			&exp.MinTemperature, &exp.ProductTypeID, &exp.SectionID).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
 
 		pb, err := mockRepository.Create(context.TODO(), exp)
 
@@ -96,9 +139,13 @@ func TestRepositoryCreate(t *testing.T) {
 	})
 
 	t.Run("create_fail_zero_rows_affected", func(t *testing.T) {
+		mock.ExpectBegin()
+		ExpectLockSection(mock, 1, 0, 300)
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlOccupyCapacity)).WithArgs(200, 1).WillReturnResult(sqlmock.NewResult(0, 1))
 		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlCreateBatch)).WithArgs(&exp.BatchNumber, &exp.CurQuantity,
 			&exp.CurTemperature, &exp.DueDate, &exp.InitialQuantity, &exp.ManufactDate, &exp.ManufactHour,
 			&exp.MinTemperature, &exp.ProductTypeID, &exp.SectionID).WillReturnResult(sqlmock.NewResult(1, 0))
+		mock.ExpectRollback()
 
 		pb, err := mockRepository.Create(context.TODO(), exp)
 
@@ -116,7 +163,19 @@ func TestReport(t *testing.T) {
 	t.Run("report_ok", func(t *testing.T) {
 		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlReportBatchAll)).WillReturnRows(rows)
 
-		pb, err := mockRepository.Report(context.TODO())
+		pb, err := mockRepository.Report(context.TODO(), productbatch.ReportFilter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, exp, pb)
+	})
+
+	t.Run("report_filtered", func(t *testing.T) {
+		rows := MockRowsArray(WithValue)
+		query := productbatch.SqlReportBatchAll + " WHERE b.warehouse_id = ? AND b.product_type_id = ?" +
+			productbatch.SqlReportGroupBy
+		mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(2, 4).WillReturnRows(rows)
+
+		pb, err := mockRepository.Report(context.TODO(), productbatch.ReportFilter{WarehouseID: 2, ProductTypeID: 4})
 
 		assert.NoError(t, err)
 		assert.Equal(t, exp, pb)
@@ -125,7 +184,7 @@ func TestReport(t *testing.T) {
 	t.Run("report_fail_query", func(t *testing.T) {
 		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlReportBatchAll)).WillReturnError(sql.ErrNoRows)
 
-		pb, err := mockRepository.Report(context.TODO())
+		pb, err := mockRepository.Report(context.TODO(), productbatch.ReportFilter{})
 
 		assert.Equal(t, []productbatch.Report{}, pb)
 		assert.Error(t, err)
@@ -136,7 +195,7 @@ func TestReport(t *testing.T) {
 		rows := MockRowsArray(FailScan)
 		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlReportBatchAll)).WillReturnRows(rows)
 
-		pb, err := mockRepository.Report(context.TODO())
+		pb, err := mockRepository.Report(context.TODO(), productbatch.ReportFilter{})
 
 		assert.Equal(t, []productbatch.Report{}, pb)
 		assert.Error(t, err)
@@ -157,6 +216,25 @@ func TestReportByID(t *testing.T) {
 		assert.Equal(t, exp, pb)
 	})
 
+	t.Run("report_empty_section", func(t *testing.T) {
+		row := sqlmock.NewRows([]string{"section_id", "section_number", "products_count", "total_quantity",
+			"distinct_products", "earliest_due_date", "maximum_capacity"}).AddRow(3, 30, 0, 0, 0, "", 500)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlReportBatchByID)).WithArgs(3).WillReturnRows(row)
+
+		pb, err := mockRepository.ReportByID(context.TODO(), 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, productbatch.Report{SecID: 3, SecNum: 30, MaxCapacity: 500}, pb)
+	})
+
+	t.Run("report_section_not_found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlReportBatchByID)).WithArgs(99).WillReturnError(sql.ErrNoRows)
+
+		_, err := mockRepository.ReportByID(context.TODO(), 99)
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_SECTION_NOT_FOUND, 99), err)
+	})
+
 	t.Run("report_fail_scan", func(t *testing.T) {
 		row := MockRow(FailScan)
 		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlReportBatchAll)).WillReturnRows(row)
@@ -167,3 +245,569 @@ func TestReportByID(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestRepositoryAllocatableBatches(t *testing.T) {
+	mock, mockRepository := InitTestRepository(t)
+
+	t.Run("allocatable_batches_ok", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "batch_number", "section_id", "due_date", "available"}).
+			AddRow(2, 222, 3, "2022-09-01 00:00:00", 10).
+			AddRow(1, 111, 1, "2022-10-01 00:00:00", 4)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlAllocatableBatches)).WithArgs(7, 1, "2022-08-15").WillReturnRows(rows)
+
+		batches, err := mockRepository.AllocatableBatches(context.TODO(), 1, 7, "2022-08-15")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []productbatch.BatchStock{
+			{2, 222, 3, "2022-09-01 00:00:00", 10},
+			{1, 111, 1, "2022-10-01 00:00:00", 4},
+		}, batches)
+	})
+
+	t.Run("allocatable_batches_fail_query", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlAllocatableBatches)).WillReturnError(sql.ErrConnDone)
+
+		batches, err := mockRepository.AllocatableBatches(context.TODO(), 1, 0, "2022-08-15")
+
+		assert.Error(t, err)
+		assert.Equal(t, []productbatch.BatchStock{}, batches)
+	})
+}
+
+func TestRepositoryConfirmPicks(t *testing.T) {
+	picks := []productbatch.Pick{{ProductBatchID: 2, Quantity: 10}, {ProductBatchID: 1, Quantity: 2}}
+
+	t.Run("confirm_picks_ok", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockPickBatch)).WithArgs(7, 2, 1, "2022-08-15").
+			WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(10))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlPickBatch)).WithArgs(10, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlFreePickedCapacity)).WithArgs(10, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlPickedReservations)).WithArgs(7, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity"}).AddRow(4, 6).AddRow(5, 6))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlConsumeReservation)).WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlConsumePartOfReservation)).WithArgs(4, 5).WillReturnResult(sqlmock.NewResult(6, 1))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlReduceReservation)).WithArgs(4, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockPickBatch)).WithArgs(7, 1, 1, "2022-08-15").
+			WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(5))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlPickBatch)).WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlFreePickedCapacity)).WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlPickedReservations)).WithArgs(7, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "quantity"}))
+		mock.ExpectCommit()
+
+		err := mockRepository.ConfirmPicks(context.TODO(), 1, 7, "2022-08-15", picks)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("confirm_picks_exceeds_stock", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockPickBatch)).WithArgs(0, 2, 1, "2022-08-15").
+			WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(10))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlPickBatch)).WithArgs(10, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlFreePickedCapacity)).WithArgs(10, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockPickBatch)).WithArgs(0, 1, 1, "2022-08-15").
+			WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(1))
+		mock.ExpectRollback()
+
+		err := mockRepository.ConfirmPicks(context.TODO(), 1, 0, "2022-08-15", picks)
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_PICK_EXCEEDS_STOCK, 1), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("confirm_picks_not_allowed", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockPickBatch)).WithArgs(0, 2, 1, "2022-08-15").
+			WillReturnRows(sqlmock.NewRows([]string{"available"}))
+		mock.ExpectRollback()
+
+		err := mockRepository.ConfirmPicks(context.TODO(), 1, 0, "2022-08-15", picks)
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_PICK_NOT_ALLOWED, 2), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("confirm_picks_fail_exec", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockPickBatch)).
+			WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(10))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlPickBatch)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		err := mockRepository.ConfirmPicks(context.TODO(), 1, 0, "2022-08-15", picks)
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryShelfLife(t *testing.T) {
+	mock, mockRepository := InitTestRepository(t)
+
+	t.Run("get_shelf_life_ok", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetShelfLife)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"minimum_days"}).AddRow(15))
+
+		shelfLife, err := mockRepository.GetShelfLife(context.TODO(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, productbatch.ShelfLife{BuyerID: 1, MinimumDays: 15}, shelfLife)
+	})
+
+	t.Run("get_shelf_life_not_set", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetShelfLife)).WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+		shelfLife, err := mockRepository.GetShelfLife(context.TODO(), 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, productbatch.ShelfLife{BuyerID: 2}, shelfLife)
+	})
+
+	t.Run("get_shelf_life_fail", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetShelfLife)).WithArgs(3).WillReturnError(sql.ErrConnDone)
+
+		_, err := mockRepository.GetShelfLife(context.TODO(), 3)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("set_shelf_life_ok", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlSetShelfLife)).WithArgs(1, 20).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		shelfLife, err := mockRepository.SetShelfLife(context.TODO(), productbatch.ShelfLife{BuyerID: 1, MinimumDays: 20})
+
+		assert.NoError(t, err)
+		assert.Equal(t, productbatch.ShelfLife{BuyerID: 1, MinimumDays: 20}, shelfLife)
+	})
+}
+
+func TestRepositoryExpiring(t *testing.T) {
+	mock, mockRepository := InitTestRepository(t)
+	columns := []string{"id", "batch_number", "product_id", "section_id", "warehouse_id", "due_date", "current_quantity",
+		"expired", "days_left"}
+
+	t.Run("expiring_ok", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(2, 222, 1, 3, 1, "2022-08-01 00:00:00", 10, true, -2).
+			AddRow(1, 111, 1, 3, 1, "2022-08-05 00:00:00", 4, false, 2)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlExpiring+
+			" WHERE b.current_quantity > 0 AND b.due_date <= ? AND s.warehouse_id = ? AND b.section_id = ?"+
+			productbatch.SqlExpiringOrderBy)).
+			WithArgs("2022-08-03", "2022-08-10 23:59:59", 1, 3).WillReturnRows(rows)
+
+		batches, err := mockRepository.Expiring(context.TODO(), "2022-08-03", "2022-08-10 23:59:59",
+			productbatch.ExpiringFilter{Days: 7, WarehouseID: 1, SectionID: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []productbatch.ExpiringBatch{
+			{2, 222, 1, 3, 1, "2022-08-01 00:00:00", 10, true, -2},
+			{1, 111, 1, 3, 1, "2022-08-05 00:00:00", 4, false, 2},
+		}, batches)
+	})
+
+	t.Run("expiring_empty", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlExpiring+" WHERE b.current_quantity > 0 AND b.due_date <= ?"+
+			productbatch.SqlExpiringOrderBy)).WithArgs("2022-08-03", "2022-08-03 23:59:59").WillReturnRows(sqlmock.NewRows(columns))
+
+		batches, err := mockRepository.Expiring(context.TODO(), "2022-08-03", "2022-08-03 23:59:59", productbatch.ExpiringFilter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []productbatch.ExpiringBatch{}, batches)
+	})
+
+	t.Run("expiring_fail_query", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlExpiring)).WillReturnError(sql.ErrConnDone)
+
+		_, err := mockRepository.Expiring(context.TODO(), "2022-08-03", "2022-08-03 23:59:59", productbatch.ExpiringFilter{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryFlagExpired(t *testing.T) {
+	mock, mockRepository := InitTestRepository(t)
+
+	t.Run("flag_expired_ok", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlReleaseExpiredReservations)).WithArgs("2022-08-03").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlFlagExpired)).WithArgs("2022-08-03").
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectCommit()
+
+		flagged, err := mockRepository.FlagExpired(context.TODO(), "2022-08-03")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, flagged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("flag_expired_fail_release", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlReleaseExpiredReservations)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		_, err := mockRepository.FlagExpired(context.TODO(), "2022-08-03")
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("flag_expired_fail", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlReleaseExpiredReservations)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlFlagExpired)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		_, err := mockRepository.FlagExpired(context.TODO(), "2022-08-03")
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryGetByID(t *testing.T) {
+	mock, mockRepository := InitTestRepository(t)
+	columns := []string{"id", "batch_number", "current_quantity", "current_temperature", "due_date", "initial_quantity",
+		"manufacturing_date", "manufacturing_hour", "minimum_temperature", "product_id", "section_id"}
+	exp := productbatch.ProductBatch{1, 111, 200, 20, "2022-04-04 00:00:00", 10, "2020-04-04 00:00:00", 10, 5, 1, 1}
+
+	t.Run("get_by_id_ok", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).AddRow(1, 111, 200, 20, "2022-04-04 00:00:00", 10, "2020-04-04 00:00:00", 10, 5, 1, 1)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetBatches + " WHERE id = ?")).WithArgs(1).WillReturnRows(rows)
+
+		pb, err := mockRepository.GetByID(context.TODO(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, exp, pb)
+	})
+
+	t.Run("get_by_id_not_found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetBatches + " WHERE id = ?")).WithArgs(99).WillReturnError(sql.ErrNoRows)
+
+		pb, err := mockRepository.GetByID(context.TODO(), 99)
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_BATCH_NOT_FOUND, 99), err)
+		assert.Equal(t, productbatch.ProductBatch{}, pb)
+	})
+}
+
+func TestRepositoryGetAll(t *testing.T) {
+	mock, mockRepository := InitTestRepository(t)
+	columns := []string{"id", "batch_number", "current_quantity", "current_temperature", "due_date", "initial_quantity",
+		"manufacturing_date", "manufacturing_hour", "minimum_temperature", "product_id", "section_id"}
+
+	t.Run("get_all_with_filters", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).AddRow(1, 111, 200, 20, "2022-04-04 00:00:00", 10, "2020-04-04 00:00:00", 10, 5, 1, 1)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetBatches+
+			" WHERE product_id = ? AND section_id = ? AND due_date >= ? AND due_date <= ?"+productbatch.SqlGetBatchesOrderBy)).
+			WithArgs(1, 1, "2022-04-01 00:00:00", "2022-04-30 23:59:59").WillReturnRows(rows)
+
+		batches, err := mockRepository.GetAll(context.TODO(), productbatch.BatchFilter{ProductID: 1, SectionID: 1,
+			From: "2022-04-01 00:00:00", To: "2022-04-30 23:59:59"})
+
+		assert.NoError(t, err)
+		assert.Len(t, batches, 1)
+	})
+
+	t.Run("get_all_without_filters", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetBatches + productbatch.SqlGetBatchesOrderBy)).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		batches, err := mockRepository.GetAll(context.TODO(), productbatch.BatchFilter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []productbatch.ProductBatch{}, batches)
+	})
+
+	t.Run("get_all_fail_query", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetBatches)).WillReturnError(sql.ErrConnDone)
+
+		_, err := mockRepository.GetAll(context.TODO(), productbatch.BatchFilter{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryUpdateDelete(t *testing.T) {
+	mock, mockRepository := InitTestRepository(t)
+
+	lockBatch := func(sectionID, quantity int) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockBatch)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "batch_number", "current_quantity", "current_temperature", "due_date",
+				"initial_quantity", "manufacturing_date", "manufacturing_hour", "minimum_temperature", "product_id", "section_id"}).
+				AddRow(1, 111, quantity, 20, "2022-04-04", 300, "2022-01-01", 10, 5, 1, sectionID))
+	}
+	reserved := func(quantity int) {
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlReservedStock)).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"reserved"}).AddRow(quantity))
+	}
+	batch := func(quantity, temperature, sectionID int) productbatch.ProductBatch {
+		return productbatch.ProductBatch{ID: 1, BatchNumber: 111, CurQuantity: quantity, CurTemperature: temperature,
+			DueDate: "2022-04-04", InitialQuantity: 300, ManufactDate: "2022-01-01", ManufactHour: 10, MinTemperature: 5,
+			ProductTypeID: 1, SectionID: sectionID}
+	}
+	temperature := 18
+
+	t.Run("update_ok", func(t *testing.T) {
+		quantity := 150
+		mock.ExpectBegin()
+		lockBatch(2, 200)
+		reserved(100)
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlFreeCapacity)).WithArgs(50, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlUpdateBatch)).WithArgs(150, 18, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		pb, err := mockRepository.Update(context.TODO(), 1, productbatch.BatchUpdate{CurQuantity: &quantity, CurTemperature: &temperature})
+
+		assert.NoError(t, err)
+		assert.Equal(t, batch(150, 18, 2), pb)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("update_keeps_locked_quantity", func(t *testing.T) {
+		mock.ExpectBegin()
+		lockBatch(2, 120)
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlUpdateBatch)).WithArgs(120, 18, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		pb, err := mockRepository.Update(context.TODO(), 1, productbatch.BatchUpdate{CurTemperature: &temperature})
+
+		assert.NoError(t, err)
+		assert.Equal(t, batch(120, 18, 2), pb)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("update_below_reserved", func(t *testing.T) {
+		quantity := 50
+		mock.ExpectBegin()
+		lockBatch(2, 200)
+		reserved(80)
+		mock.ExpectRollback()
+
+		pb, err := mockRepository.Update(context.TODO(), 1, productbatch.BatchUpdate{CurQuantity: &quantity})
+
+		assert.Equal(t, errors.New(productbatch.ERR_BELOW_RESERVED), err)
+		assert.Equal(t, productbatch.ProductBatch{}, pb)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("update_grows_within_capacity", func(t *testing.T) {
+		quantity := 250
+		mock.ExpectBegin()
+		lockBatch(2, 200)
+		reserved(0)
+		ExpectLockSection(mock, 2, 400, 500)
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlOccupyCapacity)).WithArgs(50, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlUpdateBatch)).WithArgs(250, 20, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		pb, err := mockRepository.Update(context.TODO(), 1, productbatch.BatchUpdate{CurQuantity: &quantity})
+
+		assert.NoError(t, err)
+		assert.Equal(t, batch(250, 20, 2), pb)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("update_moves_section", func(t *testing.T) {
+		sectionID := 3
+		mock.ExpectBegin()
+		lockBatch(2, 200)
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlFreeCapacity)).WithArgs(200, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		ExpectLockSection(mock, 3, 0, 200)
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlOccupyCapacity)).WithArgs(200, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlUpdateBatch)).WithArgs(200, 20, 3, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		pb, err := mockRepository.Update(context.TODO(), 1, productbatch.BatchUpdate{SectionID: &sectionID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, batch(200, 20, 3), pb)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("update_move_section_full", func(t *testing.T) {
+		sectionID := 3
+		mock.ExpectBegin()
+		lockBatch(2, 200)
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlFreeCapacity)).WithArgs(200, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		ExpectLockSection(mock, 3, 150, 300)
+		mock.ExpectRollback()
+
+		pb, err := mockRepository.Update(context.TODO(), 1, productbatch.BatchUpdate{SectionID: &sectionID})
+
+		assert.Equal(t, errors.New(productbatch.ERR_SECTION_FULL), err)
+		assert.Equal(t, productbatch.ProductBatch{}, pb)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("update_not_found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlLockBatch)).WithArgs(1).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, err := mockRepository.Update(context.TODO(), 1, productbatch.BatchUpdate{})
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_BATCH_NOT_FOUND, 1), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("delete_ok", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlDeleteBatch)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := mockRepository.Delete(context.TODO(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("delete_not_empty", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(productbatch.SqlDeleteBatch)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := mockRepository.Delete(context.TODO(), 1)
+
+		assert.Equal(t, errors.New(productbatch.ERR_BATCH_NOT_EMPTY), err)
+	})
+}
+
+func TestRepositoryStorage(t *testing.T) {
+	sectionColumns := []string{"id", "section_number", "warehouse_id", "product_type_id", "minimum_temperature",
+		"current_temperature", "current_capacity", "maximum_capacity"}
+
+	t.Run("get_storage_product_ok", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		rows := sqlmock.NewRows([]string{"id", "product_type_id", "recommended_freezing_temperature"}).AddRow(1, 2, "-18.00")
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetStorageProduct)).WithArgs(1).WillReturnRows(rows)
+
+		product, err := mockRepository.GetStorageProduct(context.TODO(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, productbatch.StorageProduct{ID: 1, ProductTypeID: 2, FreezingTemperature: -18}, product)
+	})
+
+	t.Run("get_storage_product_not_found", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetStorageProduct)).WithArgs(9).WillReturnError(sql.ErrNoRows)
+
+		_, err := mockRepository.GetStorageProduct(context.TODO(), 9)
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_PRODUCT_NOT_FOUND, 9), err)
+	})
+
+	t.Run("get_storage_section_not_found", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetStorageSections + " WHERE id = ?")).WithArgs(9).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := mockRepository.GetStorageSection(context.TODO(), 9)
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_SECTION_NOT_FOUND, 9), err)
+	})
+
+	t.Run("storage_sections_ok", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		rows := sqlmock.NewRows(sectionColumns).AddRow(1, 10, 1, 1, -25, -18, 100, 500).AddRow(4, 40, 1, 2, 0, 5, 0, 500)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetStorageSections)).WillReturnRows(rows)
+
+		sections, err := mockRepository.StorageSections(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []productbatch.StorageSection{
+			{ID: 1, SectionNumber: 10, WarehouseID: 1, ProductTypeID: 1, MinTemperature: -25, CurTemperature: -18, CurCapacity: 100, MaxCapacity: 500},
+			{ID: 4, SectionNumber: 40, WarehouseID: 1, ProductTypeID: 2, MinTemperature: 0, CurTemperature: 5, MaxCapacity: 500},
+		}, sections)
+	})
+
+	t.Run("storage_sections_fail_query", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetStorageSections)).WillReturnError(sql.ErrConnDone)
+
+		sections, err := mockRepository.StorageSections(context.TODO())
+
+		assert.Error(t, err)
+		assert.Equal(t, []productbatch.StorageSection{}, sections)
+	})
+
+	t.Run("warehouse_sections_ok", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		rows := sqlmock.NewRows(sectionColumns).AddRow(1, 10, 2, 1, -25, -18, 100, 500)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlGetStorageSections + " WHERE warehouse_id = ?")).WithArgs(2).
+			WillReturnRows(rows)
+
+		sections, err := mockRepository.WarehouseSections(context.TODO(), 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []productbatch.StorageSection{
+			{ID: 1, SectionNumber: 10, WarehouseID: 2, ProductTypeID: 1, MinTemperature: -25, CurTemperature: -18, CurCapacity: 100, MaxCapacity: 500},
+		}, sections)
+	})
+
+	t.Run("warehouse_exists", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlWarehouseExists)).WithArgs(9).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		exists, err := mockRepository.WarehouseExists(context.TODO(), 9)
+
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestRepositoryWarehouseStock(t *testing.T) {
+	t.Run("warehouse_stock_ok", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		rows := sqlmock.NewRows([]string{"product_id", "batches", "current_quantity", "initial_quantity", "expired_batches"}).
+			AddRow(1, 2, 150, 300, 1).AddRow(3, 1, 40, 40, 0)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlWarehouseStock)).WithArgs(1).WillReturnRows(rows)
+
+		stock, err := mockRepository.WarehouseStock(context.TODO(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []productbatch.ProductStock{
+			{ProductID: 1, Batches: 2, CurrentQuantity: 150, InitialQuantity: 300, ExpiredBatches: 1},
+			{ProductID: 3, Batches: 1, CurrentQuantity: 40, InitialQuantity: 40},
+		}, stock)
+	})
+
+	t.Run("warehouse_stock_error", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlWarehouseStock)).WithArgs(1).WillReturnError(sql.ErrConnDone)
+
+		_, err := mockRepository.WarehouseStock(context.TODO(), 1)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryProductSections(t *testing.T) {
+	t.Run("product_sections_ok", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		rows := sqlmock.NewRows([]string{"section_id"}).AddRow(1).AddRow(3)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlProductSections)).WithArgs(1).WillReturnRows(rows)
+
+		sections, err := mockRepository.ProductSections(context.TODO(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 3}, sections)
+	})
+
+	t.Run("product_sections_error", func(t *testing.T) {
+		mock, mockRepository := InitTestRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(productbatch.SqlProductSections)).WithArgs(1).WillReturnError(sql.ErrConnDone)
+
+		_, err := mockRepository.ProductSections(context.TODO(), 1)
+
+		assert.Error(t, err)
+	})
+}
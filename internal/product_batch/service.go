@@ -2,13 +2,37 @@ package productbatch
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04:05"
 )
 
 type Services interface {
-	Report(ctx context.Context) ([]Report, error)
+	Report(ctx context.Context, filter ReportFilter) ([]Report, error)
 	ReportByID(ctx context.Context, id int) (Report, error)
 	Create(ctx context.Context, pb ProductBatch) (ProductBatch, error)
+	Allocate(ctx context.Context, a Allocation) (Allocation, error)
+	ConfirmPick(ctx context.Context, a Allocation) (Allocation, error)
+	GetShelfLife(ctx context.Context, buyerID int) (ShelfLife, error)
+	SetShelfLife(ctx context.Context, shelfLife ShelfLife) (ShelfLife, error)
+	Expiring(ctx context.Context, filter ExpiringFilter) ([]ExpiringBatch, error)
+	FlagExpired(ctx context.Context) (int, error)
+	GetByID(ctx context.Context, id int) (ProductBatch, error)
+	GetAll(ctx context.Context, filter BatchFilter) ([]ProductBatch, error)
+	Update(ctx context.Context, id int, changes BatchUpdate) (ProductBatch, error)
+	Delete(ctx context.Context, id int) error
+	CompatibleSections(ctx context.Context, productID int) ([]StorageSection, error)
+	Recommend(ctx context.Context, slotting Slotting) ([]Recommendation, error)
 }
 
 type service struct {
@@ -21,11 +45,19 @@ func NewService(r Repository) Services {
 }
 
 func (s service) Create(ctx context.Context, pb ProductBatch) (ProductBatch, error) {
+	if pb.CurQuantity < 0 {
+		return ProductBatch{}, fmt.Errorf(ERR_INVALID_QUANTITY)
+	}
+
 	_, err := s.repository.GetByBatchNum(ctx, pb.BatchNumber)
 	if err == nil {
 		return ProductBatch{}, fmt.Errorf("error: batch number '%d' already exists in BD", pb.BatchNumber)
 	}
 
+	if err = s.checkCompatibility(ctx, pb.ProductTypeID, pb.SectionID); err != nil {
+		return ProductBatch{}, err
+	}
+
 	pb, err = s.repository.Create(ctx, pb)
 	if err != nil {
 		return ProductBatch{}, err
@@ -33,11 +65,15 @@ func (s service) Create(ctx context.Context, pb ProductBatch) (ProductBatch, err
 	return pb, nil
 }
 
-func (s service) Report(ctx context.Context) ([]Report, error) {
-	pb, err := s.repository.Report(ctx)
+func (s service) Report(ctx context.Context, filter ReportFilter) ([]Report, error) {
+	pb, err := s.repository.Report(ctx, filter)
 	if err != nil {
 		return []Report{}, err
 	}
+
+	for i := range pb {
+		pb[i] = withUtilization(pb[i])
+	}
 	return pb, nil
 }
 
@@ -46,5 +82,375 @@ func (s service) ReportByID(ctx context.Context, id int) (Report, error) {
 	if err != nil {
 		return Report{}, err
 	}
-	return pb, nil
+	return withUtilization(pb), nil
+}
+
+// withUtilization sets the percentage of the maximum capacity of the section
+// used by the quantity of its batches. Sections without a maximum capacity
+// have none.
+func withUtilization(rep Report) Report {
+	if rep.MaxCapacity > 0 {
+		rep.Utilization = math.Round(float64(rep.TotalQuantity)/float64(rep.MaxCapacity)*10000) / 100
+	}
+	return rep
+}
+
+var reportCSVHeader = []string{"section_id", "section_number", "products_count", "total_quantity", "distinct_products",
+	"earliest_due_date", "maximum_capacity", "utilization_percentage"}
+
+// WriteReportCSV writes the section reports as a csv with a header row.
+func WriteReportCSV(w io.Writer, reports []Report) error {
+	csvWriter := csv.NewWriter(w)
+
+	if err := csvWriter.Write(reportCSVHeader); err != nil {
+		return err
+	}
+
+	for _, rep := range reports {
+		err := csvWriter.Write([]string{strconv.Itoa(rep.SecID), strconv.Itoa(rep.SecNum), strconv.Itoa(rep.ProdCount),
+			strconv.Itoa(rep.TotalQuantity), strconv.Itoa(rep.DistinctProducts), rep.EarliestDueDate,
+			strconv.Itoa(rep.MaxCapacity), strconv.FormatFloat(rep.Utilization, 'f', 2, 64)})
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// Allocate plans the picks of the quantity of the product, first expired
+// first out: the batches due first are picked first, skipping the ones due
+// before the minimum shelf life of the buyer from today. Nothing changes
+// until the pick is confirmed.
+func (s service) Allocate(ctx context.Context, a Allocation) (Allocation, error) {
+	if a.Quantity <= 0 {
+		return Allocation{}, fmt.Errorf(ERR_INVALID_QUANTITY)
+	}
+
+	shelfLife, err := s.repository.GetShelfLife(ctx, a.BuyerID)
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	minDueDate := time.Now().AddDate(0, 0, shelfLife.MinimumDays).Format(dateLayout)
+
+	batches, err := s.repository.AllocatableBatches(ctx, a.ProductID, a.PurchaseOrderID, minDueDate)
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	a.Picks = []Pick{}
+	remaining := a.Quantity
+	for _, batch := range batches {
+		if remaining == 0 {
+			break
+		}
+		if batch.Available <= 0 {
+			continue
+		}
+
+		quantity := batch.Available
+		if quantity > remaining {
+			quantity = remaining
+		}
+		remaining -= quantity
+
+		a.Picks = append(a.Picks, Pick{batch.ID, batch.BatchNumber, batch.SectionID, batch.DueDate, quantity})
+	}
+
+	if remaining > 0 {
+		return Allocation{}, fmt.Errorf(ERR_NOT_ENOUGH_STOCK)
+	}
+
+	return a, nil
+}
+
+// ConfirmPick takes the picked quantities out of the batches of the plan,
+// checking them again as Allocate would, since the stock may have changed
+// since the plan was made.
+func (s service) ConfirmPick(ctx context.Context, a Allocation) (Allocation, error) {
+	if len(a.Picks) == 0 {
+		return Allocation{}, fmt.Errorf(ERR_NO_PICKS)
+	}
+
+	a.Quantity = 0
+	for _, pick := range a.Picks {
+		if pick.Quantity <= 0 {
+			return Allocation{}, fmt.Errorf(ERR_INVALID_QUANTITY)
+		}
+		a.Quantity += pick.Quantity
+	}
+
+	shelfLife, err := s.repository.GetShelfLife(ctx, a.BuyerID)
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	minDueDate := time.Now().AddDate(0, 0, shelfLife.MinimumDays).Format(dateLayout)
+
+	if err = s.repository.ConfirmPicks(ctx, a.ProductID, a.PurchaseOrderID, minDueDate, a.Picks); err != nil {
+		return Allocation{}, err
+	}
+
+	return a, nil
+}
+
+func (s service) GetShelfLife(ctx context.Context, buyerID int) (ShelfLife, error) {
+	return s.repository.GetShelfLife(ctx, buyerID)
+}
+
+func (s service) SetShelfLife(ctx context.Context, shelfLife ShelfLife) (ShelfLife, error) {
+	if shelfLife.MinimumDays < 0 {
+		return ShelfLife{}, fmt.Errorf(ERR_INVALID_SHELF_LIFE)
+	}
+
+	return s.repository.SetShelfLife(ctx, shelfLife)
+}
+
+// Expiring lists the batches with stock left that are due by the end of the
+// day the given days from today, including the ones already expired.
+func (s service) Expiring(ctx context.Context, filter ExpiringFilter) ([]ExpiringBatch, error) {
+	if filter.Days < 0 {
+		return []ExpiringBatch{}, fmt.Errorf(ERR_INVALID_DAYS)
+	}
+
+	today := time.Now()
+	until := today.AddDate(0, 0, filter.Days).Format(dateLayout) + " 23:59:59"
+
+	return s.repository.Expiring(ctx, today.Format(dateLayout), until, filter)
+}
+
+// FlagExpired flags the batches due before today as expired, releasing the
+// stock reserved in them, and returns how many.
+func (s service) FlagExpired(ctx context.Context) (int, error) {
+	return s.repository.FlagExpired(ctx, time.Now().Format(dateLayout))
+}
+
+func (s service) GetByID(ctx context.Context, id int) (ProductBatch, error) {
+	return s.repository.GetByID(ctx, id)
+}
+
+// GetAll lists the product batches in the order they are due. The due date
+// range is given as dates and includes both days.
+func (s service) GetAll(ctx context.Context, filter BatchFilter) ([]ProductBatch, error) {
+	if filter.From != "" {
+		from, err := time.Parse(dateLayout, filter.From)
+		if err != nil {
+			return []ProductBatch{}, fmt.Errorf(ERR_INVALID_DATE)
+		}
+		filter.From = from.Format(dateTimeLayout)
+	}
+	if filter.To != "" {
+		to, err := time.Parse(dateLayout, filter.To)
+		if err != nil {
+			return []ProductBatch{}, fmt.Errorf(ERR_INVALID_DATE)
+		}
+		filter.To = to.Add(24*time.Hour - time.Second).Format(dateTimeLayout)
+	}
+
+	return s.repository.GetAll(ctx, filter)
+}
+
+// Update changes the current quantity, temperature and section of the
+// product batch. The changes are applied by the repository to the batch it
+// locks, so only the section is checked against the batch read here.
+func (s service) Update(ctx context.Context, id int, changes BatchUpdate) (ProductBatch, error) {
+	pb, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return ProductBatch{}, err
+	}
+
+	if changes.CurQuantity != nil && *changes.CurQuantity < 0 {
+		return ProductBatch{}, fmt.Errorf(ERR_INVALID_QUANTITY)
+	}
+	if changes.SectionID != nil && *changes.SectionID != pb.SectionID {
+		if err = s.checkCompatibility(ctx, pb.ProductTypeID, *changes.SectionID); err != nil {
+			return ProductBatch{}, err
+		}
+	}
+
+	return s.repository.Update(ctx, id, changes)
+}
+
+// Delete removes the product batch once all of its stock is gone.
+func (s service) Delete(ctx context.Context, id int) error {
+	pb, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if pb.CurQuantity != 0 {
+		return fmt.Errorf(ERR_BATCH_NOT_EMPTY)
+	}
+
+	return s.repository.Delete(ctx, id)
+}
+
+// CompatibleSections lists the sections where the product can be stored.
+func (s service) CompatibleSections(ctx context.Context, productID int) ([]StorageSection, error) {
+	product, err := s.repository.GetStorageProduct(ctx, productID)
+	if err != nil {
+		return []StorageSection{}, err
+	}
+
+	sections, err := s.repository.StorageSections(ctx)
+	if err != nil {
+		return []StorageSection{}, err
+	}
+
+	compatible := []StorageSection{}
+	for _, section := range sections {
+		if len(Incompatibilities(product, section)) == 0 {
+			compatible = append(compatible, section)
+		}
+	}
+
+	return compatible, nil
+}
+
+// Recommend ranks the sections of the warehouse for an incoming batch. The
+// sections that can take it come first, by their score: how close their
+// current temperature is to the recommended freezing temperature of the
+// product, how much of their capacity is left free after the batch, and how
+// near, by section number, they are to the sections already holding the
+// product.
+func (s service) Recommend(ctx context.Context, slotting Slotting) ([]Recommendation, error) {
+	if slotting.Quantity <= 0 {
+		return []Recommendation{}, fmt.Errorf(ERR_INVALID_QUANTITY)
+	}
+
+	product, err := s.repository.GetStorageProduct(ctx, slotting.ProductID)
+	if err != nil {
+		return []Recommendation{}, err
+	}
+
+	exists, err := s.repository.WarehouseExists(ctx, slotting.WarehouseID)
+	if err != nil {
+		return []Recommendation{}, err
+	}
+	if !exists {
+		return []Recommendation{}, fmt.Errorf(ERR_WAREHOUSE_NOT_FOUND, slotting.WarehouseID)
+	}
+
+	sections, err := s.repository.WarehouseSections(ctx, slotting.WarehouseID)
+	if err != nil {
+		return []Recommendation{}, err
+	}
+
+	holding, err := s.repository.ProductSections(ctx, slotting.ProductID)
+	if err != nil {
+		return []Recommendation{}, err
+	}
+
+	holdingIDs := make(map[int]bool, len(holding))
+	for _, id := range holding {
+		holdingIDs[id] = true
+	}
+
+	var holdingNumbers []int
+	for _, section := range sections {
+		if holdingIDs[section.ID] {
+			holdingNumbers = append(holdingNumbers, section.SectionNumber)
+		}
+	}
+
+	recommendations := make([]Recommendation, 0, len(sections))
+	for _, section := range sections {
+		recommendations = append(recommendations, recommend(product, section, slotting.Quantity, holdingNumbers))
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		if recommendations[i].Suitable != recommendations[j].Suitable {
+			return recommendations[i].Suitable
+		}
+		return recommendations[i].Score > recommendations[j].Score
+	})
+
+	return recommendations, nil
+}
+
+func recommend(product StorageProduct, section StorageSection, quantity int, holdingNumbers []int) Recommendation {
+	rec := Recommendation{Section: section, FreeCapacity: section.MaxCapacity - section.CurCapacity}
+	if rec.FreeCapacity < 0 {
+		rec.FreeCapacity = 0
+	}
+
+	rec.Reasons = Incompatibilities(product, section)
+	if rec.FreeCapacity < quantity {
+		rec.Reasons = append(rec.Reasons, fmt.Sprintf("the section has %d of free capacity and the batch needs %d",
+			rec.FreeCapacity, quantity))
+	}
+	if len(rec.Reasons) > 0 {
+		return rec
+	}
+
+	rec.Suitable = true
+	rec.TemperatureFit = round(1 / (1 + math.Abs(float64(section.CurTemperature)-product.FreezingTemperature)))
+	rec.CapacityFit = round(float64(rec.FreeCapacity-quantity) / float64(section.MaxCapacity))
+
+	for _, number := range holdingNumbers {
+		distance := number - section.SectionNumber
+		if distance < 0 {
+			distance = -distance
+		}
+
+		proximity := round(1 / (1 + float64(distance)))
+		if proximity > rec.Proximity {
+			rec.Proximity = proximity
+		}
+	}
+
+	rec.Score = round(100 * (WEIGHT_TEMPERATURE*rec.TemperatureFit + WEIGHT_CAPACITY*rec.CapacityFit +
+		WEIGHT_PROXIMITY*rec.Proximity))
+
+	return rec
+}
+
+// round rounds to two decimals.
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func (s service) checkCompatibility(ctx context.Context, productID, sectionID int) error {
+	product, err := s.repository.GetStorageProduct(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	section, err := s.repository.GetStorageSection(ctx, sectionID)
+	if err != nil {
+		return err
+	}
+
+	if reasons := Incompatibilities(product, section); len(reasons) > 0 {
+		return fmt.Errorf("%s: %s", ERR_INCOMPATIBLE, strings.Join(reasons, "; "))
+	}
+
+	return nil
+}
+
+// Incompatibilities lists why the product can't be stored in the section.
+// The section has to store the type of the product, and keep its recommended
+// freezing temperature: sections hold their products between their minimum
+// and current temperature.
+func Incompatibilities(product StorageProduct, section StorageSection) []string {
+	var reasons []string
+
+	if product.ProductTypeID != section.ProductTypeID {
+		reasons = append(reasons, fmt.Sprintf("the section stores product type %d and the product is of type %d",
+			section.ProductTypeID, product.ProductTypeID))
+	}
+
+	lowest, highest := section.MinTemperature, section.CurTemperature
+	if lowest > highest {
+		lowest, highest = highest, lowest
+	}
+
+	if product.FreezingTemperature < float64(lowest) || product.FreezingTemperature > float64(highest) {
+		reasons = append(reasons, fmt.Sprintf("the recommended freezing temperature of the product (%.2f) is out of "+
+			"the temperatures of the section (%d to %d)", product.FreezingTemperature, lowest, highest))
+	}
+
+	return reasons
 }
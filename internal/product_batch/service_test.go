@@ -1,9 +1,12 @@
 package productbatch_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	productbatch "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch/mocks"
@@ -18,12 +21,29 @@ func InitTestService(t *testing.T) (productbatch.Services, *mocks.Repository) {
 	return service, mockRepository
 }
 
+var (
+	frozenProduct = productbatch.StorageProduct{ID: 1, ProductTypeID: 1, FreezingTemperature: -18}
+	freezer       = productbatch.StorageSection{ID: 1, SectionNumber: 10, WarehouseID: 1, ProductTypeID: 1,
+		MinTemperature: -25, CurTemperature: -18, MaxCapacity: 500}
+	chiller = productbatch.StorageSection{ID: 4, SectionNumber: 40, WarehouseID: 1, ProductTypeID: 2,
+		MinTemperature: 0, CurTemperature: 5, MaxCapacity: 500}
+)
+
+func ExpectCompatible(mockRepository *mocks.Repository, sectionID int) {
+	section := freezer
+	section.ID = sectionID
+
+	mockRepository.On("GetStorageProduct", mock.Anything, frozenProduct.ID).Return(frozenProduct, nil).Once()
+	mockRepository.On("GetStorageSection", mock.Anything, sectionID).Return(section, nil).Once()
+}
+
 func TestServiceCreate(t *testing.T) {
 	t.Run("create_ok", func(t *testing.T) {
 		service, mockRepository := InitTestService(t)
 		exp := productbatch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1}
 
 		mockRepository.On("GetByBatchNum", mock.Anything, mock.Anything).Return(productbatch.ProductBatch{}, errors.New(""))
+		ExpectCompatible(mockRepository, 1)
 		mockRepository.On("Create", mock.Anything, exp).Return(exp, nil)
 		pb, err := service.Create(context.TODO(), exp)
 
@@ -31,11 +51,22 @@ func TestServiceCreate(t *testing.T) {
 		assert.Equal(t, exp, pb)
 	})
 
+	t.Run("create_negative_quantity", func(t *testing.T) {
+		service, _ := InitTestService(t)
+		exp := productbatch.ProductBatch{1, 111, -5, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1}
+
+		pb, err := service.Create(context.TODO(), exp)
+
+		assert.Equal(t, errors.New(productbatch.ERR_INVALID_QUANTITY), err)
+		assert.Equal(t, productbatch.ProductBatch{}, pb)
+	})
+
 	t.Run("create_fail", func(t *testing.T) {
 		service, mockRepository := InitTestService(t)
 		exp := productbatch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1}
 
 		mockRepository.On("GetByBatchNum", mock.Anything, mock.Anything).Return(productbatch.ProductBatch{}, errors.New(""))
+		ExpectCompatible(mockRepository, 1)
 		mockRepository.On("Create", mock.Anything, exp).Return(productbatch.ProductBatch{}, errors.New("sql: rows not affected"))
 		pb, err := service.Create(context.TODO(), exp)
 
@@ -55,6 +86,32 @@ func TestServiceCreate(t *testing.T) {
 		assert.Equal(t, errors.New("error: batch number '111' already exists in BD"), err)
 		assert.Equal(t, productbatch.ProductBatch{}, pb)
 	})
+
+	t.Run("create_incompatible_section", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+		exp := productbatch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 4}
+
+		mockRepository.On("GetByBatchNum", mock.Anything, mock.Anything).Return(productbatch.ProductBatch{}, errors.New(""))
+		mockRepository.On("GetStorageProduct", mock.Anything, 1).Return(frozenProduct, nil)
+		mockRepository.On("GetStorageSection", mock.Anything, 4).Return(chiller, nil)
+		pb, err := service.Create(context.TODO(), exp)
+
+		assert.Equal(t, errors.New("the product can't be stored in the section: the section stores product type 2 and "+
+			"the product is of type 1; the recommended freezing temperature of the product (-18.00) is out of the "+
+			"temperatures of the section (0 to 5)"), err)
+		assert.Equal(t, productbatch.ProductBatch{}, pb)
+	})
+
+	t.Run("create_product_not_found", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+		exp := productbatch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 9, 1}
+
+		mockRepository.On("GetByBatchNum", mock.Anything, mock.Anything).Return(productbatch.ProductBatch{}, errors.New(""))
+		mockRepository.On("GetStorageProduct", mock.Anything, 9).Return(productbatch.StorageProduct{}, fmt.Errorf(productbatch.ERR_PRODUCT_NOT_FOUND, 9))
+		_, err := service.Create(context.TODO(), exp)
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_PRODUCT_NOT_FOUND, 9), err)
+	})
 }
 
 func TestServiceReport(t *testing.T) {
@@ -62,18 +119,23 @@ func TestServiceReport(t *testing.T) {
 		service, mockRepository := InitTestService(t)
 		exp := CreateReportArray()
 
-		mockRepository.On("Report", mock.Anything).Return(exp, nil)
-		pb, err := service.Report(context.TODO())
+		filter := productbatch.ReportFilter{WarehouseID: 2}
+
+		mockRepository.On("Report", mock.Anything, filter).Return(exp, nil)
+		pb, err := service.Report(context.TODO(), filter)
 
 		assert.NoError(t, err)
-		assert.Equal(t, exp, pb)
+		assert.Len(t, pb, 3)
+		assert.Equal(t, 40.0, pb[0].Utilization)
+		assert.Equal(t, 0.0, pb[1].Utilization)
+		assert.Equal(t, 125.0, pb[2].Utilization)
 	})
 
 	t.Run("report_fail", func(t *testing.T) {
 		service, mockRepository := InitTestService(t)
 
-		mockRepository.On("Report", mock.Anything).Return([]productbatch.Report{}, errors.New("sql: rows not affected"))
-		pb, err := service.Report(context.TODO())
+		mockRepository.On("Report", mock.Anything, productbatch.ReportFilter{}).Return([]productbatch.Report{}, errors.New("sql: rows not affected"))
+		pb, err := service.Report(context.TODO(), productbatch.ReportFilter{})
 
 		assert.Error(t, err)
 		assert.Equal(t, errors.New("sql: rows not affected"), err)
@@ -89,6 +151,7 @@ func TestServiceReportByID(t *testing.T) {
 		mockRepository.On("ReportByID", mock.Anything, 1).Return(exp, nil)
 		pb, err := service.ReportByID(context.TODO(), 1)
 
+		exp.Utilization = 40
 		assert.NoError(t, err)
 		assert.Equal(t, exp, pb)
 	})
@@ -104,3 +167,314 @@ func TestServiceReportByID(t *testing.T) {
 		assert.Equal(t, productbatch.Report{}, pb)
 	})
 }
+
+func TestWriteReportCSV(t *testing.T) {
+	var buf bytes.Buffer
+	reports := []productbatch.Report{{1, 22, 5, 120, 3, "2022-04-04", 300, 40}}
+
+	err := productbatch.WriteReportCSV(&buf, reports)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "section_id,section_number,products_count,total_quantity,distinct_products,earliest_due_date,"+
+		"maximum_capacity,utilization_percentage\n1,22,5,120,3,2022-04-04,300,40.00\n", buf.String())
+}
+
+func TestServiceAllocate(t *testing.T) {
+	t.Run("allocate_first_expired_first_out", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+		minDueDate := time.Now().AddDate(0, 0, 10).Format("2006-01-02")
+
+		mockRepository.On("GetShelfLife", mock.Anything, 3).Return(productbatch.ShelfLife{BuyerID: 3, MinimumDays: 10}, nil)
+		mockRepository.On("AllocatableBatches", mock.Anything, 1, 0, minDueDate).Return([]productbatch.BatchStock{
+			{2, 222, 3, "2022-09-01 00:00:00", 10},
+			{4, 444, 3, "2022-09-15 00:00:00", 0},
+			{1, 111, 1, "2022-10-01 00:00:00", 8},
+			{5, 555, 2, "2022-11-01 00:00:00", 8},
+		}, nil)
+		allocation, err := service.Allocate(context.TODO(), productbatch.Allocation{ProductID: 1, BuyerID: 3, Quantity: 12})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []productbatch.Pick{
+			{2, 222, 3, "2022-09-01 00:00:00", 10},
+			{1, 111, 1, "2022-10-01 00:00:00", 2},
+		}, allocation.Picks)
+	})
+
+	t.Run("allocate_not_enough_stock", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		mockRepository.On("GetShelfLife", mock.Anything, 3).Return(productbatch.ShelfLife{BuyerID: 3}, nil)
+		mockRepository.On("AllocatableBatches", mock.Anything, 1, 0, mock.Anything).Return([]productbatch.BatchStock{
+			{2, 222, 3, "2022-09-01 00:00:00", 10},
+		}, nil)
+		_, err := service.Allocate(context.TODO(), productbatch.Allocation{ProductID: 1, BuyerID: 3, Quantity: 12})
+
+		assert.Equal(t, errors.New(productbatch.ERR_NOT_ENOUGH_STOCK), err)
+	})
+
+	t.Run("allocate_invalid_quantity", func(t *testing.T) {
+		service, _ := InitTestService(t)
+
+		_, err := service.Allocate(context.TODO(), productbatch.Allocation{ProductID: 1})
+
+		assert.Equal(t, errors.New(productbatch.ERR_INVALID_QUANTITY), err)
+	})
+}
+
+func TestServiceConfirmPick(t *testing.T) {
+	t.Run("confirm_pick_ok", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+		picks := []productbatch.Pick{{ProductBatchID: 2, Quantity: 10}, {ProductBatchID: 1, Quantity: 2}}
+
+		minDueDate := time.Now().AddDate(0, 0, 5).Format("2006-01-02")
+
+		mockRepository.On("GetShelfLife", mock.Anything, 3).Return(productbatch.ShelfLife{BuyerID: 3, MinimumDays: 5}, nil)
+		mockRepository.On("ConfirmPicks", mock.Anything, 1, 7, minDueDate, picks).Return(nil)
+		allocation, err := service.ConfirmPick(context.TODO(), productbatch.Allocation{ProductID: 1, BuyerID: 3,
+			PurchaseOrderID: 7, Picks: picks})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 12, allocation.Quantity)
+	})
+
+	t.Run("confirm_pick_fail_shelf_life", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+		picks := []productbatch.Pick{{ProductBatchID: 2, Quantity: 10}}
+
+		mockRepository.On("GetShelfLife", mock.Anything, 3).Return(productbatch.ShelfLife{}, errors.New("error"))
+		_, err := service.ConfirmPick(context.TODO(), productbatch.Allocation{ProductID: 1, BuyerID: 3, Picks: picks})
+
+		assert.Equal(t, errors.New("error"), err)
+	})
+
+	t.Run("confirm_pick_without_picks", func(t *testing.T) {
+		service, _ := InitTestService(t)
+
+		_, err := service.ConfirmPick(context.TODO(), productbatch.Allocation{})
+
+		assert.Equal(t, errors.New(productbatch.ERR_NO_PICKS), err)
+	})
+}
+
+func TestServiceSetShelfLife(t *testing.T) {
+	t.Run("set_shelf_life_negative", func(t *testing.T) {
+		service, _ := InitTestService(t)
+
+		_, err := service.SetShelfLife(context.TODO(), productbatch.ShelfLife{BuyerID: 1, MinimumDays: -1})
+
+		assert.Equal(t, errors.New(productbatch.ERR_INVALID_SHELF_LIFE), err)
+	})
+}
+
+func TestServiceExpiring(t *testing.T) {
+	t.Run("expiring_ok", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+		today := time.Now()
+		filter := productbatch.ExpiringFilter{Days: 7, WarehouseID: 1}
+		exp := []productbatch.ExpiringBatch{{ID: 1, BatchNumber: 111, DaysLeft: 2}}
+
+		mockRepository.On("Expiring", mock.Anything, today.Format("2006-01-02"),
+			today.AddDate(0, 0, 7).Format("2006-01-02")+" 23:59:59", filter).Return(exp, nil)
+		batches, err := service.Expiring(context.TODO(), filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, exp, batches)
+	})
+
+	t.Run("expiring_invalid_days", func(t *testing.T) {
+		service, _ := InitTestService(t)
+
+		_, err := service.Expiring(context.TODO(), productbatch.ExpiringFilter{Days: -1})
+
+		assert.Equal(t, errors.New(productbatch.ERR_INVALID_DAYS), err)
+	})
+}
+
+func TestServiceFlagExpired(t *testing.T) {
+	t.Run("flag_expired_ok", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		mockRepository.On("FlagExpired", mock.Anything, time.Now().Format("2006-01-02")).Return(2, nil)
+		flagged, err := service.FlagExpired(context.TODO())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, flagged)
+	})
+}
+
+func TestServiceGetAll(t *testing.T) {
+	t.Run("get_all_due_date_range", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		mockRepository.On("GetAll", mock.Anything, productbatch.BatchFilter{ProductID: 1, From: "2022-04-01 00:00:00",
+			To: "2022-04-30 23:59:59"}).Return([]productbatch.ProductBatch{}, nil)
+		_, err := service.GetAll(context.TODO(), productbatch.BatchFilter{ProductID: 1, From: "2022-04-01", To: "2022-04-30"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("get_all_invalid_date", func(t *testing.T) {
+		service, _ := InitTestService(t)
+
+		_, err := service.GetAll(context.TODO(), productbatch.BatchFilter{To: "30/04/2022"})
+
+		assert.Equal(t, errors.New(productbatch.ERR_INVALID_DATE), err)
+	})
+}
+
+func TestServiceUpdate(t *testing.T) {
+	t.Run("update_ok", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+		saved := productbatch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1}
+		exp := productbatch.ProductBatch{1, 111, 150, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1}
+		quantity := 150
+		changes := productbatch.BatchUpdate{CurQuantity: &quantity}
+
+		mockRepository.On("GetByID", mock.Anything, 1).Return(saved, nil)
+		mockRepository.On("Update", mock.Anything, 1, changes).Return(exp, nil)
+		pb, err := service.Update(context.TODO(), 1, changes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, exp, pb)
+	})
+
+	t.Run("update_negative_quantity", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+		quantity := -1
+
+		mockRepository.On("GetByID", mock.Anything, 1).Return(productbatch.ProductBatch{ID: 1}, nil)
+		_, err := service.Update(context.TODO(), 1, productbatch.BatchUpdate{CurQuantity: &quantity})
+
+		assert.Equal(t, errors.New(productbatch.ERR_INVALID_QUANTITY), err)
+	})
+	t.Run("update_moves_section", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+		saved := productbatch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 1}
+		exp := productbatch.ProductBatch{1, 111, 200, 20, "2022-04-04", 10, "2020-04-04", 10, 5, 1, 4}
+		sectionID := 4
+		changes := productbatch.BatchUpdate{SectionID: &sectionID}
+
+		mockRepository.On("GetByID", mock.Anything, 1).Return(saved, nil)
+		ExpectCompatible(mockRepository, 4)
+		mockRepository.On("Update", mock.Anything, 1, changes).Return(exp, nil)
+		pb, err := service.Update(context.TODO(), 1, changes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, exp, pb)
+	})
+}
+
+func TestServiceDelete(t *testing.T) {
+	t.Run("delete_ok", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		mockRepository.On("GetByID", mock.Anything, 1).Return(productbatch.ProductBatch{ID: 1}, nil)
+		mockRepository.On("Delete", mock.Anything, 1).Return(nil)
+		err := service.Delete(context.TODO(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("delete_not_empty", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		mockRepository.On("GetByID", mock.Anything, 1).Return(productbatch.ProductBatch{ID: 1, CurQuantity: 3}, nil)
+		err := service.Delete(context.TODO(), 1)
+
+		assert.Equal(t, errors.New(productbatch.ERR_BATCH_NOT_EMPTY), err)
+	})
+}
+
+func TestServiceCompatibleSections(t *testing.T) {
+	t.Run("compatible_sections_ok", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		mockRepository.On("GetStorageProduct", mock.Anything, 1).Return(frozenProduct, nil)
+		mockRepository.On("StorageSections", mock.Anything).Return([]productbatch.StorageSection{freezer, chiller}, nil)
+		sections, err := service.CompatibleSections(context.TODO(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []productbatch.StorageSection{freezer}, sections)
+	})
+
+	t.Run("compatible_sections_product_not_found", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		mockRepository.On("GetStorageProduct", mock.Anything, 9).Return(productbatch.StorageProduct{}, fmt.Errorf(productbatch.ERR_PRODUCT_NOT_FOUND, 9))
+		sections, err := service.CompatibleSections(context.TODO(), 9)
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_PRODUCT_NOT_FOUND, 9), err)
+		assert.Equal(t, []productbatch.StorageSection{}, sections)
+	})
+}
+
+func TestServiceRecommend(t *testing.T) {
+	t.Run("recommend_ok", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		holding := freezer
+		holding.ID, holding.SectionNumber, holding.CurCapacity = 3, 30, 100
+		warmer := freezer
+		warmer.ID, warmer.SectionNumber, warmer.CurTemperature, warmer.CurCapacity = 2, 20, -15, 400
+		full := freezer
+		full.ID, full.SectionNumber, full.CurCapacity = 6, 50, 480
+
+		mockRepository.On("GetStorageProduct", mock.Anything, 1).Return(frozenProduct, nil)
+		mockRepository.On("WarehouseExists", mock.Anything, 1).Return(true, nil)
+		mockRepository.On("WarehouseSections", mock.Anything, 1).Return([]productbatch.StorageSection{
+			freezer, warmer, holding, chiller, full}, nil)
+		mockRepository.On("ProductSections", mock.Anything, 1).Return([]int{3, 5}, nil)
+		recommendations, err := service.Recommend(context.TODO(), productbatch.Slotting{ProductID: 1, Quantity: 50, WarehouseID: 1})
+
+		assert.NoError(t, err)
+		var ids []int
+		for _, rec := range recommendations {
+			ids = append(ids, rec.Section.ID)
+		}
+		assert.Equal(t, []int{3, 1, 2, 4, 6}, ids)
+		assert.Equal(t, productbatch.Recommendation{Section: holding, FreeCapacity: 400, Suitable: true, Score: 91,
+			TemperatureFit: 1, CapacityFit: 0.7, Proximity: 1}, recommendations[0])
+		assert.Equal(t, 59.0, recommendations[1].Score)
+		assert.Equal(t, 14.1, recommendations[2].Score)
+		assert.False(t, recommendations[3].Suitable)
+		assert.Len(t, recommendations[3].Reasons, 2)
+		assert.Equal(t, []string{"the section has 20 of free capacity and the batch needs 50"}, recommendations[4].Reasons)
+	})
+
+	t.Run("recommend_invalid_quantity", func(t *testing.T) {
+		service, _ := InitTestService(t)
+
+		_, err := service.Recommend(context.TODO(), productbatch.Slotting{ProductID: 1, Quantity: -5, WarehouseID: 1})
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_INVALID_QUANTITY), err)
+	})
+
+	t.Run("recommend_product_not_found", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		mockRepository.On("GetStorageProduct", mock.Anything, 9).Return(productbatch.StorageProduct{}, fmt.Errorf(productbatch.ERR_PRODUCT_NOT_FOUND, 9))
+		_, err := service.Recommend(context.TODO(), productbatch.Slotting{ProductID: 9, Quantity: 5, WarehouseID: 1})
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_PRODUCT_NOT_FOUND, 9), err)
+	})
+
+	t.Run("recommend_warehouse_not_found", func(t *testing.T) {
+		service, mockRepository := InitTestService(t)
+
+		mockRepository.On("GetStorageProduct", mock.Anything, 1).Return(frozenProduct, nil)
+		mockRepository.On("WarehouseExists", mock.Anything, 9).Return(false, nil)
+		_, err := service.Recommend(context.TODO(), productbatch.Slotting{ProductID: 1, Quantity: 5, WarehouseID: 9})
+
+		assert.Equal(t, fmt.Errorf(productbatch.ERR_WAREHOUSE_NOT_FOUND, 9), err)
+	})
+}
+
+func TestIncompatibilities(t *testing.T) {
+	assert.Empty(t, productbatch.Incompatibilities(frozenProduct, freezer))
+
+	warm := freezer
+	warm.CurTemperature = -10
+	warm.MinTemperature = -15
+	assert.Equal(t, []string{"the recommended freezing temperature of the product (-18.00) is out of the temperatures " +
+		"of the section (-15 to -10)"}, productbatch.Incompatibilities(frozenProduct, warm))
+}
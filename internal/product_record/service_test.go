@@ -110,7 +110,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_ok", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockProductRepository := mockProducts.NewRepository(t)
 		ProductService := products.NewService(
@@ -132,7 +132,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_inexistent_product", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockProductRepository := mockProducts.NewRepository(t)
 		ProductService := products.NewService(
@@ -150,7 +150,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_lower_last_update_time", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockProductRepository := mockProducts.NewRepository(t)
 		ProductService := products.NewService(
@@ -175,7 +175,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_error_parsing_time", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockProductRepository := mockProducts.NewRepository(t)
 		ProductService := products.NewService(
@@ -199,7 +199,7 @@ func TestStore(t *testing.T) {
 	t.Run("create_fail_to_save", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockProductRepository := mockProducts.NewRepository(t)
 		ProductService := products.NewService(
@@ -224,7 +224,7 @@ func TestGetById(t *testing.T) {
 	t.Run("find_by_id_existent", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockProductRepository := mockProducts.NewRepository(t)
 		ProductService := products.NewService(mockProductRepository, sellerService)
@@ -240,7 +240,7 @@ func TestGetById(t *testing.T) {
 	t.Run("find_by_id_non_existent", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockProductRepository := mockProducts.NewRepository(t)
 		ProductService := products.NewService(mockProductRepository, sellerService)
@@ -260,7 +260,7 @@ func TestGetAll(t *testing.T) {
 	t.Run("find_all", func(t *testing.T) {
 		mockSellerRepository := mockSeller.NewRepository(t)
 		mockLocalityRepository := mocksLocality.NewRepository(t)
-		localityService := locality.NewService(mockLocalityRepository)
+		localityService := locality.NewService(mockLocalityRepository, nil, nil)
 		sellerService := seller.NewService(mockSellerRepository, localityService)
 		mockProductRepository := mockProducts.NewRepository(t)
 		ProductService := products.NewService(mockProductRepository, sellerService)
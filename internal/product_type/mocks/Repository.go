@@ -0,0 +1,204 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	producttype "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_type"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// CountUsage provides a mock function with given fields: ctx, id
+func (_m *Repository) CountUsage(ctx context.Context, id int) (int, int, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, int) int); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Repository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *Repository) GetAll(ctx context.Context) ([]producttype.ProductType, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []producttype.ProductType
+	if rf, ok := ret.Get(0).(func(context.Context) []producttype.ProductType); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]producttype.ProductType)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *Repository) GetById(ctx context.Context, id int) (producttype.ProductType, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 producttype.ProductType
+	if rf, ok := ret.Get(0).(func(context.Context, int) producttype.ProductType); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(producttype.ProductType)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProducts provides a mock function with given fields: ctx, id
+func (_m *Repository) GetProducts(ctx context.Context, id int) ([]producttype.ReportProduct, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []producttype.ReportProduct
+	if rf, ok := ret.Get(0).(func(context.Context, int) []producttype.ReportProduct); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]producttype.ReportProduct)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSections provides a mock function with given fields: ctx, id
+func (_m *Repository) GetSections(ctx context.Context, id int) ([]producttype.ReportSection, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []producttype.ReportSection
+	if rf, ok := ret.Get(0).(func(context.Context, int) []producttype.ReportSection); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]producttype.ReportSection)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: ctx, pt
+func (_m *Repository) Store(ctx context.Context, pt producttype.ProductType) (producttype.ProductType, error) {
+	ret := _m.Called(ctx, pt)
+
+	var r0 producttype.ProductType
+	if rf, ok := ret.Get(0).(func(context.Context, producttype.ProductType) producttype.ProductType); ok {
+		r0 = rf(ctx, pt)
+	} else {
+		r0 = ret.Get(0).(producttype.ProductType)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, producttype.ProductType) error); ok {
+		r1 = rf(ctx, pt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, pt, id
+func (_m *Repository) Update(ctx context.Context, pt producttype.ProductType, id int) (producttype.ProductType, error) {
+	ret := _m.Called(ctx, pt, id)
+
+	var r0 producttype.ProductType
+	if rf, ok := ret.Get(0).(func(context.Context, producttype.ProductType, int) producttype.ProductType); ok {
+		r0 = rf(ctx, pt, id)
+	} else {
+		r0 = ret.Get(0).(producttype.ProductType)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, producttype.ProductType, int) error); ok {
+		r1 = rf(ctx, pt, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
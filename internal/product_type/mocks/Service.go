@@ -0,0 +1,151 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	producttype "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_type"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Service) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *Service) GetAll(ctx context.Context) ([]producttype.ProductType, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []producttype.ProductType
+	if rf, ok := ret.Get(0).(func(context.Context) []producttype.ProductType); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]producttype.ProductType)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *Service) GetById(ctx context.Context, id int) (producttype.ProductType, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 producttype.ProductType
+	if rf, ok := ret.Get(0).(func(context.Context, int) producttype.ProductType); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(producttype.ProductType)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReport provides a mock function with given fields: ctx, id
+func (_m *Service) GetReport(ctx context.Context, id int) (producttype.Report, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 producttype.Report
+	if rf, ok := ret.Get(0).(func(context.Context, int) producttype.Report); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(producttype.Report)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: ctx, pt
+func (_m *Service) Store(ctx context.Context, pt producttype.ProductType) (producttype.ProductType, error) {
+	ret := _m.Called(ctx, pt)
+
+	var r0 producttype.ProductType
+	if rf, ok := ret.Get(0).(func(context.Context, producttype.ProductType) producttype.ProductType); ok {
+		r0 = rf(ctx, pt)
+	} else {
+		r0 = ret.Get(0).(producttype.ProductType)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, producttype.ProductType) error); ok {
+		r1 = rf(ctx, pt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, pt, id
+func (_m *Service) Update(ctx context.Context, pt producttype.ProductType, id int) (producttype.ProductType, error) {
+	ret := _m.Called(ctx, pt, id)
+
+	var r0 producttype.ProductType
+	if rf, ok := ret.Get(0).(func(context.Context, producttype.ProductType, int) producttype.ProductType); ok {
+		r0 = rf(ctx, pt, id)
+	} else {
+		r0 = ret.Get(0).(producttype.ProductType)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, producttype.ProductType, int) error); ok {
+		r1 = rf(ctx, pt, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
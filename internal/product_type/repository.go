@@ -0,0 +1,214 @@
+package producttype
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const (
+	ERROR_PRODUCT_TYPE_NOT_FOUND = "product type %d not found"
+)
+
+const (
+	GETALL      = "SELECT id, description FROM product_types"
+	GETBYID     = "SELECT id, description FROM product_types WHERE id=?"
+	STORE       = "INSERT INTO product_types (description) VALUES (?)"
+	UPDATE      = "UPDATE product_types SET description=? WHERE id=?"
+	DELETE      = "DELETE FROM product_types WHERE id=?"
+	COUNT_USAGE = `SELECT
+				(SELECT COUNT(*) FROM products WHERE product_type_id = ?),
+				(SELECT COUNT(*) FROM section WHERE product_type_id = ?)`
+	GET_PRODUCTS = `SELECT id, product_code, description
+				FROM products WHERE product_type_id = ? ORDER BY id`
+	GET_SECTIONS = `SELECT id, section_number, warehouse_id
+				FROM section WHERE product_type_id = ? ORDER BY id`
+)
+
+type ProductType struct {
+	ID          int    `json:"id"`
+	Description string `json:"description" validate:"required"`
+}
+
+type ReportProduct struct {
+	ID          int    `json:"id"`
+	ProductCode string `json:"product_code"`
+	Description string `json:"description"`
+}
+
+type ReportSection struct {
+	ID            int `json:"id"`
+	SectionNumber int `json:"section_number"`
+	WarehouseID   int `json:"warehouse_id"`
+}
+
+type Report struct {
+	ID            int             `json:"id"`
+	Description   string          `json:"description"`
+	ProductsCount int             `json:"products_count"`
+	SectionsCount int             `json:"sections_count"`
+	Products      []ReportProduct `json:"products"`
+	Sections      []ReportSection `json:"sections"`
+}
+
+type Repository interface {
+	Store(ctx context.Context, pt ProductType) (ProductType, error)
+	GetAll(ctx context.Context) ([]ProductType, error)
+	GetById(ctx context.Context, id int) (ProductType, error)
+	Update(ctx context.Context, pt ProductType, id int) (ProductType, error)
+	Delete(ctx context.Context, id int) error
+	CountUsage(ctx context.Context, id int) (int, int, error)
+	GetProducts(ctx context.Context, id int) ([]ReportProduct, error)
+	GetSections(ctx context.Context, id int) ([]ReportSection, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Store(ctx context.Context, pt ProductType) (ProductType, error) {
+	stmt, err := r.db.PrepareContext(ctx, STORE)
+	if err != nil {
+		return ProductType{}, err
+	}
+	defer stmt.Close()
+	result, err := stmt.ExecContext(ctx, &pt.Description)
+	if err != nil {
+		return ProductType{}, err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ProductType{}, fmt.Errorf("fail to save")
+	}
+	lastId, _ := result.LastInsertId()
+	pt.ID = int(lastId)
+	return pt, nil
+}
+
+func (r *repository) GetAll(ctx context.Context) ([]ProductType, error) {
+	var pts []ProductType
+	rows, err := r.db.QueryContext(ctx, GETALL)
+	if err != nil {
+		return pts, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var pt ProductType
+		err := rows.Scan(&pt.ID, &pt.Description)
+		if err != nil {
+			return pts, err
+		}
+		pts = append(pts, pt)
+	}
+	return pts, nil
+}
+
+func (r *repository) GetById(ctx context.Context, id int) (ProductType, error) {
+	var pt ProductType
+	stmt, err := r.db.PrepareContext(ctx, GETBYID)
+	if err != nil {
+		return ProductType{}, err
+	}
+	defer stmt.Close()
+	err = stmt.QueryRowContext(ctx, id).Scan(&pt.ID, &pt.Description)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ProductType{}, fmt.Errorf(ERROR_PRODUCT_TYPE_NOT_FOUND, id)
+	}
+	if err != nil {
+		return ProductType{}, err
+	}
+	return pt, nil
+}
+
+func (r *repository) Update(ctx context.Context, pt ProductType, id int) (ProductType, error) {
+	stmt, err := r.db.PrepareContext(ctx, UPDATE)
+	if err != nil {
+		return ProductType{}, err
+	}
+	defer stmt.Close()
+	olderProductType, _ := r.GetById(ctx, id)
+	result, err := stmt.ExecContext(ctx, &pt.Description, id)
+	if err != nil {
+		return ProductType{}, err
+	}
+	pt.ID = id
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 && pt != olderProductType {
+		return ProductType{}, fmt.Errorf(ERROR_PRODUCT_TYPE_NOT_FOUND, id)
+	}
+	return pt, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id int) error {
+	stmt, err := r.db.PrepareContext(ctx, DELETE)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf(ERROR_PRODUCT_TYPE_NOT_FOUND, id)
+	}
+	return nil
+}
+
+// CountUsage returns how many products and sections reference the product
+// type, in this order.
+func (r *repository) CountUsage(ctx context.Context, id int) (int, int, error) {
+	var products, sections int
+	stmt, err := r.db.PrepareContext(ctx, COUNT_USAGE)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer stmt.Close()
+	err = stmt.QueryRowContext(ctx, id, id).Scan(&products, &sections)
+	if err != nil {
+		return 0, 0, err
+	}
+	return products, sections, nil
+}
+
+func (r *repository) GetProducts(ctx context.Context, id int) ([]ReportProduct, error) {
+	ps := []ReportProduct{}
+	rows, err := r.db.QueryContext(ctx, GET_PRODUCTS, id)
+	if err != nil {
+		return ps, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var prod ReportProduct
+		err := rows.Scan(&prod.ID, &prod.ProductCode, &prod.Description)
+		if err != nil {
+			return ps, err
+		}
+		ps = append(ps, prod)
+	}
+	return ps, nil
+}
+
+func (r *repository) GetSections(ctx context.Context, id int) ([]ReportSection, error) {
+	secs := []ReportSection{}
+	rows, err := r.db.QueryContext(ctx, GET_SECTIONS, id)
+	if err != nil {
+		return secs, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var sec ReportSection
+		err := rows.Scan(&sec.ID, &sec.SectionNumber, &sec.WarehouseID)
+		if err != nil {
+			return secs, err
+		}
+		secs = append(secs, sec)
+	}
+	return secs, nil
+}
@@ -0,0 +1,335 @@
+package producttype_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	producttype "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_type"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockRowsArray() *sqlmock.Rows {
+	pts := createProductTypesArray()
+	return sqlmock.NewRows([]string{"id", "description"}).
+		AddRow(pts[0].ID, pts[0].Description).
+		AddRow(pts[1].ID, pts[1].Description)
+}
+
+func TestRepositoryStore(t *testing.T) {
+	t.Run("create_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		pt := createProductTypesArray()[0]
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.STORE)).ExpectExec().
+			WithArgs(pt.Description).WillReturnResult(sqlmock.NewResult(1, 1))
+		repo := producttype.NewRepository(db)
+		result, err := repo.Store(context.Background(), producttype.ProductType{Description: pt.Description})
+		assert.NoError(t, err)
+		assert.Equal(t, pt, result)
+	})
+	t.Run("create_prepare_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		errPrepare := fmt.Errorf("fail to prepare")
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.STORE)).WillReturnError(errPrepare)
+		repo := producttype.NewRepository(db)
+		result, err := repo.Store(context.Background(), createProductTypesArray()[0])
+		assert.Equal(t, errPrepare, err)
+		assert.Equal(t, producttype.ProductType{}, result)
+	})
+	t.Run("create_fail_exec", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		errExec := fmt.Errorf("fail to exec")
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.STORE)).ExpectExec().WillReturnError(errExec)
+		repo := producttype.NewRepository(db)
+		result, err := repo.Store(context.Background(), createProductTypesArray()[0])
+		assert.Equal(t, errExec, err)
+		assert.Equal(t, producttype.ProductType{}, result)
+	})
+	t.Run("create_fail_to_save", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.STORE)).ExpectExec().
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		repo := producttype.NewRepository(db)
+		result, err := repo.Store(context.Background(), createProductTypesArray()[0])
+		assert.Equal(t, fmt.Errorf("fail to save"), err)
+		assert.Equal(t, producttype.ProductType{}, result)
+	})
+}
+
+func TestRepositoryGetAll(t *testing.T) {
+	t.Run("get_all_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(producttype.GETALL)).WillReturnRows(mockRowsArray())
+		repo := producttype.NewRepository(db)
+		result, err := repo.GetAll(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, createProductTypesArray(), result)
+	})
+	t.Run("get_all_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(producttype.GETALL)).WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		_, err = repo.GetAll(context.Background())
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+	t.Run("get_all_scan_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"id", "description"}).AddRow("one", "frios")
+		mock.ExpectQuery(regexp.QuoteMeta(producttype.GETALL)).WillReturnRows(rows)
+		repo := producttype.NewRepository(db)
+		_, err = repo.GetAll(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryGetById(t *testing.T) {
+	t.Run("get_by_id_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		pt := createProductTypesArray()[0]
+		rows := sqlmock.NewRows([]string{"id", "description"}).AddRow(pt.ID, pt.Description)
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.GETBYID)).ExpectQuery().WithArgs(1).WillReturnRows(rows)
+		repo := producttype.NewRepository(db)
+		result, err := repo.GetById(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, pt, result)
+	})
+	t.Run("get_by_id_non_existent", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.GETBYID)).ExpectQuery().WithArgs(9).WillReturnError(sql.ErrNoRows)
+		repo := producttype.NewRepository(db)
+		result, err := repo.GetById(context.Background(), 9)
+		assert.Equal(t, fmt.Errorf("product type 9 not found"), err)
+		assert.Equal(t, producttype.ProductType{}, result)
+	})
+	t.Run("get_by_id_prepare_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.GETBYID)).WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		_, err = repo.GetById(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+	t.Run("get_by_id_query_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.GETBYID)).ExpectQuery().WithArgs(1).WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		_, err = repo.GetById(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	t.Run("update_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		pt := producttype.ProductType{ID: 1, Description: "congelados"}
+		stmt := mock.ExpectPrepare(regexp.QuoteMeta(producttype.UPDATE))
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.GETBYID)).ExpectQuery().WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "description"}).AddRow(1, "frios"))
+		stmt.ExpectExec().WithArgs(pt.Description, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		repo := producttype.NewRepository(db)
+		result, err := repo.Update(context.Background(), producttype.ProductType{Description: pt.Description}, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, pt, result)
+	})
+	t.Run("update_non_existent", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		stmt := mock.ExpectPrepare(regexp.QuoteMeta(producttype.UPDATE))
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.GETBYID)).ExpectQuery().WithArgs(9).WillReturnError(sql.ErrNoRows)
+		stmt.ExpectExec().WithArgs("congelados", 9).WillReturnResult(sqlmock.NewResult(0, 0))
+		repo := producttype.NewRepository(db)
+		result, err := repo.Update(context.Background(), producttype.ProductType{Description: "congelados"}, 9)
+		assert.Equal(t, fmt.Errorf("product type 9 not found"), err)
+		assert.Equal(t, producttype.ProductType{}, result)
+	})
+	t.Run("update_prepare_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.UPDATE)).WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		_, err = repo.Update(context.Background(), producttype.ProductType{Description: "congelados"}, 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+	t.Run("update_fail_exec", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		stmt := mock.ExpectPrepare(regexp.QuoteMeta(producttype.UPDATE))
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.GETBYID)).ExpectQuery().WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "description"}).AddRow(1, "frios"))
+		stmt.ExpectExec().WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		_, err = repo.Update(context.Background(), producttype.ProductType{Description: "congelados"}, 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	t.Run("delete_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.DELETE)).ExpectExec().WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		repo := producttype.NewRepository(db)
+		err = repo.Delete(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+	t.Run("delete_non_existent", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.DELETE)).ExpectExec().WithArgs(9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		repo := producttype.NewRepository(db)
+		err = repo.Delete(context.Background(), 9)
+		assert.Equal(t, fmt.Errorf("product type 9 not found"), err)
+	})
+	t.Run("delete_prepare_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.DELETE)).WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		err = repo.Delete(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+	t.Run("delete_fail_exec", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.DELETE)).ExpectExec().WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		err = repo.Delete(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+}
+
+func TestRepositoryCountUsage(t *testing.T) {
+	t.Run("count_usage_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"products", "sections"}).AddRow(3, 1)
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.COUNT_USAGE)).ExpectQuery().WithArgs(1, 1).WillReturnRows(rows)
+		repo := producttype.NewRepository(db)
+		products, sections, err := repo.CountUsage(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, products)
+		assert.Equal(t, 1, sections)
+	})
+	t.Run("count_usage_prepare_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.COUNT_USAGE)).WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		_, _, err = repo.CountUsage(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+	t.Run("count_usage_query_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectPrepare(regexp.QuoteMeta(producttype.COUNT_USAGE)).ExpectQuery().WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		_, _, err = repo.CountUsage(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+}
+
+func TestRepositoryGetProducts(t *testing.T) {
+	t.Run("get_products_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"id", "product_code", "description"}).
+			AddRow(1, "01", "leite").AddRow(2, "02", "queijo")
+		mock.ExpectQuery(regexp.QuoteMeta(producttype.GET_PRODUCTS)).WithArgs(1).WillReturnRows(rows)
+		repo := producttype.NewRepository(db)
+		result, err := repo.GetProducts(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []producttype.ReportProduct{{1, "01", "leite"}, {2, "02", "queijo"}}, result)
+	})
+	t.Run("get_products_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(producttype.GET_PRODUCTS)).WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		_, err = repo.GetProducts(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+	t.Run("get_products_scan_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"id", "product_code", "description"}).AddRow("one", "01", "leite")
+		mock.ExpectQuery(regexp.QuoteMeta(producttype.GET_PRODUCTS)).WillReturnRows(rows)
+		repo := producttype.NewRepository(db)
+		_, err = repo.GetProducts(context.Background(), 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryGetSections(t *testing.T) {
+	t.Run("get_sections_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"id", "section_number", "warehouse_id"}).AddRow(1, 40, 2)
+		mock.ExpectQuery(regexp.QuoteMeta(producttype.GET_SECTIONS)).WithArgs(1).WillReturnRows(rows)
+		repo := producttype.NewRepository(db)
+		result, err := repo.GetSections(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []producttype.ReportSection{{1, 40, 2}}, result)
+	})
+	t.Run("get_sections_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(producttype.GET_SECTIONS)).WillReturnError(sql.ErrConnDone)
+		repo := producttype.NewRepository(db)
+		_, err = repo.GetSections(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+	t.Run("get_sections_scan_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"id", "section_number", "warehouse_id"}).AddRow("one", 40, 2)
+		mock.ExpectQuery(regexp.QuoteMeta(producttype.GET_SECTIONS)).WillReturnRows(rows)
+		repo := producttype.NewRepository(db)
+		_, err = repo.GetSections(context.Background(), 1)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,96 @@
+package producttype
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	ERROR_PRODUCT_TYPE_IN_USE = "the product type is in use by products or sections"
+)
+
+type Service interface {
+	Store(ctx context.Context, pt ProductType) (ProductType, error)
+	GetAll(ctx context.Context) ([]ProductType, error)
+	GetById(ctx context.Context, id int) (ProductType, error)
+	Update(ctx context.Context, pt ProductType, id int) (ProductType, error)
+	Delete(ctx context.Context, id int) error
+	GetReport(ctx context.Context, id int) (Report, error)
+}
+
+type service struct {
+	repository Repository
+}
+
+func NewService(r Repository) Service {
+	return &service{repository: r}
+}
+
+func (s *service) Store(ctx context.Context, pt ProductType) (ProductType, error) {
+	productType, err := s.repository.Store(ctx, pt)
+	if err != nil {
+		return ProductType{}, err
+	}
+	return productType, nil
+}
+
+func (s *service) GetAll(ctx context.Context) ([]ProductType, error) {
+	pts, err := s.repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pts, nil
+}
+
+func (s *service) GetById(ctx context.Context, id int) (ProductType, error) {
+	pt, err := s.repository.GetById(ctx, id)
+	if err != nil {
+		return ProductType{}, err
+	}
+	return pt, nil
+}
+
+func (s *service) Update(ctx context.Context, pt ProductType, id int) (ProductType, error) {
+	productType, err := s.repository.Update(ctx, pt, id)
+	if err != nil {
+		return ProductType{}, err
+	}
+	return productType, nil
+}
+
+func (s *service) Delete(ctx context.Context, id int) error {
+	if _, err := s.repository.GetById(ctx, id); err != nil {
+		return err
+	}
+	products, sections, err := s.repository.CountUsage(ctx, id)
+	if err != nil {
+		return err
+	}
+	if products > 0 || sections > 0 {
+		return fmt.Errorf(ERROR_PRODUCT_TYPE_IN_USE)
+	}
+	return s.repository.Delete(ctx, id)
+}
+
+func (s *service) GetReport(ctx context.Context, id int) (Report, error) {
+	pt, err := s.repository.GetById(ctx, id)
+	if err != nil {
+		return Report{}, err
+	}
+	products, err := s.repository.GetProducts(ctx, id)
+	if err != nil {
+		return Report{}, err
+	}
+	sections, err := s.repository.GetSections(ctx, id)
+	if err != nil {
+		return Report{}, err
+	}
+	return Report{
+		ID:            pt.ID,
+		Description:   pt.Description,
+		ProductsCount: len(products),
+		SectionsCount: len(sections),
+		Products:      products,
+		Sections:      sections,
+	}, nil
+}
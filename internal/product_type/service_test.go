@@ -0,0 +1,181 @@
+package producttype_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	producttype "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_type"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_type/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createProductTypesArray() []producttype.ProductType {
+	return []producttype.ProductType{
+		{ID: 1, Description: "frios"},
+		{ID: 2, Description: "congelados"},
+	}
+}
+
+func TestStore(t *testing.T) {
+	t.Run("create_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		pt := createProductTypesArray()[0]
+		mockRepository.On("Store", context.Background(), producttype.ProductType{Description: pt.Description}).Return(pt, nil)
+		result, err := service.Store(context.Background(), producttype.ProductType{Description: pt.Description})
+		assert.NoError(t, err)
+		assert.Equal(t, pt, result)
+	})
+	t.Run("create_fail_to_save", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		pt := createProductTypesArray()[0]
+		mockRepository.On("Store", context.Background(), pt).Return(producttype.ProductType{}, fmt.Errorf("fail to save"))
+		result, err := service.Store(context.Background(), pt)
+		assert.Equal(t, fmt.Errorf("fail to save"), err)
+		assert.Equal(t, producttype.ProductType{}, result)
+	})
+}
+
+func TestGetAll(t *testing.T) {
+	t.Run("get_all_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetAll", context.Background()).Return(createProductTypesArray(), nil)
+		result, err := service.GetAll(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, createProductTypesArray(), result)
+	})
+	t.Run("get_all_fail", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetAll", context.Background()).Return(nil, fmt.Errorf("fail"))
+		result, err := service.GetAll(context.Background())
+		assert.Equal(t, fmt.Errorf("fail"), err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestGetById(t *testing.T) {
+	t.Run("get_by_id_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		pt := createProductTypesArray()[0]
+		mockRepository.On("GetById", context.Background(), 1).Return(pt, nil)
+		result, err := service.GetById(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, pt, result)
+	})
+	t.Run("get_by_id_non_existent", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 9).Return(producttype.ProductType{}, fmt.Errorf("product type 9 not found"))
+		_, err := service.GetById(context.Background(), 9)
+		assert.Equal(t, fmt.Errorf("product type 9 not found"), err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("update_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		pt := producttype.ProductType{ID: 1, Description: "resfriados"}
+		mockRepository.On("Update", context.Background(), pt, 1).Return(pt, nil)
+		result, err := service.Update(context.Background(), pt, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, pt, result)
+	})
+	t.Run("update_non_existent", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		pt := producttype.ProductType{ID: 9, Description: "resfriados"}
+		mockRepository.On("Update", context.Background(), pt, 9).Return(producttype.ProductType{}, fmt.Errorf("product type 9 not found"))
+		_, err := service.Update(context.Background(), pt, 9)
+		assert.Equal(t, fmt.Errorf("product type 9 not found"), err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("delete_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 1).Return(createProductTypesArray()[0], nil)
+		mockRepository.On("CountUsage", context.Background(), 1).Return(0, 0, nil)
+		mockRepository.On("Delete", context.Background(), 1).Return(nil)
+		err := service.Delete(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+	t.Run("delete_non_existent", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 9).Return(producttype.ProductType{}, fmt.Errorf("product type 9 not found"))
+		err := service.Delete(context.Background(), 9)
+		assert.Equal(t, fmt.Errorf("product type 9 not found"), err)
+	})
+	t.Run("delete_used_by_products", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 1).Return(createProductTypesArray()[0], nil)
+		mockRepository.On("CountUsage", context.Background(), 1).Return(2, 0, nil)
+		err := service.Delete(context.Background(), 1)
+		assert.Equal(t, fmt.Errorf(producttype.ERROR_PRODUCT_TYPE_IN_USE), err)
+	})
+	t.Run("delete_used_by_sections", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 1).Return(createProductTypesArray()[0], nil)
+		mockRepository.On("CountUsage", context.Background(), 1).Return(0, 1, nil)
+		err := service.Delete(context.Background(), 1)
+		assert.Equal(t, fmt.Errorf(producttype.ERROR_PRODUCT_TYPE_IN_USE), err)
+	})
+	t.Run("delete_count_fail", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 1).Return(createProductTypesArray()[0], nil)
+		mockRepository.On("CountUsage", context.Background(), 1).Return(0, 0, fmt.Errorf("fail to count"))
+		err := service.Delete(context.Background(), 1)
+		assert.Equal(t, fmt.Errorf("fail to count"), err)
+	})
+}
+
+func TestGetReport(t *testing.T) {
+	products := []producttype.ReportProduct{{ID: 1, ProductCode: "01", Description: "leite"}}
+	sections := []producttype.ReportSection{{ID: 1, SectionNumber: 40, WarehouseID: 1}, {ID: 2, SectionNumber: 41, WarehouseID: 1}}
+	t.Run("report_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 1).Return(createProductTypesArray()[0], nil)
+		mockRepository.On("GetProducts", context.Background(), 1).Return(products, nil)
+		mockRepository.On("GetSections", context.Background(), 1).Return(sections, nil)
+		result, err := service.GetReport(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, producttype.Report{ID: 1, Description: "frios", ProductsCount: 1, SectionsCount: 2,
+			Products: products, Sections: sections}, result)
+	})
+	t.Run("report_non_existent", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 9).Return(producttype.ProductType{}, fmt.Errorf("product type 9 not found"))
+		_, err := service.GetReport(context.Background(), 9)
+		assert.Equal(t, fmt.Errorf("product type 9 not found"), err)
+	})
+	t.Run("report_products_fail", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 1).Return(createProductTypesArray()[0], nil)
+		mockRepository.On("GetProducts", context.Background(), 1).Return([]producttype.ReportProduct{}, fmt.Errorf("fail"))
+		_, err := service.GetReport(context.Background(), 1)
+		assert.Equal(t, fmt.Errorf("fail"), err)
+	})
+	t.Run("report_sections_fail", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := producttype.NewService(mockRepository)
+		mockRepository.On("GetById", context.Background(), 1).Return(createProductTypesArray()[0], nil)
+		mockRepository.On("GetProducts", context.Background(), 1).Return(products, nil)
+		mockRepository.On("GetSections", context.Background(), 1).Return([]producttype.ReportSection{}, fmt.Errorf("fail"))
+		_, err := service.GetReport(context.Background(), 1)
+		assert.Equal(t, fmt.Errorf("fail"), err)
+	})
+}
@@ -0,0 +1,130 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	province "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, provinceName, countryID
+func (_m *Service) Create(ctx context.Context, provinceName string, countryID int) (province.Province, error) {
+	ret := _m.Called(ctx, provinceName, countryID)
+
+	var r0 province.Province
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) province.Province); ok {
+		r0 = rf(ctx, provinceName, countryID)
+	} else {
+		r0 = ret.Get(0).(province.Province)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, provinceName, countryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *Service) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *Service) GetAll(ctx context.Context) ([]province.Province, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []province.Province
+	if rf, ok := ret.Get(0).(func(context.Context) []province.Province); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]province.Province)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *Service) GetById(ctx context.Context, id int) (province.Province, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 province.Province
+	if rf, ok := ret.Get(0).(func(context.Context, int) province.Province); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(province.Province)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, provinceName, countryID
+func (_m *Service) Update(ctx context.Context, id int, provinceName string, countryID int) (province.Province, error) {
+	ret := _m.Called(ctx, id, provinceName, countryID)
+
+	var r0 province.Province
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, int) province.Province); ok {
+		r0 = rf(ctx, id, provinceName, countryID)
+	} else {
+		r0 = ret.Get(0).(province.Province)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, int) error); ok {
+		r1 = rf(ctx, id, provinceName, countryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
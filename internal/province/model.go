@@ -0,0 +1,8 @@
+package province
+
+type Province struct {
+	Id           int    `json:"id"`
+	ProvinceName string `json:"province_name"`
+	CountryID    int    `json:"country_id"`
+	CountryName  string `json:"country_name"`
+}
@@ -0,0 +1,149 @@
+package province
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type Repository interface {
+	GetAll(ctx context.Context) ([]Province, error)
+	GetById(ctx context.Context, id int) (Province, error)
+	Create(ctx context.Context, provinceName string, countryID int) (Province, error)
+	Update(ctx context.Context, id int, provinceName string, countryID int) (Province, error)
+	Delete(ctx context.Context, id int) error
+	CountLocalities(ctx context.Context, id int) (int, error)
+	CountCoverage(ctx context.Context, id int) (int, error)
+}
+
+const (
+	GETALL = `SELECT p.id, p.province_name, c.id, c.country_name FROM provinces p
+				JOIN countries c ON c.id = p.id_country`
+	GETBYID          = GETALL + " WHERE p.id = ?"
+	INSERT           = "INSERT INTO provinces (province_name, id_country) VALUES (?, ?)"
+	UPDATE           = "UPDATE provinces SET province_name = ?, id_country = ? WHERE id = ?"
+	DELETE           = "DELETE FROM provinces WHERE id = ?"
+	COUNT_LOCALITIES = "SELECT COUNT(*) FROM localities WHERE province_id = ?"
+	COUNT_COVERAGE   = "SELECT COUNT(*) FROM carrier_coverage WHERE province_id = ?"
+)
+
+type mariaDBRepository struct {
+	db *sql.DB
+}
+
+func NewMariaDBRepository(db *sql.DB) Repository {
+	return &mariaDBRepository{db: db}
+}
+
+func (m mariaDBRepository) GetAll(ctx context.Context) ([]Province, error) {
+	var provinceList []Province
+
+	rows, err := m.db.QueryContext(ctx, GETALL)
+
+	if err != nil {
+		return provinceList, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var province Province
+
+		err = rows.Scan(&province.Id, &province.ProvinceName, &province.CountryID, &province.CountryName)
+
+		if err != nil {
+			return provinceList, err
+		}
+
+		provinceList = append(provinceList, province)
+	}
+
+	return provinceList, nil
+}
+
+func (m mariaDBRepository) GetById(ctx context.Context, id int) (Province, error) {
+	var province Province
+
+	err := m.db.QueryRowContext(ctx, GETBYID, id).
+		Scan(&province.Id, &province.ProvinceName, &province.CountryID, &province.CountryName)
+
+	if err == sql.ErrNoRows {
+		return Province{}, fmt.Errorf(ERR_PROVINCE_NOT_FOUND)
+	}
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	return province, nil
+}
+
+func (m mariaDBRepository) Create(ctx context.Context, provinceName string, countryID int) (Province, error) {
+	province := Province{ProvinceName: provinceName, CountryID: countryID}
+
+	res, err := m.db.ExecContext(ctx, INSERT, &province.ProvinceName, &province.CountryID)
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	lastID, err := res.LastInsertId()
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	province.Id = int(lastID)
+
+	return province, nil
+}
+
+func (m mariaDBRepository) Update(ctx context.Context, id int, provinceName string, countryID int) (Province, error) {
+	_, err := m.db.ExecContext(ctx, UPDATE, provinceName, countryID, id)
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	return Province{Id: id, ProvinceName: provinceName, CountryID: countryID}, nil
+}
+
+func (m mariaDBRepository) Delete(ctx context.Context, id int) error {
+	res, err := m.db.ExecContext(ctx, DELETE, id)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+
+	if rowsAffected == 0 {
+		return fmt.Errorf(ERR_PROVINCE_NOT_FOUND)
+	}
+
+	return nil
+}
+
+func (m mariaDBRepository) CountLocalities(ctx context.Context, id int) (int, error) {
+	var count int
+
+	err := m.db.QueryRowContext(ctx, COUNT_LOCALITIES, id).Scan(&count)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (m mariaDBRepository) CountCoverage(ctx context.Context, id int) (int, error) {
+	var count int
+
+	err := m.db.QueryRowContext(ctx, COUNT_COVERAGE, id).Scan(&count)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,263 @@
+package province_test
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+var provinceColumns = []string{"id", "province_name", "country_id", "country_name"}
+
+func TestRepository_GetAll(t *testing.T) {
+	t.Run("Deve retornar lista de provinces com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expected := []province.Province{
+			{Id: 1, ProvinceName: "SP", CountryID: 1, CountryName: "Brasil"},
+			{Id: 2, ProvinceName: "RJ", CountryID: 1, CountryName: "Brasil"},
+		}
+
+		rows := sqlmock.NewRows(provinceColumns).
+			AddRow(expected[0].Id, expected[0].ProvinceName, expected[0].CountryID, expected[0].CountryName).
+			AddRow(expected[1].Id, expected[1].ProvinceName, expected[1].CountryID, expected[1].CountryName)
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.GETALL)).WillReturnRows(rows)
+
+		result, err := province.NewMariaDBRepository(db).GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Deve retornar erro no Scan", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows(provinceColumns).AddRow("", "", "", "")
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.GETALL)).WillReturnRows(rows)
+
+		_, err = province.NewMariaDBRepository(db).GetAll(context.Background())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Deve retornar erro ao realizar o select", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.GETALL)).WillReturnError(fmt.Errorf("error"))
+
+		_, err = province.NewMariaDBRepository(db).GetAll(context.Background())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_GetById(t *testing.T) {
+	t.Run("Deve retornar a province com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows(provinceColumns).AddRow(1, "SP", 1, "Brasil")
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.GETBYID)).WithArgs(1).WillReturnRows(rows)
+
+		result, err := province.NewMariaDBRepository(db).GetById(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, province.Province{Id: 1, ProvinceName: "SP", CountryID: 1, CountryName: "Brasil"}, result)
+	})
+
+	t.Run("Deve retornar erro quando o id não existir", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.GETBYID)).WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+		_, err = province.NewMariaDBRepository(db).GetById(context.Background(), 2)
+
+		assert.EqualError(t, err, province.ERR_PROVINCE_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro ao realizar o select", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.GETBYID)).WithArgs(2).WillReturnError(fmt.Errorf("error"))
+
+		_, err = province.NewMariaDBRepository(db).GetById(context.Background(), 2)
+
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("Deve criar uma province com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(province.INSERT)).WithArgs("SP", 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		result, err := province.NewMariaDBRepository(db).Create(context.Background(), "SP", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, province.Province{Id: 1, ProvinceName: "SP", CountryID: 1}, result)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(province.INSERT)).WithArgs("SP", 1).
+			WillReturnError(fmt.Errorf("error"))
+
+		_, err = province.NewMariaDBRepository(db).Create(context.Background(), "SP", 1)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	t.Run("Deve atualizar a province com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(province.UPDATE)).WithArgs("São Paulo", 1, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		result, err := province.NewMariaDBRepository(db).Update(context.Background(), 1, "São Paulo", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, province.Province{Id: 1, ProvinceName: "São Paulo", CountryID: 1}, result)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(province.UPDATE)).WithArgs("São Paulo", 1, 1).
+			WillReturnError(fmt.Errorf("error"))
+
+		_, err = province.NewMariaDBRepository(db).Update(context.Background(), 1, "São Paulo", 1)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_Delete(t *testing.T) {
+	t.Run("Deve remover a province com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(province.DELETE)).WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = province.NewMariaDBRepository(db).Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Deve retornar erro quando o id não existir", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(province.DELETE)).WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = province.NewMariaDBRepository(db).Delete(context.Background(), 2)
+
+		assert.EqualError(t, err, province.ERR_PROVINCE_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(province.DELETE)).WithArgs(1).
+			WillReturnError(fmt.Errorf("error"))
+
+		err = province.NewMariaDBRepository(db).Delete(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_CountLocalities(t *testing.T) {
+	t.Run("Deve retornar a quantidade de localities", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"count"}).AddRow(4)
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.COUNT_LOCALITIES)).WithArgs(1).WillReturnRows(rows)
+
+		result, err := province.NewMariaDBRepository(db).CountLocalities(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, result)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.COUNT_LOCALITIES)).WithArgs(1).WillReturnError(fmt.Errorf("error"))
+
+		_, err = province.NewMariaDBRepository(db).CountLocalities(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_CountCoverage(t *testing.T) {
+	t.Run("Deve retornar a quantidade de coberturas", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.COUNT_COVERAGE)).WithArgs(1).WillReturnRows(rows)
+
+		result, err := province.NewMariaDBRepository(db).CountCoverage(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("Deve retornar erro ao executar a query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(province.COUNT_COVERAGE)).WithArgs(1).WillReturnError(fmt.Errorf("error"))
+
+		_, err = province.NewMariaDBRepository(db).CountCoverage(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+}
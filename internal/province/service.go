@@ -0,0 +1,135 @@
+package province
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+)
+
+const (
+	ERR_PROVINCE_NOT_FOUND   = "province does not exists"
+	ERR_UNIQUE_PROVINCE_NAME = "province_name already exists in this country"
+	ERR_PROVINCE_IN_USE      = "province has localities"
+	ERR_PROVINCE_COVERED     = "province is covered by carriers"
+)
+
+type Service interface {
+	GetAll(ctx context.Context) ([]Province, error)
+	GetById(ctx context.Context, id int) (Province, error)
+	Create(ctx context.Context, provinceName string, countryID int) (Province, error)
+	Update(ctx context.Context, id int, provinceName string, countryID int) (Province, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type service struct {
+	repository     Repository
+	countryService country.Service
+}
+
+func NewService(r Repository, countryService country.Service) Service {
+	return &service{repository: r, countryService: countryService}
+}
+
+func (s service) GetAll(ctx context.Context) ([]Province, error) {
+	return s.repository.GetAll(ctx)
+}
+
+func (s service) GetById(ctx context.Context, id int) (Province, error) {
+	return s.repository.GetById(ctx, id)
+}
+
+func (s service) Create(ctx context.Context, provinceName string, countryID int) (Province, error) {
+	c, err := s.countryService.GetById(ctx, countryID)
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	err = s.provinceNameExists(ctx, 0, provinceName, countryID)
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	province, err := s.repository.Create(ctx, provinceName, countryID)
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	province.CountryName = c.CountryName
+
+	return province, nil
+}
+
+func (s service) Update(ctx context.Context, id int, provinceName string, countryID int) (Province, error) {
+	_, err := s.repository.GetById(ctx, id)
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	c, err := s.countryService.GetById(ctx, countryID)
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	err = s.provinceNameExists(ctx, id, provinceName, countryID)
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	province, err := s.repository.Update(ctx, id, provinceName, countryID)
+
+	if err != nil {
+		return Province{}, err
+	}
+
+	province.CountryName = c.CountryName
+
+	return province, nil
+}
+
+func (s service) Delete(ctx context.Context, id int) error {
+	localities, err := s.repository.CountLocalities(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if localities > 0 {
+		return fmt.Errorf(ERR_PROVINCE_IN_USE)
+	}
+
+	coverage, err := s.repository.CountCoverage(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if coverage > 0 {
+		return fmt.Errorf(ERR_PROVINCE_COVERED)
+	}
+
+	return s.repository.Delete(ctx, id)
+}
+
+func (s service) provinceNameExists(ctx context.Context, id int, provinceName string, countryID int) error {
+	provinces, err := s.repository.GetAll(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range provinces {
+		if provinces[i].Id != id && provinces[i].CountryID == countryID &&
+			strings.EqualFold(provinces[i].ProvinceName, provinceName) {
+			return fmt.Errorf(ERR_UNIQUE_PROVINCE_NAME)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package province_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country"
+	countryMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/country/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/province/mocks"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+var (
+	brasil       = country.Country{Id: 1, CountryName: "Brasil"}
+	provinceList = []province.Province{
+		{Id: 1, ProvinceName: "SP", CountryID: 1, CountryName: "Brasil"},
+		{Id: 2, ProvinceName: "RJ", CountryID: 1, CountryName: "Brasil"},
+		{Id: 3, ProvinceName: "Buenos Aires", CountryID: 2, CountryName: "Argentina"},
+	}
+)
+
+func TestService_GetAll(t *testing.T) {
+	t.Run("Deve retornar a lista de provinces", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetAll", context.Background()).Return(provinceList, nil)
+
+		result, err := province.NewService(mockRepo, nil).GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, provinceList, result)
+	})
+}
+
+func TestService_GetById(t *testing.T) {
+	t.Run("Deve retornar a province", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetById", context.Background(), 1).Return(provinceList[0], nil)
+
+		result, err := province.NewService(mockRepo, nil).GetById(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, provinceList[0], result)
+	})
+}
+
+func TestService_Create(t *testing.T) {
+	t.Run("Deve criar uma province com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockCountryService := countryMocks.NewService(t)
+
+		mockCountryService.On("GetById", context.Background(), 1).Return(brasil, nil)
+		mockRepo.On("GetAll", context.Background()).Return(provinceList, nil)
+		mockRepo.On("Create", context.Background(), "MG", 1).
+			Return(province.Province{Id: 4, ProvinceName: "MG", CountryID: 1}, nil)
+
+		result, err := province.NewService(mockRepo, mockCountryService).Create(context.Background(), "MG", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, province.Province{Id: 4, ProvinceName: "MG", CountryID: 1, CountryName: "Brasil"}, result)
+	})
+
+	t.Run("Deve permitir o mesmo nome em outro country", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockCountryService := countryMocks.NewService(t)
+
+		mockCountryService.On("GetById", context.Background(), 1).Return(brasil, nil)
+		mockRepo.On("GetAll", context.Background()).Return(provinceList, nil)
+		mockRepo.On("Create", context.Background(), "Buenos Aires", 1).
+			Return(province.Province{Id: 4, ProvinceName: "Buenos Aires", CountryID: 1}, nil)
+
+		_, err := province.NewService(mockRepo, mockCountryService).Create(context.Background(), "Buenos Aires", 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Deve retornar erro quando o country não existir", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockCountryService := countryMocks.NewService(t)
+
+		mockCountryService.On("GetById", context.Background(), 9).
+			Return(country.Country{}, fmt.Errorf(country.ERR_COUNTRY_NOT_FOUND))
+
+		_, err := province.NewService(mockRepo, mockCountryService).Create(context.Background(), "MG", 9)
+
+		assert.EqualError(t, err, country.ERR_COUNTRY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro quando o province_name já existir no country", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockCountryService := countryMocks.NewService(t)
+
+		mockCountryService.On("GetById", context.Background(), 1).Return(brasil, nil)
+		mockRepo.On("GetAll", context.Background()).Return(provinceList, nil)
+
+		_, err := province.NewService(mockRepo, mockCountryService).Create(context.Background(), "sp", 1)
+
+		assert.EqualError(t, err, province.ERR_UNIQUE_PROVINCE_NAME)
+	})
+
+	t.Run("Deve retornar erro ao salvar a province", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockCountryService := countryMocks.NewService(t)
+
+		mockCountryService.On("GetById", context.Background(), 1).Return(brasil, nil)
+		mockRepo.On("GetAll", context.Background()).Return(provinceList, nil)
+		mockRepo.On("Create", context.Background(), "MG", 1).Return(province.Province{}, fmt.Errorf("error"))
+
+		_, err := province.NewService(mockRepo, mockCountryService).Create(context.Background(), "MG", 1)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	t.Run("Deve atualizar a province com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockCountryService := countryMocks.NewService(t)
+
+		mockRepo.On("GetById", context.Background(), 1).Return(provinceList[0], nil)
+		mockCountryService.On("GetById", context.Background(), 1).Return(brasil, nil)
+		mockRepo.On("GetAll", context.Background()).Return(provinceList, nil)
+		mockRepo.On("Update", context.Background(), 1, "São Paulo", 1).
+			Return(province.Province{Id: 1, ProvinceName: "São Paulo", CountryID: 1}, nil)
+
+		result, err := province.NewService(mockRepo, mockCountryService).Update(context.Background(), 1, "São Paulo", 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, province.Province{Id: 1, ProvinceName: "São Paulo", CountryID: 1, CountryName: "Brasil"}, result)
+	})
+
+	t.Run("Deve retornar erro quando a province não existir", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+
+		mockRepo.On("GetById", context.Background(), 9).
+			Return(province.Province{}, fmt.Errorf(province.ERR_PROVINCE_NOT_FOUND))
+
+		_, err := province.NewService(mockRepo, nil).Update(context.Background(), 9, "São Paulo", 1)
+
+		assert.EqualError(t, err, province.ERR_PROVINCE_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro quando o country não existir", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockCountryService := countryMocks.NewService(t)
+
+		mockRepo.On("GetById", context.Background(), 1).Return(provinceList[0], nil)
+		mockCountryService.On("GetById", context.Background(), 9).
+			Return(country.Country{}, fmt.Errorf(country.ERR_COUNTRY_NOT_FOUND))
+
+		_, err := province.NewService(mockRepo, mockCountryService).Update(context.Background(), 1, "SP", 9)
+
+		assert.EqualError(t, err, country.ERR_COUNTRY_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro quando o province_name pertencer a outra province", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockCountryService := countryMocks.NewService(t)
+
+		mockRepo.On("GetById", context.Background(), 1).Return(provinceList[0], nil)
+		mockCountryService.On("GetById", context.Background(), 1).Return(brasil, nil)
+		mockRepo.On("GetAll", context.Background()).Return(provinceList, nil)
+
+		_, err := province.NewService(mockRepo, mockCountryService).Update(context.Background(), 1, "RJ", 1)
+
+		assert.EqualError(t, err, province.ERR_UNIQUE_PROVINCE_NAME)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Run("Deve remover a province com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("CountLocalities", context.Background(), 1).Return(0, nil)
+		mockRepo.On("CountCoverage", context.Background(), 1).Return(0, nil)
+		mockRepo.On("Delete", context.Background(), 1).Return(nil)
+
+		err := province.NewService(mockRepo, nil).Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Deve retornar erro quando a province possuir localities", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("CountLocalities", context.Background(), 1).Return(5, nil)
+
+		err := province.NewService(mockRepo, nil).Delete(context.Background(), 1)
+
+		assert.EqualError(t, err, province.ERR_PROVINCE_IN_USE)
+	})
+
+	t.Run("Deve retornar erro quando a province for coberta por carriers", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("CountLocalities", context.Background(), 1).Return(0, nil)
+		mockRepo.On("CountCoverage", context.Background(), 1).Return(2, nil)
+
+		err := province.NewService(mockRepo, nil).Delete(context.Background(), 1)
+
+		assert.EqualError(t, err, province.ERR_PROVINCE_COVERED)
+	})
+
+	t.Run("Deve retornar erro ao contar as localities", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("CountLocalities", context.Background(), 1).Return(0, fmt.Errorf("error"))
+
+		err := province.NewService(mockRepo, nil).Delete(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+}
@@ -1,7 +1,10 @@
 package controller
 
 import (
+	"fmt"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
+	shipment "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
 	"github.com/gin-gonic/gin"
 	"net/http"
@@ -10,30 +13,59 @@ import (
 
 const (
 	ERROR_PURCHASE_ID_NOT_FOUNDED = "purchase order with id (%d) not founded"
+	ERROR_BUYER_ID                = "buyer_id must be a positive integer"
 )
 
 type PurchaseOrdersCreate struct {
-	ID              int    `json:"id"`
-	OrderNumber     string `json:"order_number" binding:"required"`
-	OrderDate       string `json:"order_date" binding:"required"`
-	TrackingCode    string `json:"tracking_code" binding:"required"`
-	BuyerId         int    `json:"buyer_id" binding:"required"`
-	ProductRecordId int    `json:"product_record_id" binding:"required"`
-	OrderStatusId   int    `json:"order_status_id" binding:"required"`
+	ID           int                 `json:"id"`
+	OrderNumber  string              `json:"order_number" binding:"required"`
+	OrderDate    string              `json:"order_date" binding:"required"`
+	TrackingCode string              `json:"tracking_code" binding:"required"`
+	BuyerId      int                 `json:"buyer_id" binding:"required"`
+	Details      []OrderDetailCreate `json:"details" binding:"required,min=1,dive"`
+}
+
+type OrderDetailCreate struct {
+	ProductRecordId  int      `json:"product_record_id" binding:"required"`
+	Quantity         int      `json:"quantity" binding:"required,gt=0"`
+	Temperature      *float64 `json:"temperature" binding:"required"`
+	CleanLinesStatus string   `json:"clean_lines_status"`
+}
+
+// PurchaseOrdersUpdate holds the fields that can change while the purchase
+// order is in the created status. The details, when sent, replace all of the
+// saved ones.
+type PurchaseOrdersUpdate struct {
+	OrderDate    string              `json:"order_date"`
+	TrackingCode string              `json:"tracking_code"`
+	BuyerId      int                 `json:"buyer_id" binding:"omitempty,gt=0"`
+	Details      []OrderDetailCreate `json:"details" binding:"omitempty,min=1,dive"`
+}
+
+type TransitionCreate struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// PurchaseOrderResponse is a purchase order with its shipments, whose
+// tracking events make the delivery timeline of the order.
+type PurchaseOrderResponse struct {
+	domain.PurchaseOrders
+	Shipments []shipment.Shipment `json:"shipments"`
 }
 
 type PurchaseOrders struct {
-	service domain.Service
+	service         domain.Service
+	shipmentService shipment.Service
 }
 
-func NewPurchaseOrder(r domain.Service) PurchaseOrders {
-	return PurchaseOrders{r}
+func NewPurchaseOrder(r domain.Service, shipmentService shipment.Service) PurchaseOrders {
+	return PurchaseOrders{r, shipmentService}
 }
 
 // Create CreatePurchaseOrder godoc
 // @Summary Create PurchaseOrder
 // @Tags Buyers
-// @Description store a new purchase order
+// @Description store a new purchase order with its details
 // @Accept json
 // @Produce json
 // @Param token header string true "token"
@@ -52,16 +84,14 @@ func (b *PurchaseOrders) Create(c *gin.Context) {
 		return
 	}
 	purchaseOrder := domain.PurchaseOrders{OrderNumber: req.OrderNumber, OrderDate: req.OrderDate, TrackingCode: req.TrackingCode,
-		BuyerId: req.BuyerId, ProductRecordId: req.ProductRecordId, OrderStatusId: req.OrderStatusId}
+		BuyerId: req.BuyerId}
+
+	purchaseOrder.Details = toDetails(req.Details)
 
 	newPurchaseOrder, err := b.service.Create(c.Request.Context(), purchaseOrder)
 
 	if err != nil {
-		if err.Error() == domain.ERROR_UNIQUE_ORDER_NUMBER {
-			c.JSON(web.DecodeError(http.StatusConflict, err.Error()))
-			return
-		}
-		c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+		c.JSON(web.DecodeError(errorStatus(err, 0, http.StatusBadRequest), err.Error()))
 		return
 	}
 
@@ -71,7 +101,7 @@ func (b *PurchaseOrders) Create(c *gin.Context) {
 // GetPurchaseOrderById GetPurchaseOrder godoc
 // @Summary List buyer
 // @Tags Buyers
-// @Description get a specific purchase order by id
+// @Description get a specific purchase order by id with its details, totals, status history and shipments timeline
 // @Accept json
 // @Produce json
 // @Param token header string true "token"
@@ -90,5 +120,193 @@ func (b *PurchaseOrders) GetPurchaseOrderById(c *gin.Context) {
 		return
 	}
 
-	c.JSON(web.NewResponse(http.StatusOK, data))
+	shipments, err := b.shipmentService.GetByPurchaseOrder(c.Request.Context(), id)
+
+	if err != nil {
+		c.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusOK, PurchaseOrderResponse{data, shipments}))
+}
+
+// Transition TransitionPurchaseOrder godoc
+// @Summary Change the status of a PurchaseOrder
+// @Tags Buyers
+// @Description move a purchase order to another status of its lifecycle, saving the change in its status history
+// @Accept json
+// @Produce json
+// @Param token header string true "token"
+// @Param id path int true "Purchase order id"
+// @Param transition body TransitionCreate true "Status to move the purchase order to"
+// @Failure 401 {object} web.Response "We need token"
+// @Failure 404 {object} web.Response
+// @Failure 409 {object} web.Response "Transition not allowed from the current status"
+// @Failure 422 {object} web.Response "Unknown status"
+// @Success 200 {object} web.Response
+// @Router /api/v1/purchase-orders/{id}/transitions [POST]
+func (b *PurchaseOrders) Transition(c *gin.Context) {
+
+	id, _ := strconv.Atoi(c.Param("id"))
+
+	var req TransitionCreate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(web.DecodeError(http.StatusUnprocessableEntity, "invalid body"))
+		return
+	}
+
+	purchaseOrder, err := b.service.Transition(c.Request.Context(), id, req.Status)
+
+	if err != nil {
+		c.JSON(web.DecodeError(errorStatus(err, id, http.StatusInternalServerError), err.Error()))
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusOK, purchaseOrder))
+}
+
+// GetAll GetPurchaseOrders godoc
+// @Summary List PurchaseOrders
+// @Tags Buyers
+// @Description list the purchase orders, filtered by buyer, status, order number and order date range
+// @Accept json
+// @Produce json
+// @Param token header string true "token"
+// @Param buyer_id query int false "Buyer id"
+// @Param status query string false "Status"
+// @Param order_number query string false "Order number"
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date (YYYY-MM-DD)"
+// @Failure 400 {object} web.Response
+// @Failure 401 {object} web.Response "We need token"
+// @Success 200 {object} web.Response
+// @Router /api/v1/purchase-orders [GET]
+func (b *PurchaseOrders) GetAll(c *gin.Context) {
+
+	filter := domain.Filter{
+		Status:      c.Query("status"),
+		OrderNumber: c.Query("order_number"),
+		From:        c.Query("from"),
+		To:          c.Query("to"),
+	}
+
+	if buyerID := c.Query("buyer_id"); buyerID != "" {
+		id, err := strconv.Atoi(buyerID)
+		if err != nil || id < 1 {
+			c.JSON(web.DecodeError(http.StatusBadRequest, ERROR_BUYER_ID))
+			return
+		}
+		filter.BuyerId = id
+	}
+
+	purchaseOrders, err := b.service.GetAll(c.Request.Context(), filter)
+
+	if err != nil {
+		switch err.Error() {
+		case domain.ERROR_INVALID_STATUS, domain.ERROR_INVALID_DATE:
+			c.JSON(web.DecodeError(http.StatusBadRequest, err.Error()))
+		default:
+			c.JSON(web.DecodeError(http.StatusInternalServerError, err.Error()))
+		}
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusOK, purchaseOrders))
+}
+
+// Update UpdatePurchaseOrder godoc
+// @Summary Update PurchaseOrder
+// @Tags Buyers
+// @Description update the order date, tracking code, buyer or details of a purchase order still in created status
+// @Accept json
+// @Produce json
+// @Param token header string true "token"
+// @Param id path int true "Purchase order id"
+// @Param purchaseOrder body PurchaseOrdersUpdate true "Fields to update"
+// @Failure 401 {object} web.Response "We need token"
+// @Failure 404 {object} web.Response
+// @Failure 409 {object} web.Response "Purchase order is no longer in created status"
+// @Failure 422 {object} web.Response "Invalid field"
+// @Success 200 {object} web.Response
+// @Router /api/v1/purchase-orders/{id} [PATCH]
+func (b *PurchaseOrders) Update(c *gin.Context) {
+
+	id, _ := strconv.Atoi(c.Param("id"))
+
+	var req PurchaseOrdersUpdate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(web.DecodeError(http.StatusUnprocessableEntity, "invalid body"))
+		return
+	}
+
+	changes := domain.PurchaseOrders{OrderDate: req.OrderDate, TrackingCode: req.TrackingCode, BuyerId: req.BuyerId,
+		Details: toDetails(req.Details)}
+
+	purchaseOrder, err := b.service.Update(c.Request.Context(), id, changes)
+
+	if err != nil {
+		c.JSON(web.DecodeError(errorStatus(err, id, http.StatusInternalServerError), err.Error()))
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusOK, purchaseOrder))
+}
+
+// Cancel CancelPurchaseOrder godoc
+// @Summary Cancel PurchaseOrder
+// @Tags Buyers
+// @Description cancel a purchase order that wasn't shipped yet
+// @Accept json
+// @Produce json
+// @Param token header string true "token"
+// @Param id path int true "Purchase order id"
+// @Failure 401 {object} web.Response "We need token"
+// @Failure 404 {object} web.Response
+// @Failure 409 {object} web.Response "Purchase order can't be cancelled from its current status"
+// @Success 200 {object} web.Response
+// @Router /api/v1/purchase-orders/{id}/cancel [POST]
+func (b *PurchaseOrders) Cancel(c *gin.Context) {
+
+	id, _ := strconv.Atoi(c.Param("id"))
+
+	purchaseOrder, err := b.service.Cancel(c.Request.Context(), id)
+
+	if err != nil {
+		c.JSON(web.DecodeError(errorStatus(err, id, http.StatusInternalServerError), err.Error()))
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusOK, purchaseOrder))
+}
+
+// toDetails keeps nil for a request without details, so an update doesn't
+// replace the saved ones.
+func toDetails(req []OrderDetailCreate) []domain.OrderDetail {
+	if req == nil {
+		return nil
+	}
+
+	details := []domain.OrderDetail{}
+	for _, detail := range req {
+		details = append(details, domain.OrderDetail{ProductRecordId: detail.ProductRecordId,
+			Quantity: detail.Quantity, Temperature: *detail.Temperature, CleanLinesStatus: detail.CleanLinesStatus})
+	}
+	return details
+}
+
+// errorStatus maps the errors of the service to the response status, using
+// the given fallback for the unexpected ones.
+func errorStatus(err error, id, fallback int) int {
+	switch err.Error() {
+	case fmt.Sprintf(ERROR_PURCHASE_ID_NOT_FOUNDED, id), domain.ERROR_PRODUCT_RECORD_NOT_FOUND:
+		return http.StatusNotFound
+	case domain.ERROR_UNIQUE_ORDER_NUMBER, domain.ERROR_INVALID_TRANSITION, domain.ERROR_NOT_EDITABLE,
+		reservation.ERR_INSUFFICIENT_STOCK:
+		return http.StatusConflict
+	case domain.ERROR_NO_DETAILS, domain.ERROR_INVALID_QUANTITY, domain.ERROR_TEMPERATURE_TOO_HIGH, domain.ERROR_INVALID_STATUS,
+		domain.ERROR_INVALID_ORDER_DATE:
+		return http.StatusUnprocessableEntity
+	default:
+		return fallback
+	}
 }
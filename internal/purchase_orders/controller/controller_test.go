@@ -13,8 +13,12 @@ import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/controller"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
+	shipment "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+	shipmentMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain/mocks"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
@@ -73,7 +77,7 @@ func createRequestTest(method string, url string, body string) (*http.Request, *
 func TestCreate(t *testing.T) {
 	t.Run("create_ok", func(t *testing.T) {
 		mockService := mocks.NewService(t)
-		handlerPurchase := controller.NewPurchaseOrder(mockService)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
 
 		server := gin.Default()
 		buyerRouterGroup := server.Group(URL)
@@ -83,16 +87,15 @@ func TestCreate(t *testing.T) {
         "order_date": "2008-11-11T13:23:44Z",
         "tracking_code": "1521",
         "buyer_id": 1,
-        "product_record_id": 1,
-        "order_status_id": 1}`
+        "details": [{"product_record_id": 1, "quantity": 2, "temperature": -10, "clean_lines_status": "ok"}]}`
 
 		req, response := createRequestTest(http.MethodPost, URL, expected)
 		mockService.On("Create", context.Background(), domain.PurchaseOrders{OrderNumber: "order1",
-			OrderDate:       "2008-11-11T13:23:44Z",
-			TrackingCode:    "1521",
-			BuyerId:         1,
-			ProductRecordId: 1,
-			OrderStatusId:   1}).Return(buyerData[0], nil)
+			OrderDate:    "2008-11-11T13:23:44Z",
+			TrackingCode: "1521",
+			BuyerId:      1,
+			Details:      []domain.OrderDetail{{ProductRecordId: 1, Quantity: 2, Temperature: -10, CleanLinesStatus: "ok"}},
+		}).Return(buyerData[0], nil)
 		buyerRouterGroup.POST("/", handlerPurchase.Create)
 		server.ServeHTTP(response, req)
 
@@ -106,7 +109,7 @@ func TestCreate(t *testing.T) {
 	})
 	t.Run("create_wrong_body", func(t *testing.T) {
 		mockService := mocks.NewService(t)
-		handlerPurchase := controller.NewPurchaseOrder(mockService)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
 
 		server := gin.Default()
 		buyerRouterGroup := server.Group(URL)
@@ -115,7 +118,7 @@ func TestCreate(t *testing.T) {
         "order_date": "2008-11-11T13:23:44Z",
         "buyer_id": 1,
         "product_record_id": 1,
-        "order_status_id": 1}`
+        }`
 
 		req, response := createRequestTest(http.MethodPost, URL, expected)
 
@@ -131,7 +134,7 @@ func TestCreate(t *testing.T) {
 	})
 	t.Run("create_conflict", func(t *testing.T) {
 		mockService := mocks.NewService(t)
-		buyerHandler := controller.NewPurchaseOrder(mockService)
+		buyerHandler := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
 
 		server := gin.Default()
 		buyerRouterGroup := server.Group(URL)
@@ -140,17 +143,15 @@ func TestCreate(t *testing.T) {
        "order_date": "2008-11-11",
        "tracking_code": "1521",
        "buyer_id": 1,
-       "product_record_id": 1,
-       "order_status_id": 1}`
+       "details": [{"product_record_id": 1, "quantity": 2, "temperature": -10}]}`
 
 		req, response := createRequestTest(http.MethodPost, URL, expected)
 		mockService.On("Create", context.Background(), domain.PurchaseOrders{
-			OrderNumber:     "Order1",
-			OrderDate:       "2008-11-11",
-			TrackingCode:    "1521",
-			BuyerId:         1,
-			ProductRecordId: 1,
-			OrderStatusId:   1,
+			OrderNumber:  "Order1",
+			OrderDate:    "2008-11-11",
+			TrackingCode: "1521",
+			BuyerId:      1,
+			Details:      []domain.OrderDetail{{ProductRecordId: 1, Quantity: 2, Temperature: -10}},
 		}).Return(domain.PurchaseOrders{},
 			fmt.Errorf("the order number must be unique"))
 		buyerRouterGroup.POST("/", buyerHandler.Create)
@@ -163,12 +164,107 @@ func TestCreate(t *testing.T) {
 		assert.Equal(t, domain.PurchaseOrders{}, resp.Data)
 		assert.Equal(t, resp.Error, "the order number must be unique")
 	})
+	t.Run("create_invalid_detail", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		buyerHandler := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		buyerRouterGroup := server.Group(URL)
+
+		expected := `{"order_number": "Order1",
+       "order_date": "2008-11-11",
+       "tracking_code": "1521",
+       "buyer_id": 1,
+       "details": [{"product_record_id": 1, "quantity": 0, "temperature": -10}]}`
+
+		req, response := createRequestTest(http.MethodPost, URL, expected)
+		buyerRouterGroup.POST("/", buyerHandler.Create)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.Equal(t, resp.Error, "invalid body")
+	})
+	t.Run("create_temperature_too_high", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		buyerHandler := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		buyerRouterGroup := server.Group(URL)
+
+		expected := `{"order_number": "Order1",
+       "order_date": "2008-11-11",
+       "tracking_code": "1521",
+       "buyer_id": 1,
+       "details": [{"product_record_id": 1, "quantity": 2, "temperature": 30}]}`
+
+		req, response := createRequestTest(http.MethodPost, URL, expected)
+		mockService.On("Create", context.Background(), mock.Anything).Return(domain.PurchaseOrders{},
+			fmt.Errorf(domain.ERROR_TEMPERATURE_TOO_HIGH))
+		buyerRouterGroup.POST("/", buyerHandler.Create)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.Equal(t, domain.ERROR_TEMPERATURE_TOO_HIGH, resp.Error)
+	})
+	t.Run("create_product_record_not_found", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		buyerHandler := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		buyerRouterGroup := server.Group(URL)
+
+		expected := `{"order_number": "Order1",
+       "order_date": "2008-11-11",
+       "tracking_code": "1521",
+       "buyer_id": 1,
+       "details": [{"product_record_id": 9, "quantity": 2, "temperature": -10}]}`
+
+		req, response := createRequestTest(http.MethodPost, URL, expected)
+		mockService.On("Create", context.Background(), mock.Anything).Return(domain.PurchaseOrders{},
+			fmt.Errorf(domain.ERROR_PRODUCT_RECORD_NOT_FOUND))
+		buyerRouterGroup.POST("/", buyerHandler.Create)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+	t.Run("create_insufficient_stock", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		buyerHandler := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		buyerRouterGroup := server.Group(URL)
+
+		expected := `{"order_number": "Order1",
+       "order_date": "2008-11-11",
+       "tracking_code": "1521",
+       "buyer_id": 1,
+       "details": [{"product_record_id": 1, "quantity": 2000, "temperature": -10}]}`
+
+		req, response := createRequestTest(http.MethodPost, URL, expected)
+		mockService.On("Create", context.Background(), mock.Anything).Return(domain.PurchaseOrders{},
+			fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK))
+		buyerRouterGroup.POST("/", buyerHandler.Create)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.Equal(t, reservation.ERR_INSUFFICIENT_STOCK, resp.Error)
+	})
 }
 
 func TestGetById(t *testing.T) {
 	t.Run("get_by_id", func(t *testing.T) {
 		mockService := mocks.NewService(t)
-		handlerPurchase := controller.NewPurchaseOrder(mockService)
+		mockShipmentService := shipmentMocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, mockShipmentService)
 
 		server := gin.Default()
 		routerGroup := server.Group(URL)
@@ -177,6 +273,7 @@ func TestGetById(t *testing.T) {
 
 		req, response := createRequestTest(http.MethodGet, URL+"1", "")
 		mockService.On("GetById", context.Background(), 1).Return(data[0], nil)
+		mockShipmentService.On("GetByPurchaseOrder", context.Background(), 1).Return([]shipment.Shipment{}, nil)
 		routerGroup.GET("/:id", handlerPurchase.GetPurchaseOrderById)
 		server.ServeHTTP(response, req)
 
@@ -189,7 +286,7 @@ func TestGetById(t *testing.T) {
 	})
 	t.Run("get_by_id_not_found", func(t *testing.T) {
 		mockService := mocks.NewService(t)
-		handlerPurchase := controller.NewPurchaseOrder(mockService)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
 
 		server := gin.Default()
 		routerGroup := server.Group(URL)
@@ -206,4 +303,339 @@ func TestGetById(t *testing.T) {
 		assert.Equal(t, domain.PurchaseOrders{}, resp.Data)
 		assert.Equal(t, resp.Error, "purchase order with id 1123 not founded")
 	})
+	t.Run("get_by_id_with_timeline", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		mockShipmentService := shipmentMocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, mockShipmentService)
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		data := createBaseData()
+		data[0].Details = []domain.OrderDetail{
+			{ID: 1, Quantity: 2, Temperature: -10, ProductRecordId: 1, PurchaseOrderId: 1, UnitPrice: 10.5, Total: 21},
+		}
+		data[0].TotalQuantity = 2
+		data[0].TotalPrice = 21
+		shipments := []shipment.Shipment{
+			{
+				ID:              1,
+				TrackingCode:    "MF3F9A01C27BD4",
+				PurchaseOrderID: 1,
+				CarrierID:       2,
+				Status:          shipment.STATUS_IN_TRANSIT,
+				CreatedAt:       "2022-08-01 10:00:00",
+				Events: []shipment.Event{
+					{ID: 1, ShipmentID: 1, Status: shipment.STATUS_PICKED_UP, OccurredAt: "2022-08-01 12:00:00"},
+					{ID: 2, ShipmentID: 1, Status: shipment.STATUS_IN_TRANSIT, Description: "left the warehouse", OccurredAt: "2022-08-01 15:30:00"},
+				},
+			},
+		}
+
+		req, response := createRequestTest(http.MethodGet, URL+"1", "")
+		mockService.On("GetById", context.Background(), 1).Return(data[0], nil)
+		mockShipmentService.On("GetByPurchaseOrder", context.Background(), 1).Return(shipments, nil)
+		routerGroup.GET("/:id", handlerPurchase.GetPurchaseOrderById)
+		server.ServeHTTP(response, req)
+
+		resp := struct {
+			Code int
+			Data controller.PurchaseOrderResponse
+		}{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, data[0], resp.Data.PurchaseOrders)
+		assert.Equal(t, shipments, resp.Data.Shipments)
+	})
+	t.Run("get_by_id_timeline_error", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		mockShipmentService := shipmentMocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, mockShipmentService)
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		data := createBaseData()
+
+		req, response := createRequestTest(http.MethodGet, URL+"1", "")
+		mockService.On("GetById", context.Background(), 1).Return(data[0], nil)
+		mockShipmentService.On("GetByPurchaseOrder", context.Background(), 1).Return([]shipment.Shipment{}, fmt.Errorf("connection refused"))
+		routerGroup.GET("/:id", handlerPurchase.GetPurchaseOrderById)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+		assert.Equal(t, "connection refused", resp.Error)
+	})
+}
+
+func TestTransition(t *testing.T) {
+	t.Run("transition_ok", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		data := createBaseData()[0]
+		data.OrderStatusId = domain.StatusIDs[domain.STATUS_CONFIRMED]
+		data.Status = domain.STATUS_CONFIRMED
+		data.StatusHistory = []domain.StatusChange{
+			{ID: 1, PurchaseOrderId: 1, ToStatus: domain.STATUS_CREATED, ChangedAt: "2022-08-01 10:00:00"},
+			{ID: 2, PurchaseOrderId: 1, FromStatus: domain.STATUS_CREATED, ToStatus: domain.STATUS_CONFIRMED, ChangedAt: "2022-08-01 11:00:00"},
+		}
+
+		req, response := createRequestTest(http.MethodPost, URL+"1/transitions", `{"status": "confirmed"}`)
+		mockService.On("Transition", context.Background(), 1, domain.STATUS_CONFIRMED).Return(data, nil)
+		routerGroup.POST("/:id/transitions", handlerPurchase.Transition)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, data, resp.Data)
+	})
+	t.Run("transition_wrong_body", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		req, response := createRequestTest(http.MethodPost, URL+"1/transitions", `{}`)
+		routerGroup.POST("/:id/transitions", handlerPurchase.Transition)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+	t.Run("transition_errors", func(t *testing.T) {
+		cases := []struct {
+			err  error
+			code int
+		}{
+			{fmt.Errorf("purchase order with id (1) not founded"), http.StatusNotFound},
+			{fmt.Errorf(domain.ERROR_INVALID_STATUS), http.StatusUnprocessableEntity},
+			{fmt.Errorf(domain.ERROR_INVALID_TRANSITION), http.StatusConflict},
+			{fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK), http.StatusConflict},
+			{fmt.Errorf("error"), http.StatusInternalServerError},
+		}
+		for _, c := range cases {
+			mockService := mocks.NewService(t)
+			handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+			server := gin.Default()
+			routerGroup := server.Group(URL)
+
+			req, response := createRequestTest(http.MethodPost, URL+"1/transitions", `{"status": "shipped"}`)
+			mockService.On("Transition", context.Background(), 1, domain.STATUS_SHIPPED).Return(domain.PurchaseOrders{}, c.err)
+			routerGroup.POST("/:id/transitions", handlerPurchase.Transition)
+			server.ServeHTTP(response, req)
+
+			resp := responseData{}
+			json.Unmarshal(response.Body.Bytes(), &resp)
+
+			assert.Equal(t, c.code, response.Code)
+			assert.Equal(t, c.err.Error(), resp.Error)
+		}
+	})
+}
+
+func TestGetAll(t *testing.T) {
+	t.Run("get_all_ok", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		data := createBaseData()
+		req, response := createRequestTest(http.MethodGet, URL+"?buyer_id=1&status=created&from=2008-11-01&to=2008-11-30", "")
+		mockService.On("GetAll", context.Background(), domain.Filter{BuyerId: 1, Status: domain.STATUS_CREATED,
+			From: "2008-11-01", To: "2008-11-30"}).Return(data, nil)
+		routerGroup.GET("/", handlerPurchase.GetAll)
+		server.ServeHTTP(response, req)
+
+		resp := responseDataOrdersArray{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, data, resp.Data)
+	})
+	t.Run("get_all_invalid_buyer", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		req, response := createRequestTest(http.MethodGet, URL+"?buyer_id=abc", "")
+		routerGroup.GET("/", handlerPurchase.GetAll)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Equal(t, controller.ERROR_BUYER_ID, resp.Error)
+	})
+	t.Run("get_all_invalid_status", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		req, response := createRequestTest(http.MethodGet, URL+"?status=lost", "")
+		mockService.On("GetAll", context.Background(), domain.Filter{Status: "lost"}).
+			Return([]domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_INVALID_STATUS))
+		routerGroup.GET("/", handlerPurchase.GetAll)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+	t.Run("get_all_error", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		req, response := createRequestTest(http.MethodGet, URL, "")
+		mockService.On("GetAll", context.Background(), domain.Filter{}).
+			Return([]domain.PurchaseOrders{}, fmt.Errorf("error"))
+		routerGroup.GET("/", handlerPurchase.GetAll)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("update_ok", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		data := createBaseData()[0]
+		data.TrackingCode = "XYZ"
+		req, response := createRequestTest(http.MethodPatch, URL+"1",
+			`{"tracking_code": "XYZ", "details": [{"product_record_id": 2, "quantity": 4, "temperature": 2}]}`)
+		mockService.On("Update", context.Background(), 1, domain.PurchaseOrders{TrackingCode: "XYZ",
+			Details: []domain.OrderDetail{{ProductRecordId: 2, Quantity: 4, Temperature: 2}}}).Return(data, nil)
+		routerGroup.PATCH("/:id", handlerPurchase.Update)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, data, resp.Data)
+	})
+	t.Run("update_keeps_details", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		req, response := createRequestTest(http.MethodPatch, URL+"1", `{"order_date": "2008-11-12"}`)
+		mockService.On("Update", context.Background(), 1, domain.PurchaseOrders{OrderDate: "2008-11-12"}).
+			Return(createBaseData()[0], nil)
+		routerGroup.PATCH("/:id", handlerPurchase.Update)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+	})
+	t.Run("update_wrong_body", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		req, response := createRequestTest(http.MethodPatch, URL+"1", `{"details": []}`)
+		routerGroup.PATCH("/:id", handlerPurchase.Update)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+	t.Run("update_not_editable", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		req, response := createRequestTest(http.MethodPatch, URL+"1", `{"tracking_code": "XYZ"}`)
+		mockService.On("Update", context.Background(), 1, domain.PurchaseOrders{TrackingCode: "XYZ"}).
+			Return(domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_NOT_EDITABLE))
+		routerGroup.PATCH("/:id", handlerPurchase.Update)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.Equal(t, domain.ERROR_NOT_EDITABLE, resp.Error)
+	})
+}
+
+func TestCancel(t *testing.T) {
+	t.Run("cancel_ok", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		data := createBaseData()[0]
+		data.OrderStatusId = domain.StatusIDs[domain.STATUS_CANCELLED]
+		data.Status = domain.STATUS_CANCELLED
+		req, response := createRequestTest(http.MethodPost, URL+"1/cancel", "")
+		mockService.On("Cancel", context.Background(), 1).Return(data, nil)
+		routerGroup.POST("/:id/cancel", handlerPurchase.Cancel)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, data, resp.Data)
+	})
+	t.Run("cancel_not_found", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		req, response := createRequestTest(http.MethodPost, URL+"10/cancel", "")
+		mockService.On("Cancel", context.Background(), 10).
+			Return(domain.PurchaseOrders{}, fmt.Errorf("purchase order with id (10) not founded"))
+		routerGroup.POST("/:id/cancel", handlerPurchase.Cancel)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+	t.Run("cancel_shipped", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		handlerPurchase := controller.NewPurchaseOrder(mockService, shipmentMocks.NewService(t))
+
+		server := gin.Default()
+		routerGroup := server.Group(URL)
+
+		req, response := createRequestTest(http.MethodPost, URL+"1/cancel", "")
+		mockService.On("Cancel", context.Background(), 1).
+			Return(domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_INVALID_TRANSITION))
+		routerGroup.POST("/:id/cancel", handlerPurchase.Cancel)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+	})
 }
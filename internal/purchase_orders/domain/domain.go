@@ -2,30 +2,152 @@ package domain
 
 import (
 	"context"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
 )
 
 const (
-	ERROR_UNIQUE_ORDER_NUMBER = "the order number must be unique"
-	ERROR_WHILE_SAVING        = "Error while saving"
+	ERROR_UNIQUE_ORDER_NUMBER      = "the order number must be unique"
+	ERROR_WHILE_SAVING             = "Error while saving"
+	ERROR_NO_DETAILS               = "the purchase order must have at least one detail"
+	ERROR_INVALID_QUANTITY         = "the quantity of every detail must be greater than zero"
+	ERROR_PRODUCT_RECORD_NOT_FOUND = "product record not found"
+	ERROR_TEMPERATURE_TOO_HIGH     = "the temperature of a detail is above the recommended temperature of its product"
+	ERROR_INVALID_STATUS           = "status must be one of created, confirmed, picking, shipped, delivered, cancelled or returned"
+	ERROR_INVALID_TRANSITION       = "the purchase order can't change from its current status to the requested status"
+	ERROR_NOT_EDITABLE             = "only purchase orders in created status can be updated"
+	ERROR_INVALID_DATE             = "dates must be in the format YYYY-MM-DD"
+	ERROR_INVALID_ORDER_DATE       = "order_date must be a date (YYYY-MM-DD), a date and time (YYYY-MM-DD HH:MM:SS) or RFC 3339"
+
+	STATUS_CREATED   = "created"
+	STATUS_CONFIRMED = "confirmed"
+	STATUS_PICKING   = "picking"
+	STATUS_SHIPPED   = "shipped"
+	STATUS_DELIVERED = "delivered"
+	STATUS_CANCELLED = "cancelled"
+	STATUS_RETURNED  = "returned"
 )
 
+// StatusIDs are the ids the statuses are seeded with in order_status.
+var StatusIDs = map[string]int{
+	STATUS_CREATED:   1,
+	STATUS_CONFIRMED: 2,
+	STATUS_PICKING:   3,
+	STATUS_SHIPPED:   4,
+	STATUS_DELIVERED: 5,
+	STATUS_CANCELLED: 6,
+	STATUS_RETURNED:  7,
+}
+
+// Transitions holds, for each status, the statuses a purchase order can move
+// to. An order can be cancelled until it is shipped and returned after that;
+// cancelled and returned orders are final.
+var Transitions = map[string][]string{
+	STATUS_CREATED:   {STATUS_CONFIRMED, STATUS_CANCELLED},
+	STATUS_CONFIRMED: {STATUS_PICKING, STATUS_CANCELLED},
+	STATUS_PICKING:   {STATUS_SHIPPED, STATUS_CANCELLED},
+	STATUS_SHIPPED:   {STATUS_DELIVERED, STATUS_RETURNED},
+	STATUS_DELIVERED: {STATUS_RETURNED},
+	STATUS_CANCELLED: {},
+	STATUS_RETURNED:  {},
+}
+
+// StatusName returns the status seeded with the given id, or an empty string
+// when there is none.
+func StatusName(id int) string {
+	for status, statusID := range StatusIDs {
+		if statusID == id {
+			return status
+		}
+	}
+	return ""
+}
+
+// PurchaseOrders keeps the product_record_id of its first detail, so the
+// reports that join purchase_orders with product_records keep working.
+// The tax rate and the unit prices of the details are a snapshot taken when
+// the details are set, so later product records don't change the totals.
 type PurchaseOrders struct {
+	ID              int            `json:"id"`
+	OrderNumber     string         `json:"order_number"`
+	OrderDate       string         `json:"order_date"`
+	TrackingCode    string         `json:"tracking_code"`
+	BuyerId         int            `json:"buyer_id"`
+	ProductRecordId int            `json:"product_record_id"`
+	OrderStatusId   int            `json:"order_status_id"`
+	Status          string         `json:"status"`
+	Details         []OrderDetail  `json:"details"`
+	TotalQuantity   int            `json:"total_quantity"`
+	Subtotal        float64        `json:"subtotal"`
+	TaxRate         float64        `json:"tax_rate"`
+	Tax             float64        `json:"tax"`
+	TotalPrice      float64        `json:"total_price"`
+	StatusHistory   []StatusChange `json:"status_history"`
+}
+
+// StatusChange is an entry of the status history of a purchase order. The
+// first entry of an order has no previous status.
+type StatusChange struct {
 	ID              int    `json:"id"`
-	OrderNumber     string `json:"order_number"`
-	OrderDate       string `json:"order_date"`
-	TrackingCode    string `json:"tracking_code"`
-	BuyerId         int    `json:"buyer_id"`
-	ProductRecordId int    `json:"product_record_id"`
-	OrderStatusId   int    `json:"order_status_id"`
+	PurchaseOrderId int    `json:"purchase_order_id"`
+	FromStatus      string `json:"from_status"`
+	ToStatus        string `json:"to_status"`
+	ChangedAt       string `json:"changed_at"`
+}
+
+// OrderDetail is a line of the purchase order. The unit price is the sale
+// price of its product at the order date.
+type OrderDetail struct {
+	ID               int     `json:"id"`
+	CleanLinesStatus string  `json:"clean_lines_status"`
+	Quantity         int     `json:"quantity"`
+	Temperature      float64 `json:"temperature"`
+	ProductRecordId  int     `json:"product_record_id"`
+	ProductId        int     `json:"product_id"`
+	PurchaseOrderId  int     `json:"purchase_order_id"`
+	UnitPrice        float64 `json:"unit_price"`
+	Total            float64 `json:"total"`
+}
+
+// Filter narrows the purchase orders listing. Zero values don't filter; the
+// dates are inclusive.
+type Filter struct {
+	BuyerId     int
+	Status      string
+	OrderNumber string
+	From        string
+	To          string
+}
+
+// ProductRecord is what a detail needs from its product record: the sale
+// price of the product at a date, its recommended freezing temperature and
+// the product whose stock is reserved for the detail.
+type ProductRecord struct {
+	ID                     int
+	ProductID              int
+	SalePrice              float64
+	RecommendedTemperature float64
 }
 
+// Repository saves the stock held for a purchase order in the same
+// transaction as the order. A nil hold keeps the stock the order holds.
 type Repository interface {
-	Create(ctx context.Context, purchaseOrder PurchaseOrders) (PurchaseOrders, error)
+	GetAll(ctx context.Context, filter Filter) ([]PurchaseOrders, error)
+	Create(ctx context.Context, purchaseOrder PurchaseOrders, hold reservation.Hold) (PurchaseOrders, error)
 	GetById(ctx context.Context, id int) (PurchaseOrders, error)
+	Update(ctx context.Context, purchaseOrder PurchaseOrders, hold *reservation.Hold) (PurchaseOrders, error)
 	ValidadeOrderNumber(ctx context.Context, orderNumber string) (bool, error)
+	GetDetails(ctx context.Context, purchaseOrderID int) ([]OrderDetail, error)
+	GetProductRecord(ctx context.Context, id int, date string) (ProductRecord, error)
+	GetStatusHistory(ctx context.Context, purchaseOrderID int) ([]StatusChange, error)
+	ChangeStatus(ctx context.Context, change StatusChange, hold *reservation.Hold) (StatusChange, error)
 }
 
 type Service interface {
+	GetAll(ctx context.Context, filter Filter) ([]PurchaseOrders, error)
 	Create(ctx context.Context, purchaseOrder PurchaseOrders) (PurchaseOrders, error)
 	GetById(ctx context.Context, id int) (PurchaseOrders, error)
+	Update(ctx context.Context, id int, changes PurchaseOrders) (PurchaseOrders, error)
+	Transition(ctx context.Context, id int, status string) (PurchaseOrders, error)
+	Cancel(ctx context.Context, id int) (PurchaseOrders, error)
 }
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.12.3. DO NOT EDIT.
+// Code generated by mockery v2.13.1. DO NOT EDIT.
 
 package mocks
 
@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	domain "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain"
+	reservation "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,20 +15,64 @@ type Repository struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, purchaseOrder
-func (_m *Repository) Create(ctx context.Context, purchaseOrder domain.PurchaseOrders) (domain.PurchaseOrders, error) {
-	ret := _m.Called(ctx, purchaseOrder)
+// ChangeStatus provides a mock function with given fields: ctx, change, hold
+func (_m *Repository) ChangeStatus(ctx context.Context, change domain.StatusChange, hold *reservation.Hold) (domain.StatusChange, error) {
+	ret := _m.Called(ctx, change, hold)
+
+	var r0 domain.StatusChange
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatusChange, *reservation.Hold) domain.StatusChange); ok {
+		r0 = rf(ctx, change, hold)
+	} else {
+		r0 = ret.Get(0).(domain.StatusChange)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.StatusChange, *reservation.Hold) error); ok {
+		r1 = rf(ctx, change, hold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, purchaseOrder, hold
+func (_m *Repository) Create(ctx context.Context, purchaseOrder domain.PurchaseOrders, hold reservation.Hold) (domain.PurchaseOrders, error) {
+	ret := _m.Called(ctx, purchaseOrder, hold)
 
 	var r0 domain.PurchaseOrders
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PurchaseOrders) domain.PurchaseOrders); ok {
-		r0 = rf(ctx, purchaseOrder)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PurchaseOrders, reservation.Hold) domain.PurchaseOrders); ok {
+		r0 = rf(ctx, purchaseOrder, hold)
 	} else {
 		r0 = ret.Get(0).(domain.PurchaseOrders)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, domain.PurchaseOrders) error); ok {
-		r1 = rf(ctx, purchaseOrder)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PurchaseOrders, reservation.Hold) error); ok {
+		r1 = rf(ctx, purchaseOrder, hold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *Repository) GetAll(ctx context.Context, filter domain.Filter) ([]domain.PurchaseOrders, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []domain.PurchaseOrders
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) []domain.PurchaseOrders); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PurchaseOrders)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,6 +101,94 @@ func (_m *Repository) GetById(ctx context.Context, id int) (domain.PurchaseOrder
 	return r0, r1
 }
 
+// GetDetails provides a mock function with given fields: ctx, purchaseOrderID
+func (_m *Repository) GetDetails(ctx context.Context, purchaseOrderID int) ([]domain.OrderDetail, error) {
+	ret := _m.Called(ctx, purchaseOrderID)
+
+	var r0 []domain.OrderDetail
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.OrderDetail); ok {
+		r0 = rf(ctx, purchaseOrderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OrderDetail)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, purchaseOrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductRecord provides a mock function with given fields: ctx, id, date
+func (_m *Repository) GetProductRecord(ctx context.Context, id int, date string) (domain.ProductRecord, error) {
+	ret := _m.Called(ctx, id, date)
+
+	var r0 domain.ProductRecord
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) domain.ProductRecord); ok {
+		r0 = rf(ctx, id, date)
+	} else {
+		r0 = ret.Get(0).(domain.ProductRecord)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatusHistory provides a mock function with given fields: ctx, purchaseOrderID
+func (_m *Repository) GetStatusHistory(ctx context.Context, purchaseOrderID int) ([]domain.StatusChange, error) {
+	ret := _m.Called(ctx, purchaseOrderID)
+
+	var r0 []domain.StatusChange
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.StatusChange); ok {
+		r0 = rf(ctx, purchaseOrderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.StatusChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, purchaseOrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, purchaseOrder, hold
+func (_m *Repository) Update(ctx context.Context, purchaseOrder domain.PurchaseOrders, hold *reservation.Hold) (domain.PurchaseOrders, error) {
+	ret := _m.Called(ctx, purchaseOrder, hold)
+
+	var r0 domain.PurchaseOrders
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PurchaseOrders, *reservation.Hold) domain.PurchaseOrders); ok {
+		r0 = rf(ctx, purchaseOrder, hold)
+	} else {
+		r0 = ret.Get(0).(domain.PurchaseOrders)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PurchaseOrders, *reservation.Hold) error); ok {
+		r1 = rf(ctx, purchaseOrder, hold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ValidadeOrderNumber provides a mock function with given fields: ctx, orderNumber
 func (_m *Repository) ValidadeOrderNumber(ctx context.Context, orderNumber string) (bool, error) {
 	ret := _m.Called(ctx, orderNumber)
@@ -77,13 +210,13 @@ func (_m *Repository) ValidadeOrderNumber(ctx context.Context, orderNumber strin
 	return r0, r1
 }
 
-type NewRepositoryT interface {
+type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
 }
 
 // NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewRepository(t NewRepositoryT) *Repository {
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
 	mock := &Repository{}
 	mock.Mock.Test(t)
 
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.12.3. DO NOT EDIT.
+// Code generated by mockery v2.13.1. DO NOT EDIT.
 
 package mocks
 
@@ -14,6 +14,27 @@ type Service struct {
 	mock.Mock
 }
 
+// Cancel provides a mock function with given fields: ctx, id
+func (_m *Service) Cancel(ctx context.Context, id int) (domain.PurchaseOrders, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.PurchaseOrders
+	if rf, ok := ret.Get(0).(func(context.Context, int) domain.PurchaseOrders); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.PurchaseOrders)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: ctx, purchaseOrder
 func (_m *Service) Create(ctx context.Context, purchaseOrder domain.PurchaseOrders) (domain.PurchaseOrders, error) {
 	ret := _m.Called(ctx, purchaseOrder)
@@ -35,6 +56,29 @@ func (_m *Service) Create(ctx context.Context, purchaseOrder domain.PurchaseOrde
 	return r0, r1
 }
 
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *Service) GetAll(ctx context.Context, filter domain.Filter) ([]domain.PurchaseOrders, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []domain.PurchaseOrders
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Filter) []domain.PurchaseOrders); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PurchaseOrders)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetById provides a mock function with given fields: ctx, id
 func (_m *Service) GetById(ctx context.Context, id int) (domain.PurchaseOrders, error) {
 	ret := _m.Called(ctx, id)
@@ -56,13 +100,55 @@ func (_m *Service) GetById(ctx context.Context, id int) (domain.PurchaseOrders,
 	return r0, r1
 }
 
-type NewServiceT interface {
+// Transition provides a mock function with given fields: ctx, id, status
+func (_m *Service) Transition(ctx context.Context, id int, status string) (domain.PurchaseOrders, error) {
+	ret := _m.Called(ctx, id, status)
+
+	var r0 domain.PurchaseOrders
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) domain.PurchaseOrders); ok {
+		r0 = rf(ctx, id, status)
+	} else {
+		r0 = ret.Get(0).(domain.PurchaseOrders)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, changes
+func (_m *Service) Update(ctx context.Context, id int, changes domain.PurchaseOrders) (domain.PurchaseOrders, error) {
+	ret := _m.Called(ctx, id, changes)
+
+	var r0 domain.PurchaseOrders
+	if rf, ok := ret.Get(0).(func(context.Context, int, domain.PurchaseOrders) domain.PurchaseOrders); ok {
+		r0 = rf(ctx, id, changes)
+	} else {
+		r0 = ret.Get(0).(domain.PurchaseOrders)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, domain.PurchaseOrders) error); ok {
+		r1 = rf(ctx, id, changes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
 	mock.TestingT
 	Cleanup(func())
 }
 
 // NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewService(t NewServiceT) *Service {
+func NewService(t mockConstructorTestingTNewService) *Service {
 	mock := &Service{}
 	mock.Mock.Test(t)
 
@@ -1,9 +1,59 @@
 package repository
 
 const (
-	SqlGetById = "SELECT * FROM purchase_orders where id=?"
+	SqlGetById = "SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id, order_status_id, COALESCE(tax_rate, 0) FROM purchase_orders where id=?"
 
-	SqlCreate = "INSERT INTO purchase_orders (`order_number`, `order_date`, `tracking_code`, `buyer_id`, `product_record_id`, `order_status_id`) VALUES (?, ?, ?, ?, ?, ?)"
+	SqlCreate = "INSERT INTO purchase_orders (`order_number`, `order_date`, `tracking_code`, `buyer_id`, `product_record_id`, `order_status_id`, `tax_rate`) VALUES (?, ?, ?, ?, ?, ?, ?)"
 
 	SqlOrderNumber = "SELECT order_number FROM purchase_orders where order_number = ?"
+
+	SqlCreateDetail = "INSERT INTO order_details (`clean_lines_status`, `quantity`, `temperature`, `product_record_id`, `purchase_order_id`, `unit_price`) VALUES (?, ?, ?, ?, ?, ?)"
+
+	// Details saved before the price snapshot existed fall back to the
+	// current sale price of their product record.
+	SqlGetDetails = `SELECT od.id, COALESCE(od.clean_lines_status, ''), od.quantity, od.temperature, od.product_record_id, pr.product_id,
+		od.purchase_order_id, COALESCE(od.unit_price, pr.sale_price, 0)
+		FROM order_details od JOIN product_records pr ON pr.id = od.product_record_id
+		WHERE od.purchase_order_id = ? ORDER BY od.id`
+
+	// The sale price is the one of the latest record of the product updated
+	// up to the date, or the one of the record itself when there is none.
+	// The price is NULL when the product had no price yet at the date.
+	SqlGetProductRecord = `SELECT pr.id, pr.product_id,
+		(SELECT e.sale_price FROM product_records e
+			WHERE e.product_id = pr.product_id AND e.last_update_date <= ? AND e.sale_price IS NOT NULL
+			ORDER BY e.last_update_date DESC, e.id DESC LIMIT 1),
+		p.recommended_freezing_temperature
+		FROM product_records pr JOIN products p ON p.id = pr.product_id WHERE pr.id = ?`
+)
+
+const (
+	SqlCreateStatusChange = "INSERT INTO purchase_order_status_history (`purchase_order_id`, `from_status_id`, `to_status_id`, `changed_at`) VALUES (?, ?, ?, ?)"
+
+	SqlGetStatusHistory = `SELECT h.id, h.purchase_order_id, COALESCE(fs.description, ''), ts.description, h.changed_at
+		FROM purchase_order_status_history h
+		LEFT JOIN order_status fs ON fs.id = h.from_status_id
+		JOIN order_status ts ON ts.id = h.to_status_id
+		WHERE h.purchase_order_id = ? ORDER BY h.id`
+
+	// The current status is part of the condition, so a concurrent change
+	// doesn't go unnoticed.
+	SqlUpdateStatus = "UPDATE purchase_orders SET order_status_id = ? WHERE id = ? AND order_status_id = ?"
+)
+
+const (
+	SqlGetAll = "SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id, order_status_id, COALESCE(tax_rate, 0) FROM purchase_orders"
+
+	SqlGetAllOrderBy = " ORDER BY order_date DESC, id DESC"
+
+	// Only orders still in the created status are updated, even if they
+	// were confirmed after being read.
+	SqlUpdate = "UPDATE purchase_orders SET `order_date` = ?, `tracking_code` = ?, `buyer_id` = ?, `product_record_id` = ? WHERE id = ? AND order_status_id = ?"
+
+	// MySQL doesn't count the rows an update leaves as they were, so an
+	// update of the same values is told apart from an order that is no
+	// longer editable.
+	SqlIsEditable = "SELECT COUNT(*) FROM purchase_orders WHERE id = ? AND order_status_id = ?"
+
+	SqlDeleteDetails = "DELETE FROM order_details WHERE purchase_order_id = ?"
 )
@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
 )
 
 type repository struct {
@@ -26,31 +29,196 @@ func (r *repository) GetById(ctx context.Context, id int) (domain.PurchaseOrders
 
 	defer stmt.Close()
 	err = stmt.QueryRowContext(ctx, id).Scan(&purchaseOrder.ID, &purchaseOrder.OrderNumber, &purchaseOrder.OrderDate,
-		&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId)
+		&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId,
+		&purchaseOrder.TaxRate)
 	if err != nil {
 		return domain.PurchaseOrders{}, fmt.Errorf("purchase order with id (%d) not founded", id)
 	}
 	return purchaseOrder, nil
 }
 
-func (r repository) Create(ctx context.Context, purchaseOrder domain.PurchaseOrders) (domain.PurchaseOrders, error) {
-	res, err := r.db.ExecContext(ctx, SqlCreate, &purchaseOrder.OrderNumber, &purchaseOrder.OrderDate,
-		&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId)
+// Create saves the purchase order, its details, its status history and the
+// stock held for it in the same transaction, so no order is left without its
+// stock.
+func (r repository) Create(ctx context.Context, purchaseOrder domain.PurchaseOrders, hold reservation.Hold) (domain.PurchaseOrders, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	res, err := tx.ExecContext(ctx, SqlCreate, &purchaseOrder.OrderNumber, &purchaseOrder.OrderDate,
+		&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId,
+		&purchaseOrder.TaxRate)
 	if err != nil {
+		tx.Rollback()
 		return domain.PurchaseOrders{}, err
 	}
 	rowsAffected, _ := res.RowsAffected()
 	if rowsAffected == 0 {
+		tx.Rollback()
 		return domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_WHILE_SAVING)
 	}
 
 	lastID, err := res.LastInsertId()
 	if err != nil || lastID < 1 {
+		tx.Rollback()
 		return domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_WHILE_SAVING)
 	}
 
 	purchaseOrder.ID = int(lastID)
 
+	details, err := insertDetails(ctx, tx, purchaseOrder.ID, purchaseOrder.Details)
+	if err != nil {
+		tx.Rollback()
+		return domain.PurchaseOrders{}, err
+	}
+
+	purchaseOrder.Details = details
+
+	for i, change := range purchaseOrder.StatusHistory {
+		res, err = tx.ExecContext(ctx, SqlCreateStatusChange, purchaseOrder.ID, statusID(change.FromStatus),
+			statusID(change.ToStatus), change.ChangedAt)
+		if err != nil {
+			tx.Rollback()
+			return domain.PurchaseOrders{}, err
+		}
+
+		changeID, err := res.LastInsertId()
+		if err != nil || changeID < 1 {
+			tx.Rollback()
+			return domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_WHILE_SAVING)
+		}
+
+		purchaseOrder.StatusHistory[i].ID = int(changeID)
+		purchaseOrder.StatusHistory[i].PurchaseOrderId = purchaseOrder.ID
+	}
+
+	if _, err = reservation.ReserveTx(ctx, tx, purchaseOrder.ID, hold); err != nil {
+		tx.Rollback()
+		return domain.PurchaseOrders{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	return purchaseOrder, nil
+}
+
+func (r *repository) GetAll(ctx context.Context, filter domain.Filter) ([]domain.PurchaseOrders, error) {
+	var where []string
+	var args []interface{}
+
+	if filter.BuyerId != 0 {
+		where = append(where, "buyer_id = ?")
+		args = append(args, filter.BuyerId)
+	}
+	if filter.Status != "" {
+		where = append(where, "order_status_id = ?")
+		args = append(args, domain.StatusIDs[filter.Status])
+	}
+	if filter.OrderNumber != "" {
+		where = append(where, "order_number = ?")
+		args = append(args, filter.OrderNumber)
+	}
+	if filter.From != "" {
+		where = append(where, "order_date >= ?")
+		args = append(args, filter.From)
+	}
+	if filter.To != "" {
+		where = append(where, "order_date <= ?")
+		args = append(args, filter.To)
+	}
+
+	query := SqlGetAll
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += SqlGetAllOrderBy
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []domain.PurchaseOrders{}, err
+	}
+
+	defer rows.Close()
+
+	purchaseOrders := []domain.PurchaseOrders{}
+	for rows.Next() {
+		var purchaseOrder domain.PurchaseOrders
+
+		err = rows.Scan(&purchaseOrder.ID, &purchaseOrder.OrderNumber, &purchaseOrder.OrderDate,
+			&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId,
+			&purchaseOrder.TaxRate)
+		if err != nil {
+			return []domain.PurchaseOrders{}, err
+		}
+
+		purchaseOrders = append(purchaseOrders, purchaseOrder)
+	}
+
+	return purchaseOrders, rows.Err()
+}
+
+// Update saves the mutable fields of the purchase order while it is in the
+// created status. When the order has details they replace the saved ones,
+// and a hold replaces the stock held for the order, in the same transaction.
+func (r *repository) Update(ctx context.Context, purchaseOrder domain.PurchaseOrders, hold *reservation.Hold) (domain.PurchaseOrders, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	created := statusID(domain.STATUS_CREATED)
+
+	res, err := tx.ExecContext(ctx, SqlUpdate, purchaseOrder.OrderDate, purchaseOrder.TrackingCode,
+		purchaseOrder.BuyerId, purchaseOrder.ProductRecordId, purchaseOrder.ID, created)
+	if err != nil {
+		tx.Rollback()
+		return domain.PurchaseOrders{}, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		var editable int
+		err = tx.QueryRowContext(ctx, SqlIsEditable, purchaseOrder.ID, created).Scan(&editable)
+		if err != nil {
+			tx.Rollback()
+			return domain.PurchaseOrders{}, err
+		}
+		if editable == 0 {
+			tx.Rollback()
+			return domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_NOT_EDITABLE)
+		}
+	}
+
+	if purchaseOrder.Details != nil {
+		_, err = tx.ExecContext(ctx, SqlDeleteDetails, purchaseOrder.ID)
+		if err != nil {
+			tx.Rollback()
+			return domain.PurchaseOrders{}, err
+		}
+
+		details, err := insertDetails(ctx, tx, purchaseOrder.ID, purchaseOrder.Details)
+		if err != nil {
+			tx.Rollback()
+			return domain.PurchaseOrders{}, err
+		}
+
+		purchaseOrder.Details = details
+	}
+
+	if hold != nil {
+		if _, err = reservation.ReserveTx(ctx, tx, purchaseOrder.ID, *hold); err != nil {
+			tx.Rollback()
+			return domain.PurchaseOrders{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
 	return purchaseOrder, nil
 }
 
@@ -67,3 +235,157 @@ func (r *repository) ValidadeOrderNumber(ctx context.Context, orderNumber string
 
 	return orderExistent == "", nil
 }
+
+func (r *repository) GetDetails(ctx context.Context, purchaseOrderID int) ([]domain.OrderDetail, error) {
+	details := []domain.OrderDetail{}
+
+	rows, err := r.db.QueryContext(ctx, SqlGetDetails, purchaseOrderID)
+	if err != nil {
+		return details, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var detail domain.OrderDetail
+
+		err = rows.Scan(&detail.ID, &detail.CleanLinesStatus, &detail.Quantity, &detail.Temperature,
+			&detail.ProductRecordId, &detail.ProductId, &detail.PurchaseOrderId, &detail.UnitPrice)
+		if err != nil {
+			return []domain.OrderDetail{}, err
+		}
+
+		details = append(details, detail)
+	}
+
+	return details, rows.Err()
+}
+
+// GetProductRecord returns the product record with the sale price of its
+// product at the date. Products without a price at the date aren't found,
+// even if they were priced later.
+func (r *repository) GetProductRecord(ctx context.Context, id int, date string) (domain.ProductRecord, error) {
+	var productRecord domain.ProductRecord
+	var salePrice sql.NullFloat64
+
+	err := r.db.QueryRowContext(ctx, SqlGetProductRecord, date, id).Scan(&productRecord.ID, &productRecord.ProductID, &salePrice,
+		&productRecord.RecommendedTemperature)
+	if err == sql.ErrNoRows || (err == nil && !salePrice.Valid) {
+		return domain.ProductRecord{}, fmt.Errorf(domain.ERROR_PRODUCT_RECORD_NOT_FOUND)
+	}
+	if err != nil {
+		return domain.ProductRecord{}, err
+	}
+
+	productRecord.SalePrice = salePrice.Float64
+
+	return productRecord, nil
+}
+
+func (r *repository) GetStatusHistory(ctx context.Context, purchaseOrderID int) ([]domain.StatusChange, error) {
+	history := []domain.StatusChange{}
+
+	rows, err := r.db.QueryContext(ctx, SqlGetStatusHistory, purchaseOrderID)
+	if err != nil {
+		return history, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var change domain.StatusChange
+
+		err = rows.Scan(&change.ID, &change.PurchaseOrderId, &change.FromStatus, &change.ToStatus, &change.ChangedAt)
+		if err != nil {
+			return []domain.StatusChange{}, err
+		}
+
+		history = append(history, change)
+	}
+
+	return history, rows.Err()
+}
+
+// ChangeStatus moves the purchase order to the new status, saves the change
+// in its history and replaces the stock held for the order with the hold in
+// the same transaction. It fails with an invalid transition when the order
+// is no longer in the previous status.
+func (r *repository) ChangeStatus(ctx context.Context, change domain.StatusChange, hold *reservation.Hold) (domain.StatusChange, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.StatusChange{}, err
+	}
+
+	res, err := tx.ExecContext(ctx, SqlUpdateStatus, statusID(change.ToStatus), change.PurchaseOrderId,
+		statusID(change.FromStatus))
+	if err != nil {
+		tx.Rollback()
+		return domain.StatusChange{}, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		tx.Rollback()
+		return domain.StatusChange{}, fmt.Errorf(domain.ERROR_INVALID_TRANSITION)
+	}
+
+	res, err = tx.ExecContext(ctx, SqlCreateStatusChange, change.PurchaseOrderId, statusID(change.FromStatus),
+		statusID(change.ToStatus), change.ChangedAt)
+	if err != nil {
+		tx.Rollback()
+		return domain.StatusChange{}, err
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil || lastID < 1 {
+		tx.Rollback()
+		return domain.StatusChange{}, fmt.Errorf(domain.ERROR_WHILE_SAVING)
+	}
+
+	if hold != nil {
+		if _, err = reservation.ReserveTx(ctx, tx, change.PurchaseOrderId, *hold); err != nil {
+			tx.Rollback()
+			return domain.StatusChange{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.StatusChange{}, err
+	}
+
+	change.ID = int(lastID)
+
+	return change, nil
+}
+
+// statusID returns the order_status id of the status, or nil for the empty
+// status of the first history entry.
+func statusID(status string) interface{} {
+	if status == "" {
+		return nil
+	}
+	return domain.StatusIDs[status]
+}
+
+func insertDetails(ctx context.Context, tx *sql.Tx, purchaseOrderID int, details []domain.OrderDetail) ([]domain.OrderDetail, error) {
+	saved := make([]domain.OrderDetail, 0, len(details))
+
+	for _, detail := range details {
+		res, err := tx.ExecContext(ctx, SqlCreateDetail, detail.CleanLinesStatus, detail.Quantity,
+			detail.Temperature, detail.ProductRecordId, purchaseOrderID, detail.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+
+		detailID, err := res.LastInsertId()
+		if err != nil || detailID < 1 {
+			return nil, fmt.Errorf(domain.ERROR_WHILE_SAVING)
+		}
+
+		detail.ID = int(detailID)
+		detail.PurchaseOrderId = purchaseOrderID
+		saved = append(saved, detail)
+	}
+
+	return saved, nil
+}
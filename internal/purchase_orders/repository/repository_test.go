@@ -7,6 +7,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain"
 	purchaseOrdersRepo "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/repository"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
 	"github.com/stretchr/testify/assert"
 	"regexp"
 	"testing"
@@ -37,6 +38,7 @@ func TestDBRepositoryGetById(t *testing.T) {
 			"buyer_id",
 			"product_record_id",
 			"order_status_id",
+			"tax_rate",
 		}).AddRow(
 			mockBuyers[0].ID,
 			mockBuyers[0].OrderNumber,
@@ -45,6 +47,7 @@ func TestDBRepositoryGetById(t *testing.T) {
 			mockBuyers[0].BuyerId,
 			mockBuyers[0].ProductRecordId,
 			mockBuyers[0].OrderStatusId,
+			mockBuyers[0].TaxRate,
 		)
 
 		stmt := mock.ExpectPrepare(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetById))
@@ -84,27 +87,49 @@ func TestDBRepositoryGetById(t *testing.T) {
 }
 
 func TestRepositoryCreate(t *testing.T) {
+	expiresAt := "2022-08-02 10:00:00"
+	hold := reservation.Hold{Items: []reservation.Item{{ProductID: 3, Quantity: 2}}, CreatedAt: "2022-08-01 10:00:00",
+		ExpiresAt: &expiresAt}
+
 	t.Run("create_ok", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		assert.NoError(t, err)
 		defer db.Close()
 		purchaseOrder := createBaseData()[0]
+		purchaseOrder.TaxRate = 0.1
+		purchaseOrder.Details = []domain.OrderDetail{{CleanLinesStatus: "ok", Quantity: 2, Temperature: -10, ProductRecordId: 1, UnitPrice: 10.5}}
+		purchaseOrder.StatusHistory = []domain.StatusChange{{ToStatus: domain.STATUS_CREATED, ChangedAt: "2022-08-01 10:00:00"}}
+		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreate)).WithArgs(&purchaseOrder.OrderNumber, &purchaseOrder.OrderDate,
-			&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId).WillReturnResult(sqlmock.NewResult(1, 1))
+			&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId, &purchaseOrder.TaxRate).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreateDetail)).WithArgs("ok", 2, -10.0, 1, 1, 10.5).WillReturnResult(sqlmock.NewResult(3, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreateStatusChange)).WithArgs(1, nil, 1, "2022-08-01 10:00:00").WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseByPurchaseOrder)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(reservation.SqlAvailableBatches)).WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "available"}).AddRow(8, 5))
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlCreate)).
+			WithArgs(1, 8, 2, reservation.STATUS_ACTIVE, "2022-08-01 10:00:00", &expiresAt).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
 		repo := purchaseOrdersRepo.NewRepository(db)
-		result, err := repo.Create(context.Background(), purchaseOrder)
+		result, err := repo.Create(context.Background(), purchaseOrder, hold)
 		assert.NoError(t, err)
-		assert.Equal(t, result, purchaseOrder)
+		assert.Equal(t, 1, result.ID)
+		assert.Equal(t, 3, result.Details[0].ID)
+		assert.Equal(t, 1, result.Details[0].PurchaseOrderId)
+		assert.Equal(t, 5, result.StatusHistory[0].ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 	t.Run("create_fail_last_id", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		assert.NoError(t, err)
 		defer db.Close()
 		purchaseOrder := createBaseData()[0]
+		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreate)).WithArgs(&purchaseOrder.OrderNumber, &purchaseOrder.OrderDate,
-			&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId).WillReturnResult(sqlmock.NewResult(0, 1))
+			&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId, &purchaseOrder.TaxRate).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
 		repo := purchaseOrdersRepo.NewRepository(db)
-		result, err := repo.Create(context.Background(), purchaseOrder)
+		result, err := repo.Create(context.Background(), purchaseOrder, reservation.Hold{})
 		assert.Error(t, err)
 		assert.Equal(t, result, domain.PurchaseOrders{})
 	})
@@ -113,10 +138,12 @@ func TestRepositoryCreate(t *testing.T) {
 		assert.NoError(t, err)
 		defer db.Close()
 		purchaseOrder := createBaseData()[0]
+		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreate)).WithArgs(&purchaseOrder.OrderNumber, &purchaseOrder.OrderDate,
-			&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId).WillReturnError(sql.ErrNoRows)
+			&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId, &purchaseOrder.TaxRate).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
 		repo := purchaseOrdersRepo.NewRepository(db)
-		result, err := repo.Create(context.Background(), purchaseOrder)
+		result, err := repo.Create(context.Background(), purchaseOrder, reservation.Hold{})
 		assert.Error(t, err)
 		assert.Equal(t, result, domain.PurchaseOrders{})
 	})
@@ -125,12 +152,339 @@ func TestRepositoryCreate(t *testing.T) {
 		assert.NoError(t, err)
 		defer db.Close()
 		purchaseOrder := createBaseData()[0]
+		mock.ExpectBegin()
 		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreate)).WithArgs(&purchaseOrder.OrderNumber, &purchaseOrder.OrderDate,
-			&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId).WillReturnResult(sqlmock.NewResult(1, 0))
+			&purchaseOrder.TrackingCode, &purchaseOrder.BuyerId, &purchaseOrder.ProductRecordId, &purchaseOrder.OrderStatusId, &purchaseOrder.TaxRate).WillReturnResult(sqlmock.NewResult(1, 0))
+		mock.ExpectRollback()
 
 		repo := purchaseOrdersRepo.NewRepository(db)
-		result, err := repo.Create(context.Background(), purchaseOrder)
+		result, err := repo.Create(context.Background(), purchaseOrder, reservation.Hold{})
+		assert.Equal(t, result, domain.PurchaseOrders{})
+	})
+	t.Run("create_fail_detail_rolls_back", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.Details = []domain.OrderDetail{{Quantity: 2, Temperature: -10, ProductRecordId: 1}}
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreate)).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreateDetail)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.Create(context.Background(), purchaseOrder, reservation.Hold{})
+		assert.Equal(t, sql.ErrConnDone, err)
 		assert.Equal(t, result, domain.PurchaseOrders{})
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("create_fail_insufficient_stock_rolls_back", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.Details = []domain.OrderDetail{{Quantity: 2, Temperature: -10, ProductRecordId: 1}}
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreate)).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreateDetail)).WillReturnResult(sqlmock.NewResult(3, 1))
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseByPurchaseOrder)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(reservation.SqlAvailableBatches)).WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "available"}).AddRow(8, 1))
+		mock.ExpectRollback()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.Create(context.Background(), purchaseOrder, hold)
+		assert.Equal(t, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK), err)
+		assert.Equal(t, result, domain.PurchaseOrders{})
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryGetDetails(t *testing.T) {
+	t.Run("get_details_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"id", "clean_lines_status", "quantity", "temperature", "product_record_id", "product_id", "purchase_order_id", "sale_price"}).
+			AddRow(1, "ok", 2, -10.0, 1, 3, 1, 10.5).
+			AddRow(2, "", 4, 2.0, 2, 4, 1, 2.0)
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetDetails)).WithArgs(1).WillReturnRows(rows)
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.GetDetails(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.OrderDetail{
+			{ID: 1, CleanLinesStatus: "ok", Quantity: 2, Temperature: -10, ProductRecordId: 1, ProductId: 3, PurchaseOrderId: 1, UnitPrice: 10.5},
+			{ID: 2, Quantity: 4, Temperature: 2, ProductRecordId: 2, ProductId: 4, PurchaseOrderId: 1, UnitPrice: 2},
+		}, result)
+	})
+	t.Run("get_details_fail_query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetDetails)).WithArgs(1).WillReturnError(sql.ErrConnDone)
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.GetDetails(context.Background(), 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryGetProductRecord(t *testing.T) {
+	t.Run("get_product_record_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"id", "product_id", "sale_price", "recommended_freezing_temperature"}).AddRow(1, 3, 10.5, -5.0)
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetProductRecord)).WithArgs("2008-11-11 00:00:00", 1).WillReturnRows(rows)
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.GetProductRecord(context.Background(), 1, "2008-11-11 00:00:00")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ProductRecord{ID: 1, ProductID: 3, SalePrice: 10.5, RecommendedTemperature: -5}, result)
+	})
+	t.Run("get_product_record_not_found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetProductRecord)).WithArgs("2008-11-11 00:00:00", 1).WillReturnError(sql.ErrNoRows)
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.GetProductRecord(context.Background(), 1, "2008-11-11 00:00:00")
+		assert.Equal(t, fmt.Errorf(domain.ERROR_PRODUCT_RECORD_NOT_FOUND), err)
+	})
+	t.Run("get_product_record_not_priced_at_date", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"id", "product_id", "sale_price", "recommended_freezing_temperature"}).AddRow(1, 3, nil, -5.0)
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetProductRecord)).WithArgs("2008-11-11 00:00:00", 1).WillReturnRows(rows)
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.GetProductRecord(context.Background(), 1, "2008-11-11 00:00:00")
+		assert.Equal(t, fmt.Errorf(domain.ERROR_PRODUCT_RECORD_NOT_FOUND), err)
+	})
+}
+
+func TestRepositoryGetStatusHistory(t *testing.T) {
+	t.Run("get_status_history_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		rows := sqlmock.NewRows([]string{"id", "purchase_order_id", "from_status", "to_status", "changed_at"}).
+			AddRow(1, 1, "", domain.STATUS_CREATED, "2022-08-01 10:00:00").
+			AddRow(2, 1, domain.STATUS_CREATED, domain.STATUS_CONFIRMED, "2022-08-01 11:00:00")
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetStatusHistory)).WithArgs(1).WillReturnRows(rows)
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.GetStatusHistory(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.StatusChange{
+			{ID: 1, PurchaseOrderId: 1, ToStatus: domain.STATUS_CREATED, ChangedAt: "2022-08-01 10:00:00"},
+			{ID: 2, PurchaseOrderId: 1, FromStatus: domain.STATUS_CREATED, ToStatus: domain.STATUS_CONFIRMED, ChangedAt: "2022-08-01 11:00:00"},
+		}, result)
+	})
+	t.Run("get_status_history_fail_query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetStatusHistory)).WithArgs(1).WillReturnError(sql.ErrConnDone)
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.GetStatusHistory(context.Background(), 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryChangeStatus(t *testing.T) {
+	change := domain.StatusChange{PurchaseOrderId: 1, FromStatus: domain.STATUS_CREATED,
+		ToStatus: domain.STATUS_CONFIRMED, ChangedAt: "2022-08-01 11:00:00"}
+
+	t.Run("change_status_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdateStatus)).WithArgs(2, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreateStatusChange)).WithArgs(1, 1, 2, change.ChangedAt).WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.ChangeStatus(context.Background(), change, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("change_status_concurrent_change", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdateStatus)).WithArgs(2, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.ChangeStatus(context.Background(), change, nil)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_TRANSITION), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("change_status_fail_history", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdateStatus)).WithArgs(2, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreateStatusChange)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.ChangeStatus(context.Background(), change, nil)
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("change_status_releases_stock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		cancel := domain.StatusChange{PurchaseOrderId: 1, FromStatus: domain.STATUS_CONFIRMED,
+			ToStatus: domain.STATUS_CANCELLED, ChangedAt: "2022-08-01 11:00:00"}
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdateStatus)).WithArgs(6, 1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreateStatusChange)).WithArgs(1, 2, 6, cancel.ChangedAt).WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseByPurchaseOrder)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.ChangeStatus(context.Background(), cancel, &reservation.Hold{})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("ship_partially_picked_releases_the_rest", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		ship := domain.StatusChange{PurchaseOrderId: 1, FromStatus: domain.STATUS_PICKING,
+			ToStatus: domain.STATUS_SHIPPED, ChangedAt: "2022-08-02 09:00:00"}
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdateStatus)).WithArgs(4, 1, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreateStatusChange)).WithArgs(1, 3, 4, ship.ChangedAt).WillReturnResult(sqlmock.NewResult(3, 1))
+		// Only the reservations still active are released; the picked ones
+		// were consumed by the picks.
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseByPurchaseOrder)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.ChangeStatus(context.Background(), ship, &reservation.Hold{})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryGetAll(t *testing.T) {
+	columns := []string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id", "order_status_id", "tax_rate"}
+
+	t.Run("get_all_without_filter", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		purchasesData := createBaseData()
+		rows := sqlmock.NewRows(columns).AddRow(1, "Order1", "2008-11-11", "1", 1, 1, 1, 0)
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetAll + purchaseOrdersRepo.SqlGetAllOrderBy)).WillReturnRows(rows)
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.GetAll(context.Background(), domain.Filter{})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.PurchaseOrders{purchasesData[0]}, result)
+	})
+	t.Run("get_all_with_filters", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		query := purchaseOrdersRepo.SqlGetAll + " WHERE buyer_id = ? AND order_status_id = ? AND order_number = ?" +
+			" AND order_date >= ? AND order_date <= ?" + purchaseOrdersRepo.SqlGetAllOrderBy
+		mock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(1, 4, "Order1", "2008-11-01 00:00:00", "2008-11-30 23:59:59").
+			WillReturnRows(sqlmock.NewRows(columns))
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.GetAll(context.Background(), domain.Filter{BuyerId: 1, Status: domain.STATUS_SHIPPED,
+			OrderNumber: "Order1", From: "2008-11-01 00:00:00", To: "2008-11-30 23:59:59"})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.PurchaseOrders{}, result)
+	})
+	t.Run("get_all_fail_query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlGetAll)).WillReturnError(sql.ErrConnDone)
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.GetAll(context.Background(), domain.Filter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	t.Run("update_with_details", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.Details = []domain.OrderDetail{{Quantity: 4, Temperature: 2, ProductRecordId: 1, UnitPrice: 2}}
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdate)).WithArgs("2008-11-11", "1", 1, 1, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlDeleteDetails)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlCreateDetail)).WithArgs("", 4, 2.0, 1, 1, 2.0).WillReturnResult(sqlmock.NewResult(7, 1))
+		mock.ExpectCommit()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.Update(context.Background(), purchaseOrder, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, result.Details[0].ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("update_without_details", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		purchaseOrder := createBaseData()[0]
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdate)).WithArgs("2008-11-11", "1", 1, 1, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.Update(context.Background(), purchaseOrder, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("update_fail_details_rolls_back", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.Details = []domain.OrderDetail{{Quantity: 4, Temperature: 2, ProductRecordId: 1}}
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdate)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlDeleteDetails)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.Update(context.Background(), purchaseOrder, nil)
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.Equal(t, domain.PurchaseOrders{}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("update_same_values", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		purchaseOrder := createBaseData()[0]
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdate)).WithArgs("2008-11-11", "1", 1, 1, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlIsEditable)).WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectCommit()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		_, err = repo.Update(context.Background(), purchaseOrder, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("update_no_longer_created", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.Details = []domain.OrderDetail{{Quantity: 4, Temperature: 2, ProductRecordId: 1}}
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(purchaseOrdersRepo.SqlUpdate)).WithArgs("2008-11-11", "1", 1, 1, 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(purchaseOrdersRepo.SqlIsEditable)).WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectRollback()
+		repo := purchaseOrdersRepo.NewRepository(db)
+		result, err := repo.Update(context.Background(), purchaseOrder, nil)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_NOT_EDITABLE), err)
+		assert.Equal(t, domain.PurchaseOrders{}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -3,15 +3,62 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
+
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
+)
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04:05"
 )
 
 type service struct {
-	repository domain.Repository
+	repository   domain.Repository
+	taxRate      float64
+	reservations reservation.Service
 }
 
-func NewService(r domain.Repository) domain.Service {
-	return &service{r}
+// NewService returns the purchase orders service. The tax rate (0.1 is 10%)
+// is charged on the subtotal of the orders created from then on. The stock
+// of the ordered products is reserved through the reservations service.
+func NewService(r domain.Repository, taxRate float64, reservations reservation.Service) domain.Service {
+	return &service{r, taxRate, reservations}
+}
+
+func (s *service) GetAll(ctx context.Context, filter domain.Filter) ([]domain.PurchaseOrders, error) {
+	if filter.Status != "" {
+		if _, ok := domain.StatusIDs[filter.Status]; !ok {
+			return []domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_INVALID_STATUS)
+		}
+	}
+	if filter.From != "" {
+		from, err := time.Parse(dateLayout, filter.From)
+		if err != nil {
+			return []domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_INVALID_DATE)
+		}
+		filter.From = from.Format(dateTimeLayout)
+	}
+	if filter.To != "" {
+		to, err := time.Parse(dateLayout, filter.To)
+		if err != nil {
+			return []domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_INVALID_DATE)
+		}
+		filter.To = to.Add(24*time.Hour - time.Second).Format(dateTimeLayout)
+	}
+
+	purchaseOrders, err := s.repository.GetAll(ctx, filter)
+	if err != nil {
+		return []domain.PurchaseOrders{}, err
+	}
+
+	for i := range purchaseOrders {
+		purchaseOrders[i].Status = domain.StatusName(purchaseOrders[i].OrderStatusId)
+	}
+
+	return purchaseOrders, nil
 }
 
 func (s *service) GetById(ctx context.Context, id int) (domain.PurchaseOrders, error) {
@@ -19,9 +66,26 @@ func (s *service) GetById(ctx context.Context, id int) (domain.PurchaseOrders, e
 	if err != nil {
 		return domain.PurchaseOrders{}, err
 	}
-	return purchaseOrders, nil
+
+	details, err := s.repository.GetDetails(ctx, id)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	history, err := s.repository.GetStatusHistory(ctx, id)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	purchaseOrders.Status = domain.StatusName(purchaseOrders.OrderStatusId)
+	purchaseOrders.StatusHistory = history
+
+	return withTotals(purchaseOrders, details), nil
 }
 
+// Create validates every detail against its product record before saving.
+// Every order starts in the created status, holding the stock of its
+// products until it is confirmed or the hold expires.
 func (s *service) Create(ctx context.Context, purchaseOrder domain.PurchaseOrders) (domain.PurchaseOrders, error) {
 	isValid, err := s.repository.ValidadeOrderNumber(ctx, purchaseOrder.OrderNumber)
 	if err != nil {
@@ -31,9 +95,237 @@ func (s *service) Create(ctx context.Context, purchaseOrder domain.PurchaseOrder
 		return domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_UNIQUE_ORDER_NUMBER)
 	}
 
-	newPurchaseOrder, err := s.repository.Create(ctx, purchaseOrder)
+	details, err := s.validateDetails(ctx, purchaseOrder.Details, purchaseOrder.OrderDate)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	if err = s.reservations.Check(ctx, reservationItems(details)); err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	hold, err := s.reservations.NewHold(reservationItems(details), true)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	purchaseOrder.Details = details
+	purchaseOrder.TaxRate = s.taxRate
+	purchaseOrder.ProductRecordId = purchaseOrder.Details[0].ProductRecordId
+	purchaseOrder.OrderStatusId = domain.StatusIDs[domain.STATUS_CREATED]
+	purchaseOrder.Status = domain.STATUS_CREATED
+	purchaseOrder.StatusHistory = []domain.StatusChange{
+		{ToStatus: domain.STATUS_CREATED, ChangedAt: time.Now().Format(dateTimeLayout)},
+	}
+
+	// Another order may take the stock after it was checked; the order is
+	// then not saved at all.
+	newPurchaseOrder, err := s.repository.Create(ctx, purchaseOrder, hold)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	return withTotals(newPurchaseOrder, newPurchaseOrder.Details), nil
+}
+
+// Update changes the order date, tracking code, buyer and details of a
+// purchase order that is still in the created status. Empty fields keep
+// their saved values; new details replace all of the saved ones. A new order
+// date prices the details again at that date, and new details replace the
+// stock held for the order.
+func (s *service) Update(ctx context.Context, id int, changes domain.PurchaseOrders) (domain.PurchaseOrders, error) {
+	purchaseOrder, err := s.repository.GetById(ctx, id)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	if domain.StatusName(purchaseOrder.OrderStatusId) != domain.STATUS_CREATED {
+		return domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_NOT_EDITABLE)
+	}
+
+	replacesDetails := changes.Details != nil
+
+	if changes.OrderDate != "" && changes.OrderDate != purchaseOrder.OrderDate {
+		purchaseOrder.OrderDate = changes.OrderDate
+
+		if changes.Details == nil {
+			changes.Details, err = s.repository.GetDetails(ctx, id)
+			if err != nil {
+				return domain.PurchaseOrders{}, err
+			}
+		}
+	}
+	if changes.TrackingCode != "" {
+		purchaseOrder.TrackingCode = changes.TrackingCode
+	}
+	if changes.BuyerId != 0 {
+		purchaseOrder.BuyerId = changes.BuyerId
+	}
+	if changes.Details != nil {
+		details, err := s.validateDetails(ctx, changes.Details, purchaseOrder.OrderDate)
+		if err != nil {
+			return domain.PurchaseOrders{}, err
+		}
+		purchaseOrder.Details = details
+		purchaseOrder.ProductRecordId = details[0].ProductRecordId
+	}
+
+	var hold *reservation.Hold
+	if replacesDetails {
+		newHold, err := s.reservations.NewHold(reservationItems(purchaseOrder.Details), true)
+		if err != nil {
+			return domain.PurchaseOrders{}, err
+		}
+		hold = &newHold
+	}
+
+	_, err = s.repository.Update(ctx, purchaseOrder, hold)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	return s.GetById(ctx, id)
+}
+
+// Cancel moves the purchase order to the cancelled status, which the
+// lifecycle only allows before the order is shipped.
+func (s *service) Cancel(ctx context.Context, id int) (domain.PurchaseOrders, error) {
+	return s.Transition(ctx, id, domain.STATUS_CANCELLED)
+}
+
+// Transition moves the purchase order to the given status when the
+// transition is allowed from its current status. Confirming the order keeps
+// its stock reserved without expiring. Shipping, cancelling or returning it
+// releases the stock still reserved, which a shipped order left unpicked;
+// stock already picked stays consumed, since returned products have to be
+// received again as a new batch.
+func (s *service) Transition(ctx context.Context, id int, status string) (domain.PurchaseOrders, error) {
+	if _, ok := domain.Transitions[status]; !ok {
+		return domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_INVALID_STATUS)
+	}
+
+	purchaseOrder, err := s.repository.GetById(ctx, id)
+	if err != nil {
+		return domain.PurchaseOrders{}, err
+	}
+
+	current := domain.StatusName(purchaseOrder.OrderStatusId)
+	if !canTransition(current, status) {
+		return domain.PurchaseOrders{}, fmt.Errorf(domain.ERROR_INVALID_TRANSITION)
+	}
+
+	var hold *reservation.Hold
+	switch status {
+	case domain.STATUS_CONFIRMED:
+		details, err := s.repository.GetDetails(ctx, id)
+		if err != nil {
+			return domain.PurchaseOrders{}, err
+		}
+
+		newHold, err := s.reservations.NewHold(reservationItems(details), false)
+		if err != nil {
+			return domain.PurchaseOrders{}, err
+		}
+		hold = &newHold
+	case domain.STATUS_SHIPPED, domain.STATUS_CANCELLED, domain.STATUS_RETURNED:
+		hold = &reservation.Hold{}
+	}
+
+	_, err = s.repository.ChangeStatus(ctx, domain.StatusChange{PurchaseOrderId: id, FromStatus: current,
+		ToStatus: status, ChangedAt: time.Now().Format(dateTimeLayout)}, hold)
 	if err != nil {
 		return domain.PurchaseOrders{}, err
 	}
-	return newPurchaseOrder, nil
+
+	return s.GetById(ctx, id)
+}
+
+// validateDetails checks that there is at least one detail and that every
+// detail has a positive quantity and a temperature not above the recommended
+// freezing temperature of its product. The returned copy carries the sale
+// prices of the products at the order date.
+func (s *service) validateDetails(ctx context.Context, details []domain.OrderDetail, orderDate string) ([]domain.OrderDetail, error) {
+	if len(details) == 0 {
+		return nil, fmt.Errorf(domain.ERROR_NO_DETAILS)
+	}
+
+	pricingDate, err := parseOrderDate(orderDate)
+	if err != nil {
+		return nil, err
+	}
+
+	details = append([]domain.OrderDetail{}, details...)
+
+	for i, detail := range details {
+		if detail.Quantity <= 0 {
+			return nil, fmt.Errorf(domain.ERROR_INVALID_QUANTITY)
+		}
+
+		productRecord, err := s.repository.GetProductRecord(ctx, detail.ProductRecordId, pricingDate)
+		if err != nil {
+			return nil, err
+		}
+
+		if detail.Temperature > productRecord.RecommendedTemperature {
+			return nil, fmt.Errorf(domain.ERROR_TEMPERATURE_TOO_HIGH)
+		}
+
+		details[i].ID = 0
+		details[i].ProductId = productRecord.ProductID
+		details[i].UnitPrice = productRecord.SalePrice
+	}
+
+	return details, nil
+}
+
+// parseOrderDate returns the order date in the format of the database, so
+// it can be compared with the dates of the product records.
+func parseOrderDate(orderDate string) (string, error) {
+	for _, layout := range []string{time.RFC3339, dateTimeLayout, dateLayout} {
+		if date, err := time.Parse(layout, orderDate); err == nil {
+			return date.Format(dateTimeLayout), nil
+		}
+	}
+	return "", fmt.Errorf(domain.ERROR_INVALID_ORDER_DATE)
+}
+
+func reservationItems(details []domain.OrderDetail) []reservation.Item {
+	items := make([]reservation.Item, len(details))
+	for i, detail := range details {
+		items[i] = reservation.Item{ProductID: detail.ProductId, Quantity: detail.Quantity}
+	}
+	return items
+}
+
+func canTransition(from, to string) bool {
+	for _, status := range domain.Transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// withTotals sets the line totals, the subtotal, the tax on the subtotal at
+// the tax rate of the order and the total price.
+func withTotals(purchaseOrder domain.PurchaseOrders, details []domain.OrderDetail) domain.PurchaseOrders {
+	purchaseOrder.TotalQuantity = 0
+	purchaseOrder.Subtotal = 0
+
+	for i := range details {
+		details[i].Total = round(details[i].UnitPrice * float64(details[i].Quantity))
+		purchaseOrder.TotalQuantity += details[i].Quantity
+		purchaseOrder.Subtotal += details[i].Total
+	}
+
+	purchaseOrder.Details = details
+	purchaseOrder.Subtotal = round(purchaseOrder.Subtotal)
+	purchaseOrder.Tax = round(purchaseOrder.Subtotal * purchaseOrder.TaxRate)
+	purchaseOrder.TotalPrice = round(purchaseOrder.Subtotal + purchaseOrder.Tax)
+
+	return purchaseOrder
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
 }
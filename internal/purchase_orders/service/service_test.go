@@ -6,7 +6,10 @@ import (
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain/mocks"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/service"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
+	reservationMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
 )
 
@@ -14,17 +17,43 @@ func TestGetById(t *testing.T) {
 	t.Run("find_by_id_existent", func(t *testing.T) {
 		ctx := context.Background()
 		mockRepository := mocks.NewRepository(t)
-		newService := service.NewService(mockRepository)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
 		purchasesData := createBaseData()
 		mockRepository.On("GetById", ctx, 1).Return(purchasesData[0], nil)
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{
+			{ID: 1, Quantity: 2, Temperature: -10, ProductRecordId: 1, PurchaseOrderId: 1, UnitPrice: 10.5},
+			{ID: 2, Quantity: 3, Temperature: -5, ProductRecordId: 2, PurchaseOrderId: 1, UnitPrice: 1.1},
+		}, nil)
+		history := []domain.StatusChange{{ID: 1, PurchaseOrderId: 1, ToStatus: domain.STATUS_CREATED, ChangedAt: "2022-08-01 10:00:00"}}
+		mockRepository.On("GetStatusHistory", ctx, 1).Return(history, nil)
 		purchaseData, err := newService.GetById(ctx, 1)
 		assert.Nil(t, err)
-		assert.Equal(t, purchaseData, purchasesData[0])
+		assert.Len(t, purchaseData.Details, 2)
+		assert.Equal(t, 21.0, purchaseData.Details[0].Total)
+		assert.Equal(t, 3.3, purchaseData.Details[1].Total)
+		assert.Equal(t, 5, purchaseData.TotalQuantity)
+		assert.Equal(t, 24.3, purchaseData.TotalPrice)
+		assert.Equal(t, domain.STATUS_CREATED, purchaseData.Status)
+		assert.Equal(t, history, purchaseData.StatusHistory)
+	})
+	t.Run("find_by_id_details_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchasesData := createBaseData()
+		mockRepository.On("GetById", ctx, 1).Return(purchasesData[0], nil)
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{}, fmt.Errorf("error"))
+		purchaseData, err := newService.GetById(ctx, 1)
+		assert.Error(t, err)
+		assert.Equal(t, domain.PurchaseOrders{}, purchaseData)
 	})
 	t.Run("find_by_id_non_existent", func(t *testing.T) {
 		ctx := context.Background()
 		mockRepository := mocks.NewRepository(t)
-		serv := service.NewService(mockRepository)
+		mockReservations := reservationMocks.NewService(t)
+		serv := service.NewService(mockRepository, 0, mockReservations)
 		mockRepository.On("GetById", ctx, 10).Return(domain.PurchaseOrders{}, fmt.Errorf("purchase order with id %d not founded", 10))
 		foundedBuyer, err := serv.GetById(ctx, 10)
 		assert.Equal(t, fmt.Errorf("purchase order with id %d not founded", 10), err)
@@ -36,7 +65,8 @@ func TestCreate(t *testing.T) {
 	t.Run("create_conflict", func(t *testing.T) {
 		ctx := context.Background()
 		mockRepository := mocks.NewRepository(t)
-		newService := service.NewService(mockRepository)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
 		expected := domain.PurchaseOrders{
 			ID:              1,
 			OrderNumber:     "Order1",
@@ -53,7 +83,8 @@ func TestCreate(t *testing.T) {
 	t.Run("create_conflict_error", func(t *testing.T) {
 		ctx := context.Background()
 		mockRepository := mocks.NewRepository(t)
-		newService := service.NewService(mockRepository)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
 		expected := domain.PurchaseOrders{
 			ID:              1,
 			OrderNumber:     "Order1",
@@ -70,44 +101,230 @@ func TestCreate(t *testing.T) {
 	t.Run("create_ok", func(t *testing.T) {
 		ctx := context.Background()
 		mockRepository := mocks.NewRepository(t)
-		newService := service.NewService(mockRepository)
-		//baseData := createBaseData()
-		expected := domain.PurchaseOrders{
-			ID:              1,
-			OrderNumber:     "Order1",
-			OrderDate:       "2008-11-11",
-			TrackingCode:    "1",
-			BuyerId:         1,
-			ProductRecordId: 1,
-			OrderStatusId:   1,
-		}
-		mockRepository.On("ValidadeOrderNumber", context.Background(), expected.OrderNumber).Return(true, nil)
-		expected.ID = 1
-		mockRepository.On("Create", ctx, expected).Return(expected, nil)
-		newPurchase, err := newService.Create(ctx, expected)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createOrderWithDetails()
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		mockRepository.On("GetProductRecord", ctx, 1, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 1, ProductID: 3, SalePrice: 10.5, RecommendedTemperature: -5}, nil)
+		mockRepository.On("GetProductRecord", ctx, 2, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 2, ProductID: 4, SalePrice: 2, RecommendedTemperature: 4}, nil)
+		items := []reservation.Item{{ProductID: 3, Quantity: 2}, {ProductID: 4, Quantity: 4}}
+		hold := reservation.Hold{Items: items, CreatedAt: "2022-08-01 10:00:00"}
+		mockReservations.On("Check", ctx, items).Return(nil)
+		mockReservations.On("NewHold", items, true).Return(hold, nil)
+		saved := createOrderWithDetails()
+		saved.ID = 1
+		saved.ProductRecordId = 1
+		saved.Details[0].UnitPrice = 10.5
+		saved.Details[1].UnitPrice = 2
+		mockRepository.On("Create", ctx, mock.MatchedBy(func(p domain.PurchaseOrders) bool {
+			return p.ProductRecordId == 1 && p.Details[0].UnitPrice == 10.5 && p.Details[1].UnitPrice == 2 &&
+				p.OrderStatusId == domain.StatusIDs[domain.STATUS_CREATED] && len(p.StatusHistory) == 1 &&
+				p.StatusHistory[0].FromStatus == "" && p.StatusHistory[0].ToStatus == domain.STATUS_CREATED &&
+				p.StatusHistory[0].ChangedAt != ""
+		}), hold).Return(saved, nil)
+		newPurchase, err := newService.Create(ctx, purchaseOrder)
 		assert.Nil(t, err)
-		assert.Equal(t, expected, newPurchase)
+		assert.Equal(t, 1, newPurchase.ID)
+		assert.Equal(t, 1, newPurchase.ProductRecordId)
+		assert.Equal(t, 21.0, newPurchase.Details[0].Total)
+		assert.Equal(t, 8.0, newPurchase.Details[1].Total)
+		assert.Equal(t, 6, newPurchase.TotalQuantity)
+		assert.Equal(t, 29.0, newPurchase.TotalPrice)
+	})
+	t.Run("create_without_details", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		_, err := newService.Create(ctx, purchaseOrder)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_NO_DETAILS), err)
+	})
+	t.Run("create_invalid_quantity", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createOrderWithDetails()
+		purchaseOrder.Details[0].Quantity = 0
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		_, err := newService.Create(ctx, purchaseOrder)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_QUANTITY), err)
+	})
+	t.Run("create_product_record_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createOrderWithDetails()
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		mockRepository.On("GetProductRecord", ctx, 1, "2008-11-11 00:00:00").Return(domain.ProductRecord{}, fmt.Errorf(domain.ERROR_PRODUCT_RECORD_NOT_FOUND))
+		_, err := newService.Create(ctx, purchaseOrder)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_PRODUCT_RECORD_NOT_FOUND), err)
+	})
+	t.Run("create_temperature_too_high", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createOrderWithDetails()
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		mockRepository.On("GetProductRecord", ctx, 1, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 1, SalePrice: 10.5, RecommendedTemperature: -20}, nil)
+		_, err := newService.Create(ctx, purchaseOrder)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_TEMPERATURE_TOO_HIGH), err)
 	})
 	t.Run("create_error", func(t *testing.T) {
 		ctx := context.Background()
 		mockRepository := mocks.NewRepository(t)
-		newService := service.NewService(mockRepository)
-		expected := domain.PurchaseOrders{
-			ID:              1,
-			OrderNumber:     "Order1",
-			OrderDate:       "2008-11-11",
-			TrackingCode:    "1",
-			BuyerId:         1,
-			ProductRecordId: 1,
-			OrderStatusId:   1,
-		}
-		mockRepository.On("ValidadeOrderNumber", context.Background(), expected.OrderNumber).Return(true, nil)
-		expected.ID = 1
-		mockRepository.On("Create", ctx, expected).Return(domain.PurchaseOrders{}, fmt.Errorf("error"))
-		newPurchase, err := newService.Create(ctx, expected)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createOrderWithDetails()
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		mockRepository.On("GetProductRecord", ctx, 1, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 1, SalePrice: 10.5, RecommendedTemperature: -5}, nil)
+		mockRepository.On("GetProductRecord", ctx, 2, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 2, SalePrice: 2, RecommendedTemperature: 4}, nil)
+		mockReservations.On("Check", ctx, mock.Anything).Return(nil)
+		mockReservations.On("NewHold", mock.Anything, true).Return(reservation.Hold{}, nil)
+		mockRepository.On("Create", ctx, mock.Anything, mock.Anything).Return(domain.PurchaseOrders{}, fmt.Errorf("error"))
+		newPurchase, err := newService.Create(ctx, purchaseOrder)
 		assert.Error(t, err)
 		assert.Equal(t, newPurchase, domain.PurchaseOrders{})
 	})
+	t.Run("create_insufficient_stock", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createOrderWithDetails()
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		mockRepository.On("GetProductRecord", ctx, 1, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 1, ProductID: 3, SalePrice: 10.5, RecommendedTemperature: -5}, nil)
+		mockRepository.On("GetProductRecord", ctx, 2, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 2, ProductID: 4, SalePrice: 2, RecommendedTemperature: 4}, nil)
+		mockReservations.On("Check", ctx, mock.Anything).Return(fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK))
+		_, err := newService.Create(ctx, purchaseOrder)
+		assert.Equal(t, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK), err)
+	})
+	t.Run("create_stock_taken_saves_nothing", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createOrderWithDetails()
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		mockRepository.On("GetProductRecord", ctx, 1, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 1, ProductID: 3, SalePrice: 10.5, RecommendedTemperature: -5}, nil)
+		mockRepository.On("GetProductRecord", ctx, 2, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 2, ProductID: 4, SalePrice: 2, RecommendedTemperature: 4}, nil)
+		mockReservations.On("Check", ctx, mock.Anything).Return(nil)
+		mockReservations.On("NewHold", mock.Anything, true).Return(reservation.Hold{}, nil)
+		mockRepository.On("Create", ctx, mock.Anything, mock.Anything).Return(domain.PurchaseOrders{}, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK))
+		_, err := newService.Create(ctx, purchaseOrder)
+		assert.Equal(t, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK), err)
+	})
+}
+
+func TestTransition(t *testing.T) {
+	t.Run("transition_ok", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		items := []reservation.Item{{ProductID: 3, Quantity: 2}}
+		hold := reservation.Hold{Items: items, CreatedAt: "2022-08-01 11:00:00"}
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil).Once()
+		mockReservations.On("NewHold", items, false).Return(hold, nil)
+		mockRepository.On("ChangeStatus", ctx, mock.MatchedBy(func(c domain.StatusChange) bool {
+			return c.PurchaseOrderId == 1 && c.FromStatus == domain.STATUS_CREATED &&
+				c.ToStatus == domain.STATUS_CONFIRMED && c.ChangedAt != ""
+		}), &hold).Return(domain.StatusChange{ID: 2}, nil)
+		confirmed := createBaseData()[0]
+		confirmed.OrderStatusId = domain.StatusIDs[domain.STATUS_CONFIRMED]
+		history := []domain.StatusChange{
+			{ID: 1, PurchaseOrderId: 1, ToStatus: domain.STATUS_CREATED, ChangedAt: "2022-08-01 10:00:00"},
+			{ID: 2, PurchaseOrderId: 1, FromStatus: domain.STATUS_CREATED, ToStatus: domain.STATUS_CONFIRMED, ChangedAt: "2022-08-01 11:00:00"},
+		}
+		mockRepository.On("GetById", ctx, 1).Return(confirmed, nil).Once()
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{{ID: 1, Quantity: 2, ProductRecordId: 1, ProductId: 3}}, nil)
+		mockRepository.On("GetStatusHistory", ctx, 1).Return(history, nil)
+		result, err := newService.Transition(ctx, 1, domain.STATUS_CONFIRMED)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.STATUS_CONFIRMED, result.Status)
+		assert.Equal(t, history, result.StatusHistory)
+	})
+	t.Run("transition_invalid_status", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		_, err := newService.Transition(ctx, 1, "lost")
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_STATUS), err)
+	})
+	t.Run("transition_not_allowed", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		_, err := newService.Transition(ctx, 1, domain.STATUS_SHIPPED)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_TRANSITION), err)
+	})
+	t.Run("transition_from_final_status", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.OrderStatusId = domain.StatusIDs[domain.STATUS_CANCELLED]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		_, err := newService.Transition(ctx, 1, domain.STATUS_CONFIRMED)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_TRANSITION), err)
+	})
+	t.Run("transition_order_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		mockRepository.On("GetById", ctx, 10).Return(domain.PurchaseOrders{}, fmt.Errorf("purchase order with id (10) not founded"))
+		_, err := newService.Transition(ctx, 10, domain.STATUS_CONFIRMED)
+		assert.Equal(t, fmt.Errorf("purchase order with id (10) not founded"), err)
+	})
+	t.Run("transition_change_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		mockRepository.On("ChangeStatus", ctx, mock.Anything, &reservation.Hold{}).Return(domain.StatusChange{}, fmt.Errorf(domain.ERROR_INVALID_TRANSITION))
+		_, err := newService.Transition(ctx, 1, domain.STATUS_CANCELLED)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_TRANSITION), err)
+	})
+	t.Run("transition_confirm_insufficient_stock", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{{ID: 1, Quantity: 2, ProductRecordId: 1, ProductId: 3}}, nil)
+		mockReservations.On("NewHold", mock.Anything, false).Return(reservation.Hold{}, nil)
+		mockRepository.On("ChangeStatus", ctx, mock.Anything, mock.Anything).Return(domain.StatusChange{}, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK))
+		_, err := newService.Transition(ctx, 1, domain.STATUS_CONFIRMED)
+		assert.Equal(t, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK), err)
+	})
+}
+
+func createOrderWithDetails() domain.PurchaseOrders {
+	return domain.PurchaseOrders{
+		OrderNumber:   "Order1",
+		OrderDate:     "2008-11-11",
+		TrackingCode:  "1",
+		BuyerId:       1,
+		OrderStatusId: 1,
+		Details: []domain.OrderDetail{
+			{Quantity: 2, Temperature: -10, ProductRecordId: 1, CleanLinesStatus: "ok"},
+			{Quantity: 4, Temperature: 2, ProductRecordId: 2},
+		},
+	}
 }
 
 func createBaseData() []domain.PurchaseOrders {
@@ -133,3 +350,278 @@ func createBaseData() []domain.PurchaseOrders {
 	purchases = append(purchases, purchaseOne, purchaseTwo)
 	return purchases
 }
+
+func TestGetAll(t *testing.T) {
+	t.Run("get_all_ok", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrders := createBaseData()
+		purchaseOrders[1].OrderStatusId = domain.StatusIDs[domain.STATUS_SHIPPED]
+		mockRepository.On("GetAll", ctx, domain.Filter{BuyerId: 1, Status: domain.STATUS_CREATED,
+			From: "2008-11-01 00:00:00", To: "2008-11-30 23:59:59"}).Return(purchaseOrders, nil)
+		result, err := newService.GetAll(ctx, domain.Filter{BuyerId: 1, Status: domain.STATUS_CREATED,
+			From: "2008-11-01", To: "2008-11-30"})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.STATUS_CREATED, result[0].Status)
+		assert.Equal(t, domain.STATUS_SHIPPED, result[1].Status)
+	})
+	t.Run("get_all_invalid_status", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		_, err := newService.GetAll(ctx, domain.Filter{Status: "lost"})
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_STATUS), err)
+	})
+	t.Run("get_all_invalid_date", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		_, err := newService.GetAll(ctx, domain.Filter{To: "30/11/2008"})
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_DATE), err)
+	})
+	t.Run("get_all_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		mockRepository.On("GetAll", ctx, domain.Filter{}).Return([]domain.PurchaseOrders{}, fmt.Errorf("error"))
+		_, err := newService.GetAll(ctx, domain.Filter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("update_ok", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		mockRepository.On("GetProductRecord", ctx, 2, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 2, SalePrice: 2, RecommendedTemperature: 4}, nil)
+		mockRepository.On("Update", ctx, mock.MatchedBy(func(p domain.PurchaseOrders) bool {
+			return p.ID == 1 && p.OrderNumber == "Order1" && p.OrderDate == "2008-11-11" && p.TrackingCode == "XYZ" &&
+				p.BuyerId == 1 && p.ProductRecordId == 2 && len(p.Details) == 1 && p.Details[0].UnitPrice == 2
+		}), &reservation.Hold{}).Return(domain.PurchaseOrders{}, nil)
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{
+			{ID: 3, Quantity: 4, Temperature: 2, ProductRecordId: 2, PurchaseOrderId: 1, UnitPrice: 2},
+		}, nil)
+		mockReservations.On("NewHold", mock.Anything, true).Return(reservation.Hold{}, nil)
+		mockRepository.On("GetStatusHistory", ctx, 1).Return([]domain.StatusChange{}, nil)
+		result, err := newService.Update(ctx, 1, domain.PurchaseOrders{TrackingCode: "XYZ",
+			Details: []domain.OrderDetail{{Quantity: 4, Temperature: 2, ProductRecordId: 2}}})
+		assert.NoError(t, err)
+		assert.Equal(t, 8.0, result.TotalPrice)
+	})
+	t.Run("update_not_editable", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.OrderStatusId = domain.StatusIDs[domain.STATUS_CONFIRMED]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		_, err := newService.Update(ctx, 1, domain.PurchaseOrders{TrackingCode: "XYZ"})
+		assert.Equal(t, fmt.Errorf(domain.ERROR_NOT_EDITABLE), err)
+	})
+	t.Run("update_invalid_detail", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		_, err := newService.Update(ctx, 1, domain.PurchaseOrders{Details: []domain.OrderDetail{{ProductRecordId: 2}}})
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_QUANTITY), err)
+	})
+	t.Run("update_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		mockRepository.On("GetById", ctx, 10).Return(domain.PurchaseOrders{}, fmt.Errorf("purchase order with id (10) not founded"))
+		_, err := newService.Update(ctx, 10, domain.PurchaseOrders{TrackingCode: "XYZ"})
+		assert.Equal(t, fmt.Errorf("purchase order with id (10) not founded"), err)
+	})
+	t.Run("update_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		mockRepository.On("Update", ctx, mock.Anything, (*reservation.Hold)(nil)).Return(domain.PurchaseOrders{}, fmt.Errorf("error"))
+		_, err := newService.Update(ctx, 1, domain.PurchaseOrders{TrackingCode: "XYZ"})
+		assert.Error(t, err)
+	})
+	t.Run("update_error_keeps_reservations", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		mockRepository.On("GetProductRecord", ctx, 2, "2008-11-11 00:00:00").Return(domain.ProductRecord{ID: 2, ProductID: 4, SalePrice: 2, RecommendedTemperature: 4}, nil)
+		hold := reservation.Hold{Items: []reservation.Item{{ProductID: 4, Quantity: 5}}}
+		mockReservations.On("NewHold", []reservation.Item{{ProductID: 4, Quantity: 5}}, true).Return(hold, nil)
+		mockRepository.On("Update", ctx, mock.Anything, &hold).Return(domain.PurchaseOrders{}, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK))
+		_, err := newService.Update(ctx, 1, domain.PurchaseOrders{Details: []domain.OrderDetail{{Quantity: 5, Temperature: 2, ProductRecordId: 2}}})
+		assert.Equal(t, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK), err)
+	})
+}
+
+func TestCancel(t *testing.T) {
+	t.Run("cancel_ok", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		cancelled := createBaseData()[0]
+		cancelled.OrderStatusId = domain.StatusIDs[domain.STATUS_CANCELLED]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil).Once()
+		mockRepository.On("ChangeStatus", ctx, mock.MatchedBy(func(c domain.StatusChange) bool {
+			return c.FromStatus == domain.STATUS_CREATED && c.ToStatus == domain.STATUS_CANCELLED
+		}), &reservation.Hold{}).Return(domain.StatusChange{ID: 2}, nil)
+		mockRepository.On("GetById", ctx, 1).Return(cancelled, nil).Once()
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{}, nil)
+		mockRepository.On("GetStatusHistory", ctx, 1).Return([]domain.StatusChange{}, nil)
+		result, err := newService.Cancel(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.STATUS_CANCELLED, result.Status)
+	})
+	t.Run("cancel_shipped", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.OrderStatusId = domain.StatusIDs[domain.STATUS_SHIPPED]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		_, err := newService.Cancel(ctx, 1)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_TRANSITION), err)
+	})
+	t.Run("ship_releases_unpicked_stock", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.OrderStatusId = domain.StatusIDs[domain.STATUS_PICKING]
+		shipped := createBaseData()[0]
+		shipped.OrderStatusId = domain.StatusIDs[domain.STATUS_SHIPPED]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil).Once()
+		mockRepository.On("ChangeStatus", ctx, mock.MatchedBy(func(c domain.StatusChange) bool {
+			return c.FromStatus == domain.STATUS_PICKING && c.ToStatus == domain.STATUS_SHIPPED
+		}), &reservation.Hold{}).Return(domain.StatusChange{ID: 4}, nil)
+		mockRepository.On("GetById", ctx, 1).Return(shipped, nil).Once()
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{}, nil)
+		mockRepository.On("GetStatusHistory", ctx, 1).Return([]domain.StatusChange{}, nil)
+		result, err := newService.Transition(ctx, 1, domain.STATUS_SHIPPED)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.STATUS_SHIPPED, result.Status)
+	})
+	t.Run("return_releases_stock", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.OrderStatusId = domain.StatusIDs[domain.STATUS_DELIVERED]
+		returned := createBaseData()[0]
+		returned.OrderStatusId = domain.StatusIDs[domain.STATUS_RETURNED]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil).Once()
+		mockRepository.On("ChangeStatus", ctx, mock.MatchedBy(func(c domain.StatusChange) bool {
+			return c.FromStatus == domain.STATUS_DELIVERED && c.ToStatus == domain.STATUS_RETURNED
+		}), &reservation.Hold{}).Return(domain.StatusChange{ID: 5}, nil)
+		mockRepository.On("GetById", ctx, 1).Return(returned, nil).Once()
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{}, nil)
+		mockRepository.On("GetStatusHistory", ctx, 1).Return([]domain.StatusChange{}, nil)
+		result, err := newService.Transition(ctx, 1, domain.STATUS_RETURNED)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.STATUS_RETURNED, result.Status)
+	})
+}
+
+func TestPricing(t *testing.T) {
+	t.Run("create_with_tax", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0.1, mockReservations)
+		purchaseOrder := createOrderWithDetails()
+		purchaseOrder.OrderDate = "2008-11-11T13:23:44Z"
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		mockRepository.On("GetProductRecord", ctx, 1, "2008-11-11 13:23:44").Return(domain.ProductRecord{ID: 1, SalePrice: 10.5, RecommendedTemperature: -5}, nil)
+		mockRepository.On("GetProductRecord", ctx, 2, "2008-11-11 13:23:44").Return(domain.ProductRecord{ID: 2, SalePrice: 2, RecommendedTemperature: 4}, nil)
+		mockRepository.On("Create", ctx, mock.MatchedBy(func(p domain.PurchaseOrders) bool {
+			return p.TaxRate == 0.1
+		}), mock.Anything).Return(func(_ context.Context, p domain.PurchaseOrders, _ reservation.Hold) domain.PurchaseOrders {
+			p.ID = 1
+			return p
+		}, nil)
+		mockReservations.On("Check", ctx, mock.Anything).Return(nil)
+		mockReservations.On("NewHold", mock.Anything, true).Return(reservation.Hold{}, nil)
+		newPurchase, err := newService.Create(ctx, purchaseOrder)
+		assert.NoError(t, err)
+		assert.Equal(t, 29.0, newPurchase.Subtotal)
+		assert.Equal(t, 0.1, newPurchase.TaxRate)
+		assert.Equal(t, 2.9, newPurchase.Tax)
+		assert.Equal(t, 31.9, newPurchase.TotalPrice)
+	})
+	t.Run("create_invalid_order_date", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0.1, mockReservations)
+		purchaseOrder := createOrderWithDetails()
+		purchaseOrder.OrderDate = "11/11/2008"
+		mockRepository.On("ValidadeOrderNumber", ctx, purchaseOrder.OrderNumber).Return(true, nil)
+		_, err := newService.Create(ctx, purchaseOrder)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_ORDER_DATE), err)
+	})
+	t.Run("get_by_id_uses_saved_tax_rate", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0.5, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		purchaseOrder.TaxRate = 0.1
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{
+			{ID: 1, Quantity: 3, ProductRecordId: 1, PurchaseOrderId: 1, UnitPrice: 3.33},
+		}, nil)
+		mockRepository.On("GetStatusHistory", ctx, 1).Return([]domain.StatusChange{}, nil)
+		result, err := newService.GetById(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 9.99, result.Subtotal)
+		assert.Equal(t, 1.0, result.Tax)
+		assert.Equal(t, 10.99, result.TotalPrice)
+	})
+	t.Run("update_order_date_prices_details_again", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockReservations := reservationMocks.NewService(t)
+		newService := service.NewService(mockRepository, 0, mockReservations)
+		purchaseOrder := createBaseData()[0]
+		mockRepository.On("GetById", ctx, 1).Return(purchaseOrder, nil)
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{
+			{ID: 3, Quantity: 4, Temperature: 2, ProductRecordId: 2, PurchaseOrderId: 1, UnitPrice: 2},
+		}, nil).Once()
+		mockRepository.On("GetProductRecord", ctx, 2, "2008-12-01 00:00:00").Return(domain.ProductRecord{ID: 2, SalePrice: 2.5, RecommendedTemperature: 4}, nil)
+		mockRepository.On("Update", ctx, mock.MatchedBy(func(p domain.PurchaseOrders) bool {
+			return p.OrderDate == "2008-12-01" && len(p.Details) == 1 && p.Details[0].ID == 0 && p.Details[0].UnitPrice == 2.5
+		}), (*reservation.Hold)(nil)).Return(domain.PurchaseOrders{}, nil)
+		mockRepository.On("GetDetails", ctx, 1).Return([]domain.OrderDetail{
+			{ID: 4, Quantity: 4, Temperature: 2, ProductRecordId: 2, PurchaseOrderId: 1, UnitPrice: 2.5},
+		}, nil).Once()
+		mockRepository.On("GetStatusHistory", ctx, 1).Return([]domain.StatusChange{}, nil)
+		result, err := newService.Update(ctx, 1, domain.PurchaseOrders{OrderDate: "2008-12-01"})
+		assert.NoError(t, err)
+		assert.Equal(t, 10.0, result.TotalPrice)
+	})
+}
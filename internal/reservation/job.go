@@ -0,0 +1,30 @@
+package reservation
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunExpiryJob releases the expired holds every interval until the context
+// is done.
+func RunExpiryJob(ctx context.Context, s Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			released, err := s.ReleaseExpired(ctx)
+			if err != nil {
+				log.Printf("reservation: could not release the expired holds: %v", err)
+				continue
+			}
+			if released > 0 {
+				log.Printf("reservation: released %d expired holds", released)
+			}
+		}
+	}
+}
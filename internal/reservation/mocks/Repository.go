@@ -0,0 +1,116 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	reservation "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Available provides a mock function with given fields: ctx, productID
+func (_m *Repository) Available(ctx context.Context, productID int) (int, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, purchaseOrderID
+func (_m *Repository) Release(ctx context.Context, purchaseOrderID int) (int, error) {
+	ret := _m.Called(ctx, purchaseOrderID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, purchaseOrderID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, purchaseOrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseExpired provides a mock function with given fields: ctx, now
+func (_m *Repository) ReleaseExpired(ctx context.Context, now string) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reserve provides a mock function with given fields: ctx, purchaseOrderID, items, createdAt, expiresAt
+func (_m *Repository) Reserve(ctx context.Context, purchaseOrderID int, items []reservation.Item, createdAt string, expiresAt *string) ([]reservation.Reservation, error) {
+	ret := _m.Called(ctx, purchaseOrderID, items, createdAt, expiresAt)
+
+	var r0 []reservation.Reservation
+	if rf, ok := ret.Get(0).(func(context.Context, int, []reservation.Item, string, *string) []reservation.Reservation); ok {
+		r0 = rf(ctx, purchaseOrderID, items, createdAt, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reservation.Reservation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, []reservation.Item, string, *string) error); ok {
+		r1 = rf(ctx, purchaseOrderID, items, createdAt, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	reservation "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// Check provides a mock function with given fields: ctx, items
+func (_m *Service) Check(ctx context.Context, items []reservation.Item) error {
+	ret := _m.Called(ctx, items)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []reservation.Item) error); ok {
+		r0 = rf(ctx, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewHold provides a mock function with given fields: items, expiring
+func (_m *Service) NewHold(items []reservation.Item, expiring bool) (reservation.Hold, error) {
+	ret := _m.Called(items, expiring)
+
+	var r0 reservation.Hold
+	if rf, ok := ret.Get(0).(func([]reservation.Item, bool) reservation.Hold); ok {
+		r0 = rf(items, expiring)
+	} else {
+		r0 = ret.Get(0).(reservation.Hold)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]reservation.Item, bool) error); ok {
+		r1 = rf(items, expiring)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, purchaseOrderID
+func (_m *Service) Release(ctx context.Context, purchaseOrderID int) error {
+	ret := _m.Called(ctx, purchaseOrderID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, purchaseOrderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseExpired provides a mock function with given fields: ctx
+func (_m *Service) ReleaseExpired(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reserve provides a mock function with given fields: ctx, purchaseOrderID, items, expiring
+func (_m *Service) Reserve(ctx context.Context, purchaseOrderID int, items []reservation.Item, expiring bool) ([]reservation.Reservation, error) {
+	ret := _m.Called(ctx, purchaseOrderID, items, expiring)
+
+	var r0 []reservation.Reservation
+	if rf, ok := ret.Get(0).(func(context.Context, int, []reservation.Item, bool) []reservation.Reservation); ok {
+		r0 = rf(ctx, purchaseOrderID, items, expiring)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reservation.Reservation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, []reservation.Item, bool) error); ok {
+		r1 = rf(ctx, purchaseOrderID, items, expiring)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
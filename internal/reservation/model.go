@@ -0,0 +1,47 @@
+package reservation
+
+import "time"
+
+const (
+	STATUS_ACTIVE   = "active"
+	STATUS_RELEASED = "released"
+	// STATUS_CONSUMED is set when the reserved stock is picked out of the
+	// batches.
+	STATUS_CONSUMED = "consumed"
+
+	ERR_NO_ITEMS           = "the reservation needs at least one item"
+	ERR_INVALID_QUANTITY   = "the quantity of every item must be greater than zero"
+	ERR_INSUFFICIENT_STOCK = "insufficient stock for the ordered products"
+
+	// HOLD_DURATION is how long the stock of an order that wasn't confirmed
+	// yet stays reserved.
+	HOLD_DURATION = 24 * time.Hour
+)
+
+// Reservation is a quantity of a product batch held for a purchase order.
+// Holds of orders that weren't confirmed yet expire; confirmed ones don't.
+type Reservation struct {
+	ID              int     `json:"id"`
+	PurchaseOrderID int     `json:"purchase_order_id"`
+	ProductBatchID  int     `json:"product_batch_id"`
+	ProductID       int     `json:"product_id"`
+	Quantity        int     `json:"quantity"`
+	Status          string  `json:"status"`
+	CreatedAt       string  `json:"created_at"`
+	ExpiresAt       *string `json:"expires_at"`
+}
+
+// Item is a quantity of a product to reserve.
+type Item struct {
+	ProductID int
+	Quantity  int
+}
+
+// Hold is the stock to reserve for a purchase order, saved in the same
+// transaction as the order. A hold without items releases the stock the
+// order had reserved.
+type Hold struct {
+	Items     []Item
+	CreatedAt string
+	ExpiresAt *string
+}
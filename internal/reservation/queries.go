@@ -0,0 +1,21 @@
+package reservation
+
+const (
+	SqlReleaseByPurchaseOrder = "UPDATE stock_reservations SET status = 'released' WHERE purchase_order_id = ? AND status = 'active'"
+
+	SqlReleaseExpired = "UPDATE stock_reservations SET status = 'released' WHERE status = 'active' AND expires_at IS NOT NULL AND expires_at <= ?"
+
+	// The batches of the product are locked until the end of the
+	// transaction, so concurrent orders can't reserve the same stock.
+	// Expired batches can't be reserved.
+	SqlAvailableBatches = `SELECT b.id, COALESCE(b.current_quantity, 0) - COALESCE((SELECT SUM(r.quantity) FROM stock_reservations r
+			WHERE r.product_batch_id = b.id AND r.status = 'active'), 0)
+		FROM product_batches b WHERE b.product_id = ? AND b.expired = 0 ORDER BY b.due_date, b.id FOR UPDATE`
+
+	SqlAvailable = `SELECT COALESCE(SUM(b.current_quantity), 0) - COALESCE((SELECT SUM(r.quantity) FROM stock_reservations r
+			JOIN product_batches rb ON rb.id = r.product_batch_id
+			WHERE rb.product_id = ? AND rb.expired = 0 AND r.status = 'active'), 0)
+		FROM product_batches b WHERE b.product_id = ? AND b.expired = 0`
+
+	SqlCreate = "INSERT INTO stock_reservations (`purchase_order_id`, `product_batch_id`, `quantity`, `status`, `created_at`, `expires_at`) VALUES (?, ?, ?, ?, ?, ?)"
+)
@@ -0,0 +1,159 @@
+package reservation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type Repository interface {
+	Reserve(ctx context.Context, purchaseOrderID int, items []Item, createdAt string, expiresAt *string) ([]Reservation, error)
+	Available(ctx context.Context, productID int) (int, error)
+	Release(ctx context.Context, purchaseOrderID int) (int, error)
+	ReleaseExpired(ctx context.Context, now string) (int, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+type batchStock struct {
+	id        int
+	available int
+}
+
+// Reserve releases the active reservations of the purchase order and
+// reserves the items again, in its own transaction. Nothing changes when any
+// item lacks stock.
+func (r repository) Reserve(ctx context.Context, purchaseOrderID int, items []Item, createdAt string,
+	expiresAt *string) ([]Reservation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return []Reservation{}, err
+	}
+
+	reservations, err := ReserveTx(ctx, tx, purchaseOrderID, Hold{Items: items, CreatedAt: createdAt, ExpiresAt: expiresAt})
+	if err != nil {
+		tx.Rollback()
+		return []Reservation{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []Reservation{}, err
+	}
+
+	return reservations, nil
+}
+
+// ReserveTx releases the active reservations of the purchase order and
+// reserves the items of the hold, taking the stock of the batches that are
+// due first, within the given transaction. The caller rolls it back on
+// error, so the order and its stock are saved together or not at all.
+func ReserveTx(ctx context.Context, tx *sql.Tx, purchaseOrderID int, hold Hold) ([]Reservation, error) {
+	_, err := tx.ExecContext(ctx, SqlReleaseByPurchaseOrder, purchaseOrderID)
+	if err != nil {
+		return []Reservation{}, err
+	}
+
+	reservations := []Reservation{}
+	for _, item := range hold.Items {
+		batches, err := availableBatches(ctx, tx, item.ProductID)
+		if err != nil {
+			return []Reservation{}, err
+		}
+
+		remaining := item.Quantity
+		for _, batch := range batches {
+			if remaining == 0 {
+				break
+			}
+			if batch.available <= 0 {
+				continue
+			}
+
+			quantity := batch.available
+			if quantity > remaining {
+				quantity = remaining
+			}
+			remaining -= quantity
+
+			reservations = append(reservations, Reservation{PurchaseOrderID: purchaseOrderID, ProductBatchID: batch.id,
+				ProductID: item.ProductID, Quantity: quantity, Status: STATUS_ACTIVE, CreatedAt: hold.CreatedAt,
+				ExpiresAt: hold.ExpiresAt})
+		}
+
+		if remaining > 0 {
+			return []Reservation{}, fmt.Errorf(ERR_INSUFFICIENT_STOCK)
+		}
+	}
+
+	for i, reservation := range reservations {
+		res, err := tx.ExecContext(ctx, SqlCreate, reservation.PurchaseOrderID, reservation.ProductBatchID,
+			reservation.Quantity, reservation.Status, reservation.CreatedAt, reservation.ExpiresAt)
+		if err != nil {
+			return []Reservation{}, err
+		}
+
+		lastID, _ := res.LastInsertId()
+		reservations[i].ID = int(lastID)
+	}
+
+	return reservations, nil
+}
+
+func availableBatches(ctx context.Context, tx *sql.Tx, productID int) ([]batchStock, error) {
+	rows, err := tx.QueryContext(ctx, SqlAvailableBatches, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var batches []batchStock
+	for rows.Next() {
+		var batch batchStock
+
+		if err = rows.Scan(&batch.id, &batch.available); err != nil {
+			return nil, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return batches, rows.Err()
+}
+
+func (r repository) Available(ctx context.Context, productID int) (int, error) {
+	var available int
+
+	err := r.db.QueryRowContext(ctx, SqlAvailable, productID, productID).Scan(&available)
+	if err != nil {
+		return 0, err
+	}
+
+	return available, nil
+}
+
+func (r repository) Release(ctx context.Context, purchaseOrderID int) (int, error) {
+	res, err := r.db.ExecContext(ctx, SqlReleaseByPurchaseOrder, purchaseOrderID)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	return int(rowsAffected), nil
+}
+
+func (r repository) ReleaseExpired(ctx context.Context, now string) (int, error) {
+	res, err := r.db.ExecContext(ctx, SqlReleaseExpired, now)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	return int(rowsAffected), nil
+}
@@ -0,0 +1,156 @@
+package reservation_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepositoryReserve(t *testing.T) {
+	expiresAt := "2022-08-02 10:00:00"
+
+	t.Run("reserve_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseByPurchaseOrder)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(reservation.SqlAvailableBatches)).WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "available"}).AddRow(7, 0).AddRow(8, 5).AddRow(9, 10))
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlCreate)).
+			WithArgs(1, 8, 5, reservation.STATUS_ACTIVE, "2022-08-01 10:00:00", &expiresAt).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlCreate)).
+			WithArgs(1, 9, 2, reservation.STATUS_ACTIVE, "2022-08-01 10:00:00", &expiresAt).WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+
+		repo := reservation.NewRepository(db)
+		result, err := repo.Reserve(context.Background(), 1, []reservation.Item{{ProductID: 3, Quantity: 7}},
+			"2022-08-01 10:00:00", &expiresAt)
+		assert.NoError(t, err)
+		assert.Equal(t, []reservation.Reservation{
+			{1, 1, 8, 3, 5, reservation.STATUS_ACTIVE, "2022-08-01 10:00:00", &expiresAt},
+			{2, 1, 9, 3, 2, reservation.STATUS_ACTIVE, "2022-08-01 10:00:00", &expiresAt},
+		}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("reserve_insufficient_stock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseByPurchaseOrder)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(reservation.SqlAvailableBatches)).WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "available"}).AddRow(8, 5))
+		mock.ExpectRollback()
+
+		repo := reservation.NewRepository(db)
+		_, err = repo.Reserve(context.Background(), 1, []reservation.Item{{ProductID: 3, Quantity: 7}}, "2022-08-01 10:00:00", nil)
+		assert.Equal(t, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("reserve_fail_create", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseByPurchaseOrder)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(regexp.QuoteMeta(reservation.SqlAvailableBatches)).WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "available"}).AddRow(8, 5))
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlCreate)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		repo := reservation.NewRepository(db)
+		_, err = repo.Reserve(context.Background(), 1, []reservation.Item{{ProductID: 3, Quantity: 2}}, "2022-08-01 10:00:00", nil)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("reserve_fail_release", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseByPurchaseOrder)).WithArgs(1).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		repo := reservation.NewRepository(db)
+		_, err = repo.Reserve(context.Background(), 1, []reservation.Item{{ProductID: 3, Quantity: 2}}, "2022-08-01 10:00:00", nil)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryAvailable(t *testing.T) {
+	t.Run("available_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(reservation.SqlAvailable)).WithArgs(3, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(12))
+
+		repo := reservation.NewRepository(db)
+		result, err := repo.Available(context.Background(), 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 12, result)
+	})
+	t.Run("available_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(reservation.SqlAvailable)).WithArgs(3, 3).WillReturnError(sql.ErrConnDone)
+
+		repo := reservation.NewRepository(db)
+		_, err = repo.Available(context.Background(), 3)
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryRelease(t *testing.T) {
+	t.Run("release_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseByPurchaseOrder)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+
+		repo := reservation.NewRepository(db)
+		result, err := repo.Release(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+	t.Run("release_expired_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseExpired)).WithArgs("2022-08-02 10:00:00").
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		repo := reservation.NewRepository(db)
+		result, err := repo.ReleaseExpired(context.Background(), "2022-08-02 10:00:00")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result)
+	})
+	t.Run("release_expired_fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(regexp.QuoteMeta(reservation.SqlReleaseExpired)).WillReturnError(sql.ErrConnDone)
+
+		repo := reservation.NewRepository(db)
+		_, err = repo.ReleaseExpired(context.Background(), "2022-08-02 10:00:00")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,115 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	dateTimeLayout = "2006-01-02 15:04:05"
+)
+
+type Service interface {
+	Check(ctx context.Context, items []Item) error
+	Reserve(ctx context.Context, purchaseOrderID int, items []Item, expiring bool) ([]Reservation, error)
+	Release(ctx context.Context, purchaseOrderID int) error
+	NewHold(items []Item, expiring bool) (Hold, error)
+	ReleaseExpired(ctx context.Context) (int, error)
+}
+
+type service struct {
+	repository Repository
+}
+
+func NewService(r Repository) Service {
+	return &service{repository: r}
+}
+
+// Check returns an error when there isn't enough unreserved stock of any of
+// the products to reserve the items.
+func (s *service) Check(ctx context.Context, items []Item) error {
+	items, err := group(items)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		available, err := s.repository.Available(ctx, item.ProductID)
+		if err != nil {
+			return err
+		}
+		if available < item.Quantity {
+			return fmt.Errorf(ERR_INSUFFICIENT_STOCK)
+		}
+	}
+
+	return nil
+}
+
+// Reserve replaces the reservations of the purchase order with the items.
+// Expiring reservations are held for HOLD_DURATION.
+func (s *service) Reserve(ctx context.Context, purchaseOrderID int, items []Item, expiring bool) ([]Reservation, error) {
+	hold, err := s.NewHold(items, expiring)
+	if err != nil {
+		return []Reservation{}, err
+	}
+
+	return s.repository.Reserve(ctx, purchaseOrderID, hold.Items, hold.CreatedAt, hold.ExpiresAt)
+}
+
+// NewHold groups the items by product to be reserved from now on, for
+// HOLD_DURATION when expiring.
+func (s *service) NewHold(items []Item, expiring bool) (Hold, error) {
+	items, err := group(items)
+	if err != nil {
+		return Hold{}, err
+	}
+
+	now := time.Now()
+
+	var expiresAt *string
+	if expiring {
+		expiration := now.Add(HOLD_DURATION).Format(dateTimeLayout)
+		expiresAt = &expiration
+	}
+
+	return Hold{Items: items, CreatedAt: now.Format(dateTimeLayout), ExpiresAt: expiresAt}, nil
+}
+
+func (s *service) Release(ctx context.Context, purchaseOrderID int) error {
+	_, err := s.repository.Release(ctx, purchaseOrderID)
+	return err
+}
+
+// ReleaseExpired releases the holds that expired and returns how many.
+func (s *service) ReleaseExpired(ctx context.Context) (int, error) {
+	return s.repository.ReleaseExpired(ctx, time.Now().Format(dateTimeLayout))
+}
+
+// group adds up the quantities of the same product, keeping the order in
+// which the products first appear.
+func group(items []Item) ([]Item, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf(ERR_NO_ITEMS)
+	}
+
+	var grouped []Item
+	index := map[int]int{}
+
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf(ERR_INVALID_QUANTITY)
+		}
+
+		if i, ok := index[item.ProductID]; ok {
+			grouped[i].Quantity += item.Quantity
+			continue
+		}
+
+		index[item.ProductID] = len(grouped)
+		grouped = append(grouped, item)
+	}
+
+	return grouped, nil
+}
@@ -0,0 +1,105 @@
+package reservation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/reservation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceCheck(t *testing.T) {
+	t.Run("check_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := reservation.NewService(mockRepository)
+
+		mockRepository.On("Available", mock.Anything, 3).Return(10, nil)
+		mockRepository.On("Available", mock.Anything, 4).Return(1, nil)
+
+		err := service.Check(context.Background(), []reservation.Item{{3, 4}, {4, 1}, {3, 6}})
+		assert.NoError(t, err)
+	})
+	t.Run("check_insufficient_stock", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := reservation.NewService(mockRepository)
+
+		mockRepository.On("Available", mock.Anything, 3).Return(9, nil)
+
+		err := service.Check(context.Background(), []reservation.Item{{3, 4}, {3, 6}})
+		assert.Equal(t, fmt.Errorf(reservation.ERR_INSUFFICIENT_STOCK), err)
+	})
+	t.Run("check_invalid_items", func(t *testing.T) {
+		service := reservation.NewService(mocks.NewRepository(t))
+
+		err := service.Check(context.Background(), nil)
+		assert.Equal(t, fmt.Errorf(reservation.ERR_NO_ITEMS), err)
+
+		err = service.Check(context.Background(), []reservation.Item{{3, 0}})
+		assert.Equal(t, fmt.Errorf(reservation.ERR_INVALID_QUANTITY), err)
+	})
+	t.Run("check_error", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := reservation.NewService(mockRepository)
+
+		mockRepository.On("Available", mock.Anything, 3).Return(0, errors.New("error"))
+
+		err := service.Check(context.Background(), []reservation.Item{{3, 4}})
+		assert.Error(t, err)
+	})
+}
+
+func TestServiceReserve(t *testing.T) {
+	t.Run("reserve_expiring", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := reservation.NewService(mockRepository)
+
+		mockRepository.On("Reserve", mock.Anything, 1, []reservation.Item{{3, 10}, {4, 1}}, mock.AnythingOfType("string"),
+			mock.MatchedBy(func(expiresAt *string) bool { return expiresAt != nil })).Return([]reservation.Reservation{{ID: 1}}, nil)
+
+		result, err := service.Reserve(context.Background(), 1, []reservation.Item{{3, 4}, {4, 1}, {3, 6}}, true)
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+	t.Run("reserve_without_expiration", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := reservation.NewService(mockRepository)
+
+		mockRepository.On("Reserve", mock.Anything, 1, []reservation.Item{{3, 4}}, mock.AnythingOfType("string"),
+			(*string)(nil)).Return([]reservation.Reservation{{ID: 1}}, nil)
+
+		_, err := service.Reserve(context.Background(), 1, []reservation.Item{{3, 4}}, false)
+		assert.NoError(t, err)
+	})
+	t.Run("reserve_invalid_quantity", func(t *testing.T) {
+		service := reservation.NewService(mocks.NewRepository(t))
+
+		_, err := service.Reserve(context.Background(), 1, []reservation.Item{{3, -1}}, true)
+		assert.Equal(t, fmt.Errorf(reservation.ERR_INVALID_QUANTITY), err)
+	})
+}
+
+func TestServiceRelease(t *testing.T) {
+	t.Run("release_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := reservation.NewService(mockRepository)
+
+		mockRepository.On("Release", mock.Anything, 1).Return(2, nil)
+
+		err := service.Release(context.Background(), 1)
+		assert.NoError(t, err)
+	})
+	t.Run("release_expired_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := reservation.NewService(mockRepository)
+
+		mockRepository.On("ReleaseExpired", mock.Anything, mock.AnythingOfType("string")).Return(3, nil)
+
+		result, err := service.ReleaseExpired(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result)
+	})
+}
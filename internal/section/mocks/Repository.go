@@ -91,6 +91,50 @@ func (_m *Repository) GetByID(id int) (section.Section, error) {
 	return r0, r1
 }
 
+// GetByWarehouse provides a mock function with given fields: warehouseID
+func (_m *Repository) GetByWarehouse(warehouseID int) ([]section.Section, error) {
+	ret := _m.Called(warehouseID)
+
+	var r0 []section.Section
+	if rf, ok := ret.Get(0).(func(int) []section.Section); ok {
+		r0 = rf(warehouseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]section.Section)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(warehouseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: sec
+func (_m *Repository) Update(sec section.Section) (section.Section, section.CodeError) {
+	ret := _m.Called(sec)
+
+	var r0 section.Section
+	if rf, ok := ret.Get(0).(func(section.Section) section.Section); ok {
+		r0 = rf(sec)
+	} else {
+		r0 = ret.Get(0).(section.Section)
+	}
+
+	var r1 section.CodeError
+	if rf, ok := ret.Get(1).(func(section.Section) section.CodeError); ok {
+		r1 = rf(sec)
+	} else {
+		r1 = ret.Get(1).(section.CodeError)
+	}
+
+	return r0, r1
+}
+
 // UpdateSecID provides a mock function with given fields: id, secNum
 func (_m *Repository) UpdateSecID(id int, secNum int) (section.Section, section.CodeError) {
 	ret := _m.Called(id, secNum)
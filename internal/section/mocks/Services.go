@@ -91,6 +91,27 @@ func (_m *Services) GetByID(id int) (section.Section, error) {
 	return r0, r1
 }
 
+// Update provides a mock function with given fields: sec
+func (_m *Services) Update(sec section.Section) (section.Section, section.CodeError) {
+	ret := _m.Called(sec)
+
+	var r0 section.Section
+	if rf, ok := ret.Get(0).(func(section.Section) section.Section); ok {
+		r0 = rf(sec)
+	} else {
+		r0 = ret.Get(0).(section.Section)
+	}
+
+	var r1 section.CodeError
+	if rf, ok := ret.Get(1).(func(section.Section) section.CodeError); ok {
+		r1 = rf(sec)
+	} else {
+		r1 = ret.Get(1).(section.CodeError)
+	}
+
+	return r0, r1
+}
+
 // UpdateSecID provides a mock function with given fields: id, secNum
 func (_m *Services) UpdateSecID(id int, secNum int) (section.Section, section.CodeError) {
 	ret := _m.Called(id, secNum)
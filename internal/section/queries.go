@@ -5,9 +5,17 @@ const (
 
 	SqlGetById = "SELECT * FROM section WHERE id=?"
 
+	SqlGetByWarehouse = "SELECT * FROM section WHERE warehouse_id=? ORDER BY id"
+
 	SqlStore = "INSERT INTO section (`section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
 
 	SqlUpdateSecID = "UPDATE section SET section_number=? WHERE id=?"
 
+	// The current capacity follows the product batches of the section, so it
+	// is locked while the section changes and never set by hand.
+	SqlLockCapacity = "SELECT current_capacity FROM section WHERE id=? FOR UPDATE"
+
+	SqlUpdate = "UPDATE section SET section_number=?, current_temperature=?, minimum_temperature=?, minimum_capacity=?, maximum_capacity=?, warehouse_id=?, product_type_id=? WHERE id=?"
+
 	SqlDelete = "DELETE FROM section WHERE id=?"
 )
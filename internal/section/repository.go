@@ -20,8 +20,10 @@ type Section struct {
 type Repository interface {
 	GetAll() ([]Section, error)
 	GetByID(id int) (Section, error)
+	GetByWarehouse(warehouseID int) ([]Section, error)
 	Create(secNum, curTemp, minTemp, curCap, minCap, maxCap, wareID, typeID int) (Section, error)
 	UpdateSecID(id, secNum int) (Section, CodeError)
+	Update(sec Section) (Section, CodeError)
 	DeleteSection(id int) error
 }
 
@@ -84,6 +86,31 @@ func (r repository) GetByID(id int) (Section, error) {
 	return sec, nil
 }
 
+func (r repository) GetByWarehouse(warehouseID int) ([]Section, error) {
+	sections := []Section{}
+
+	rows, err := r.db.Query(SqlGetByWarehouse, warehouseID)
+	if err != nil {
+		return []Section{}, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var sec Section
+
+		err := rows.Scan(&sec.ID, &sec.SectionNumber, &sec.CurTemperature, &sec.MinTemperature,
+			&sec.CurCapacity, &sec.MinCapacity, &sec.MaxCapacity, &sec.WareHouseID, &sec.ProductTypeID)
+		if err != nil {
+			return []Section{}, err
+		}
+
+		sections = append(sections, sec)
+	}
+
+	return sections, nil
+}
+
 func (r repository) Create(secNum, curTemp, minTemp, curCap, minCap, maxCap, wareID, typeID int) (Section, error) {
 	res, err := r.db.Exec(SqlStore, secNum, curTemp, minTemp, curCap, minCap, maxCap, wareID, typeID)
 	if err != nil {
@@ -116,6 +143,46 @@ func (r repository) UpdateSecID(id, secNum int) (Section, CodeError) {
 	return sec, CodeError{200, nil}
 }
 
+// Update saves the section, keeping the current capacity taken by its
+// product batches. The maximum capacity can't go below it.
+func (r repository) Update(sec Section) (Section, CodeError) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return Section{}, CodeError{500, err}
+	}
+
+	err = tx.QueryRow(SqlLockCapacity, sec.ID).Scan(&sec.CurCapacity)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return Section{}, CodeError{404, fmt.Errorf("seção com id: %d não existe no banco de dados", sec.ID)}
+	}
+	if err != nil {
+		tx.Rollback()
+		return Section{}, CodeError{500, err}
+	}
+
+	if sec.MaxCapacity < sec.CurCapacity {
+		tx.Rollback()
+		return Section{}, CodeError{409, fmt.Errorf("maximum_capacity não pode ser menor que a current_capacity: %d",
+			sec.CurCapacity)}
+	}
+
+	// The section was found above, so a patch that changes nothing affects
+	// no rows without being an error.
+	_, err = tx.Exec(SqlUpdate, sec.SectionNumber, sec.CurTemperature, sec.MinTemperature, sec.MinCapacity,
+		sec.MaxCapacity, sec.WareHouseID, sec.ProductTypeID, sec.ID)
+	if err != nil {
+		tx.Rollback()
+		return Section{}, CodeError{500, err}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return Section{}, CodeError{500, err}
+	}
+
+	return sec, CodeError{200, nil}
+}
+
 func (r repository) DeleteSection(id int) error {
 	res, err := r.db.Exec(SqlDelete, id)
 	if err != nil {
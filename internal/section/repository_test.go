@@ -3,6 +3,7 @@ package section_test
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -211,6 +212,81 @@ func TestRepositoryUpdate(t *testing.T) {
 	})
 }
 
+func TestRepositoryUpdateSection(t *testing.T) {
+	mock, mockRepository, _ := InitTest(t)
+
+	exp := createSectionArray()[0]
+	exp.CurTemperature = 5
+
+	t.Run("update_existent", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(section.SqlLockCapacity)).WithArgs(exp.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"current_capacity"}).AddRow(exp.CurCapacity))
+		mock.ExpectExec(regexp.QuoteMeta(section.SqlUpdate)).WithArgs(exp.SectionNumber, exp.CurTemperature,
+			exp.MinTemperature, exp.MinCapacity, exp.MaxCapacity, exp.WareHouseID,
+			exp.ProductTypeID, exp.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		changes := exp
+		changes.CurCapacity = 0
+		sec, err := mockRepository.Update(changes)
+
+		assert.Equal(t, exp, sec)
+		assert.Equal(t, section.CodeError{200, nil}, err)
+	})
+
+	t.Run("update_not_found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(section.SqlLockCapacity)).WithArgs(exp.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"current_capacity"}))
+		mock.ExpectRollback()
+
+		sec, err := mockRepository.Update(exp)
+
+		assert.Equal(t, section.Section{}, sec)
+		assert.Equal(t, 404, err.Code)
+	})
+
+	t.Run("update_maximum_below_current_capacity", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(section.SqlLockCapacity)).WithArgs(exp.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"current_capacity"}).AddRow(exp.MaxCapacity + 1))
+		mock.ExpectRollback()
+
+		sec, err := mockRepository.Update(exp)
+
+		assert.Equal(t, section.Section{}, sec)
+		assert.Equal(t, section.CodeError{409, fmt.Errorf("maximum_capacity não pode ser menor que a current_capacity: %d",
+			exp.MaxCapacity+1)}, err)
+	})
+
+	t.Run("update_fail_update_query", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(section.SqlLockCapacity)).
+			WillReturnRows(sqlmock.NewRows([]string{"current_capacity"}).AddRow(exp.CurCapacity))
+		mock.ExpectExec(regexp.QuoteMeta(section.SqlUpdate)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		sec, err := mockRepository.Update(exp)
+
+		assert.Equal(t, section.Section{}, sec)
+		assert.Equal(t, section.CodeError{500, sql.ErrConnDone}, err)
+	})
+
+	t.Run("update_without_changes", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(section.SqlLockCapacity)).
+			WillReturnRows(sqlmock.NewRows([]string{"current_capacity"}).AddRow(exp.CurCapacity))
+		mock.ExpectExec(regexp.QuoteMeta(section.SqlUpdate)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		sec, err := mockRepository.Update(exp)
+
+		assert.Equal(t, exp, sec)
+		assert.Equal(t, section.CodeError{200, nil}, err)
+	})
+}
+
 func TestRepositoryDelete(t *testing.T) {
 	mock, mockRepository, _ := InitTest(t)
 
@@ -237,3 +313,23 @@ func TestRepositoryDelete(t *testing.T) {
 		assert.Equal(t, errors.New("rows not affected"), err)
 	})
 }
+
+func TestRepositoryGetByWarehouse(t *testing.T) {
+	mock, mockRepository, _ := InitTest(t)
+
+	t.Run("find_by_warehouse", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(section.SqlGetByWarehouse)).WithArgs(9876).WillReturnRows(mockRow(WithValue))
+		sections, err := mockRepository.GetByWarehouse(9876)
+
+		assert.NoError(t, err)
+		assert.Equal(t, createSectionArray()[:1], sections)
+	})
+
+	t.Run("find_by_warehouse_fail_query", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(section.SqlGetByWarehouse)).WithArgs(9876).WillReturnError(sql.ErrConnDone)
+		sections, err := mockRepository.GetByWarehouse(9876)
+
+		assert.Equal(t, []section.Section{}, sections)
+		assert.Error(t, err)
+	})
+}
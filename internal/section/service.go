@@ -10,6 +10,7 @@ type Services interface {
 	GetByID(id int) (Section, error)
 	Create(secNum, curTemp, minTemp, curCap, minCap, maxCap, wareID, typeID int) (Section, error)
 	UpdateSecID(id, secNum int) (Section, CodeError)
+	Update(sec Section) (Section, CodeError)
 	DeleteSection(id int) error
 }
 
@@ -89,6 +90,27 @@ func (s *service) UpdateSecID(id, secNum int) (Section, CodeError) {
 	return ps, CodeError{200, nil}
 }
 
+func (s *service) Update(sec Section) (Section, CodeError) {
+	ListSections, err := s.repository.GetAll()
+	if err != nil {
+		return Section{}, CodeError{500, errors.New("internal server error")}
+	}
+
+	for i := range ListSections {
+		if ListSections[i].ID != sec.ID && ListSections[i].SectionNumber == sec.SectionNumber {
+			return Section{}, CodeError{409,
+				fmt.Errorf("seção com section_number: %d já existe no banco de dados", sec.SectionNumber)}
+		}
+	}
+
+	ps, erro := s.repository.Update(sec)
+	if erro.Code != 200 {
+		return Section{}, erro
+	}
+
+	return ps, CodeError{200, nil}
+}
+
 func (s *service) DeleteSection(id int) error {
 	ListSections, err := s.repository.GetAll()
 	if err != nil {
@@ -194,6 +194,53 @@ func TestUpdateSecID(t *testing.T) {
 	})
 }
 
+func TestUpdate(t *testing.T) {
+	mockRepository := mocks.NewRepository(t)
+	service := section.NewService(mockRepository)
+	secs := createSectionArray()
+	exp := secs[1]
+	exp.CurTemperature = 5
+
+	mockRepository.On("GetAll").Return(secs, nil)
+
+	t.Run("update_existent", func(t *testing.T) {
+		mockRepository.On("Update", exp).Return(exp, section.CodeError{200, nil})
+		sec, err := service.Update(exp)
+		assert.Equal(t, section.CodeError{200, nil}, err)
+		assert.Equal(t, exp, sec)
+	})
+
+	t.Run("update_conflict", func(t *testing.T) {
+		conflict := exp
+		conflict.SectionNumber = 40
+		sec, err := service.Update(conflict)
+		assert.Equal(t, section.CodeError{409, errors.New("seção com section_number: 40 já existe no banco de dados")}, err)
+		assert.Equal(t, section.Section{}, sec)
+	})
+
+	t.Run("update_fail_repository", func(t *testing.T) {
+		fail := exp
+		fail.ID = 99
+		fail.SectionNumber = 99
+		mockRepository.On("Update", fail).Return(section.Section{},
+			section.CodeError{500, errors.New("rows not affected")})
+		sec, err := service.Update(fail)
+		assert.Equal(t, section.CodeError{500, errors.New("rows not affected")}, err)
+		assert.Equal(t, section.Section{}, sec)
+	})
+
+	t.Run("update_fail_getall", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := section.NewService(mockRepository)
+		mockRepository.On("GetAll").Return([]section.Section{}, errors.New("rows not affected"))
+
+		sec, err := service.Update(exp)
+
+		assert.Equal(t, section.Section{}, sec)
+		assert.Equal(t, section.CodeError{500, errors.New("internal server error")}, err)
+	})
+}
+
 func TestDelete(t *testing.T) {
 	mockRepository := mocks.NewRepository(t)
 	service := section.NewService(mockRepository)
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	sectionreading "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section_reading"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, sectionID, readings
+func (_m *Repository) Create(ctx context.Context, sectionID int, readings []sectionreading.Reading) ([]sectionreading.Reading, error) {
+	ret := _m.Called(ctx, sectionID, readings)
+
+	var r0 []sectionreading.Reading
+	if rf, ok := ret.Get(0).(func(context.Context, int, []sectionreading.Reading) []sectionreading.Reading); ok {
+		r0 = rf(ctx, sectionID, readings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sectionreading.Reading)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, []sectionreading.Reading) error); ok {
+		r1 = rf(ctx, sectionID, readings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// History provides a mock function with given fields: ctx, sectionID, from, to, intervalSeconds
+func (_m *Repository) History(ctx context.Context, sectionID int, from string, to string, intervalSeconds int) ([]sectionreading.Sample, error) {
+	ret := _m.Called(ctx, sectionID, from, to, intervalSeconds)
+
+	var r0 []sectionreading.Sample
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, int) []sectionreading.Sample); ok {
+		r0 = rf(ctx, sectionID, from, to, intervalSeconds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sectionreading.Sample)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string, int) error); ok {
+		r1 = rf(ctx, sectionID, from, to, intervalSeconds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SectionExists provides a mock function with given fields: ctx, sectionID
+func (_m *Repository) SectionExists(ctx context.Context, sectionID int) (bool, error) {
+	ret := _m.Called(ctx, sectionID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = rf(ctx, sectionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, sectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,76 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	sectionreading "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section_reading"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// History provides a mock function with given fields: ctx, sectionID, filter
+func (_m *Service) History(ctx context.Context, sectionID int, filter sectionreading.HistoryFilter) ([]sectionreading.Sample, error) {
+	ret := _m.Called(ctx, sectionID, filter)
+
+	var r0 []sectionreading.Sample
+	if rf, ok := ret.Get(0).(func(context.Context, int, sectionreading.HistoryFilter) []sectionreading.Sample); ok {
+		r0 = rf(ctx, sectionID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sectionreading.Sample)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, sectionreading.HistoryFilter) error); ok {
+		r1 = rf(ctx, sectionID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Record provides a mock function with given fields: ctx, sectionID, readings
+func (_m *Service) Record(ctx context.Context, sectionID int, readings []sectionreading.Reading) ([]sectionreading.Reading, error) {
+	ret := _m.Called(ctx, sectionID, readings)
+
+	var r0 []sectionreading.Reading
+	if rf, ok := ret.Get(0).(func(context.Context, int, []sectionreading.Reading) []sectionreading.Reading); ok {
+		r0 = rf(ctx, sectionID, readings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sectionreading.Reading)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, []sectionreading.Reading) error); ok {
+		r1 = rf(ctx, sectionID, readings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,46 @@
+package sectionreading
+
+import "time"
+
+const (
+	ERR_SECTION_NOT_FOUND = "section with id (%d) not found"
+	ERR_NO_READINGS       = "the readings need at least one reading"
+	ERR_TOO_MANY_READINGS = "at most %d readings can be recorded at once"
+	ERR_INVALID_DATE      = "dates must be in the format YYYY-MM-DD or YYYY-MM-DD HH:MM:SS"
+	ERR_INVALID_RANGE     = "from can't be after to"
+	ERR_INVALID_INTERVAL  = "interval must be a duration of at least one second, like 30s, 5m or 1h"
+
+	// MAX_READINGS is the most readings a sensor can send in one request.
+	MAX_READINGS = 1000
+
+	// DEFAULT_INTERVAL and DEFAULT_PERIOD shape the history when the
+	// interval or the start of the period aren't given.
+	DEFAULT_INTERVAL = time.Hour
+	DEFAULT_PERIOD   = 24 * time.Hour
+)
+
+// Reading is a temperature measured in a section at a point in time.
+type Reading struct {
+	ID          int     `json:"id"`
+	SectionID   int     `json:"section_id"`
+	Temperature float64 `json:"temperature"`
+	RecordedAt  string  `json:"recorded_at"`
+}
+
+// Sample summarizes the readings of a section within an interval starting at
+// From.
+type Sample struct {
+	From           string  `json:"from"`
+	Readings       int     `json:"readings"`
+	MinTemperature float64 `json:"minimum_temperature"`
+	MaxTemperature float64 `json:"maximum_temperature"`
+	AvgTemperature float64 `json:"average_temperature"`
+}
+
+// HistoryFilter is the period and interval of the history of a section, as
+// sent by the client. Empty values take the defaults.
+type HistoryFilter struct {
+	From     string
+	To       string
+	Interval string
+}
@@ -0,0 +1,24 @@
+package sectionreading
+
+const (
+	SqlLockSection = "SELECT id FROM section WHERE id = ? FOR UPDATE"
+
+	SqlSectionExists = "SELECT COUNT(*) FROM section WHERE id = ?"
+
+	SqlCreateReading = "INSERT INTO section_readings (section_id, temperature, recorded_at) VALUES (?, ?, ?)"
+
+	// The current temperature is the latest reading, which isn't always the
+	// last one received.
+	SqlUpdateCurrentTemperature = `UPDATE section SET current_temperature = (
+		SELECT ROUND(temperature) FROM section_readings WHERE section_id = ? ORDER BY recorded_at DESC, id DESC LIMIT 1
+	) WHERE id = ?`
+
+	// The readings are grouped in buckets of the interval, in seconds,
+	// counted from the unix epoch.
+	SqlHistory = `SELECT FROM_UNIXTIME(FLOOR(UNIX_TIMESTAMP(recorded_at) / ?) * ?) AS bucket, COUNT(*),
+		MIN(temperature), MAX(temperature), ROUND(AVG(temperature), 2)
+		FROM section_readings
+		WHERE section_id = ? AND recorded_at BETWEEN ? AND ?
+		GROUP BY bucket
+		ORDER BY bucket`
+)
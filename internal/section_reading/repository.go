@@ -0,0 +1,104 @@
+package sectionreading
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+type Repository interface {
+	Create(ctx context.Context, sectionID int, readings []Reading) ([]Reading, error)
+	SectionExists(ctx context.Context, sectionID int) (bool, error)
+	History(ctx context.Context, sectionID int, from, to string, intervalSeconds int) ([]Sample, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+// Create stores the readings of the section and sets its current temperature
+// to the latest one, in the same transaction.
+func (r repository) Create(ctx context.Context, sectionID int, readings []Reading) ([]Reading, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return []Reading{}, err
+	}
+
+	var id int
+	err = tx.QueryRowContext(ctx, SqlLockSection, sectionID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return []Reading{}, fmt.Errorf(ERR_SECTION_NOT_FOUND, sectionID)
+	}
+	if err != nil {
+		tx.Rollback()
+		return []Reading{}, err
+	}
+
+	created := make([]Reading, 0, len(readings))
+	for _, reading := range readings {
+		res, err := tx.ExecContext(ctx, SqlCreateReading, sectionID, reading.Temperature, reading.RecordedAt)
+		if err != nil {
+			tx.Rollback()
+			return []Reading{}, err
+		}
+
+		lastID, _ := res.LastInsertId()
+		reading.ID = int(lastID)
+		reading.SectionID = sectionID
+
+		created = append(created, reading)
+	}
+
+	_, err = tx.ExecContext(ctx, SqlUpdateCurrentTemperature, sectionID, sectionID)
+	if err != nil {
+		tx.Rollback()
+		return []Reading{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []Reading{}, err
+	}
+
+	return created, nil
+}
+
+func (r repository) SectionExists(ctx context.Context, sectionID int) (bool, error) {
+	var count int
+
+	err := r.db.QueryRowContext(ctx, SqlSectionExists, sectionID).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r repository) History(ctx context.Context, sectionID int, from, to string, intervalSeconds int) ([]Sample, error) {
+	rows, err := r.db.QueryContext(ctx, SqlHistory, intervalSeconds, intervalSeconds, sectionID, from, to)
+	if err != nil {
+		return []Sample{}, err
+	}
+
+	defer rows.Close()
+
+	samples := []Sample{}
+	for rows.Next() {
+		var sample Sample
+
+		err = rows.Scan(&sample.From, &sample.Readings, &sample.MinTemperature, &sample.MaxTemperature,
+			&sample.AvgTemperature)
+		if err != nil {
+			return []Sample{}, err
+		}
+
+		samples = append(samples, sample)
+	}
+
+	return samples, rows.Err()
+}
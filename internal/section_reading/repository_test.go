@@ -0,0 +1,123 @@
+package sectionreading_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sectionreading "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section_reading"
+	"github.com/stretchr/testify/assert"
+)
+
+func InitTestRepository(t *testing.T) (sqlmock.Sqlmock, sectionreading.Repository) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	return mock, sectionreading.NewRepository(db)
+}
+
+func TestRepositoryCreate(t *testing.T) {
+	readings := []sectionreading.Reading{
+		{Temperature: -18.5, RecordedAt: "2026-10-19 10:00:00"},
+		{Temperature: -17, RecordedAt: "2026-10-19 10:05:00"},
+	}
+
+	t.Run("create_ok", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sectionreading.SqlLockSection)).WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		mock.ExpectExec(regexp.QuoteMeta(sectionreading.SqlCreateReading)).WithArgs(3, -18.5, "2026-10-19 10:00:00").
+			WillReturnResult(sqlmock.NewResult(11, 1))
+		mock.ExpectExec(regexp.QuoteMeta(sectionreading.SqlCreateReading)).WithArgs(3, -17.0, "2026-10-19 10:05:00").
+			WillReturnResult(sqlmock.NewResult(12, 1))
+		mock.ExpectExec(regexp.QuoteMeta(sectionreading.SqlUpdateCurrentTemperature)).WithArgs(3, 3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		created, err := repository.Create(context.TODO(), 3, readings)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []sectionreading.Reading{
+			{ID: 11, SectionID: 3, Temperature: -18.5, RecordedAt: "2026-10-19 10:00:00"},
+			{ID: 12, SectionID: 3, Temperature: -17, RecordedAt: "2026-10-19 10:05:00"},
+		}, created)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("create_section_not_found", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sectionreading.SqlLockSection)).WithArgs(9).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		created, err := repository.Create(context.TODO(), 9, readings)
+
+		assert.Equal(t, fmt.Errorf(sectionreading.ERR_SECTION_NOT_FOUND, 9), err)
+		assert.Equal(t, []sectionreading.Reading{}, created)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("create_fail_exec", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(sectionreading.SqlLockSection)).WithArgs(3).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		mock.ExpectExec(regexp.QuoteMeta(sectionreading.SqlCreateReading)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		_, err := repository.Create(context.TODO(), 3, readings)
+
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositorySectionExists(t *testing.T) {
+	mock, repository := InitTestRepository(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(sectionreading.SqlSectionExists)).WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	exists, err := repository.SectionExists(context.TODO(), 3)
+
+	assert.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestRepositoryHistory(t *testing.T) {
+	t.Run("history_ok", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+		rows := sqlmock.NewRows([]string{"bucket", "readings", "min", "max", "avg"}).
+			AddRow("2026-10-19 10:00:00", 12, "-19.00", "-16.50", "-18.12").
+			AddRow("2026-10-19 11:00:00", 4, "-18.00", "-17.00", "-17.50")
+
+		mock.ExpectQuery(regexp.QuoteMeta(sectionreading.SqlHistory)).
+			WithArgs(3600, 3600, 3, "2026-10-19 00:00:00", "2026-10-19 23:59:59").WillReturnRows(rows)
+
+		samples, err := repository.History(context.TODO(), 3, "2026-10-19 00:00:00", "2026-10-19 23:59:59", 3600)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []sectionreading.Sample{
+			{From: "2026-10-19 10:00:00", Readings: 12, MinTemperature: -19, MaxTemperature: -16.5, AvgTemperature: -18.12},
+			{From: "2026-10-19 11:00:00", Readings: 4, MinTemperature: -18, MaxTemperature: -17, AvgTemperature: -17.5},
+		}, samples)
+	})
+
+	t.Run("history_fail_query", func(t *testing.T) {
+		mock, repository := InitTestRepository(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta(sectionreading.SqlHistory)).WillReturnError(sql.ErrConnDone)
+
+		samples, err := repository.History(context.TODO(), 3, "2026-10-19 00:00:00", "2026-10-19 23:59:59", 3600)
+
+		assert.Error(t, err)
+		assert.Equal(t, []sectionreading.Sample{}, samples)
+	})
+}
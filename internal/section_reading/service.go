@@ -0,0 +1,116 @@
+package sectionreading
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04:05"
+)
+
+type Service interface {
+	Record(ctx context.Context, sectionID int, readings []Reading) ([]Reading, error)
+	History(ctx context.Context, sectionID int, filter HistoryFilter) ([]Sample, error)
+}
+
+type service struct {
+	repository Repository
+}
+
+func NewService(r Repository) Service {
+	return &service{repository: r}
+}
+
+// Record stores the readings of a section. Readings without a time are
+// recorded at the time they are received.
+func (s *service) Record(ctx context.Context, sectionID int, readings []Reading) ([]Reading, error) {
+	if len(readings) == 0 {
+		return []Reading{}, fmt.Errorf(ERR_NO_READINGS)
+	}
+	if len(readings) > MAX_READINGS {
+		return []Reading{}, fmt.Errorf(ERR_TOO_MANY_READINGS, MAX_READINGS)
+	}
+
+	now := time.Now().Format(dateTimeLayout)
+
+	for i := range readings {
+		if readings[i].RecordedAt == "" {
+			readings[i].RecordedAt = now
+			continue
+		}
+
+		recordedAt, err := time.Parse(dateTimeLayout, readings[i].RecordedAt)
+		if err != nil {
+			return []Reading{}, fmt.Errorf(ERR_INVALID_DATE)
+		}
+		readings[i].RecordedAt = recordedAt.Format(dateTimeLayout)
+	}
+
+	return s.repository.Create(ctx, sectionID, readings)
+}
+
+// History returns the readings of a section summarized by interval. The
+// period ends now and starts a day before when it isn't given; dates without
+// a time cover the whole day.
+func (s *service) History(ctx context.Context, sectionID int, filter HistoryFilter) ([]Sample, error) {
+	interval := DEFAULT_INTERVAL
+	if filter.Interval != "" {
+		parsed, err := time.ParseDuration(filter.Interval)
+		if err != nil || parsed < time.Second {
+			return []Sample{}, fmt.Errorf(ERR_INVALID_INTERVAL)
+		}
+		interval = parsed
+	}
+
+	to := time.Now()
+	if filter.To != "" {
+		parsed, err := parseTime(filter.To, true)
+		if err != nil {
+			return []Sample{}, err
+		}
+		to = parsed
+	}
+
+	from := to.Add(-DEFAULT_PERIOD)
+	if filter.From != "" {
+		parsed, err := parseTime(filter.From, false)
+		if err != nil {
+			return []Sample{}, err
+		}
+		from = parsed
+	}
+
+	if from.After(to) {
+		return []Sample{}, fmt.Errorf(ERR_INVALID_RANGE)
+	}
+
+	exists, err := s.repository.SectionExists(ctx, sectionID)
+	if err != nil {
+		return []Sample{}, err
+	}
+	if !exists {
+		return []Sample{}, fmt.Errorf(ERR_SECTION_NOT_FOUND, sectionID)
+	}
+
+	return s.repository.History(ctx, sectionID, from.Format(dateTimeLayout), to.Format(dateTimeLayout),
+		int(interval/time.Second))
+}
+
+func parseTime(value string, endOfDay bool) (time.Time, error) {
+	if parsed, err := time.Parse(dateTimeLayout, value); err == nil {
+		return parsed, nil
+	}
+
+	parsed, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(ERR_INVALID_DATE)
+	}
+	if endOfDay {
+		parsed = parsed.Add(24*time.Hour - time.Second)
+	}
+
+	return parsed, nil
+}
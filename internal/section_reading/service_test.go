@@ -0,0 +1,118 @@
+package sectionreading_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sectionreading "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section_reading"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section_reading/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceRecord(t *testing.T) {
+	t.Run("record_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := sectionreading.NewService(mockRepository)
+		readings := []sectionreading.Reading{{Temperature: -18.5, RecordedAt: "2026-10-19 10:00:00"}}
+		exp := []sectionreading.Reading{{ID: 1, SectionID: 3, Temperature: -18.5, RecordedAt: "2026-10-19 10:00:00"}}
+
+		mockRepository.On("Create", mock.Anything, 3, readings).Return(exp, nil)
+
+		created, err := service.Record(context.TODO(), 3, readings)
+
+		assert.NoError(t, err)
+		assert.Equal(t, exp, created)
+	})
+
+	t.Run("record_without_time", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := sectionreading.NewService(mockRepository)
+
+		mockRepository.On("Create", mock.Anything, 3, mock.MatchedBy(func(readings []sectionreading.Reading) bool {
+			return len(readings) == 1 && len(readings[0].RecordedAt) == len("2006-01-02 15:04:05")
+		})).Return([]sectionreading.Reading{}, nil)
+
+		_, err := service.Record(context.TODO(), 3, []sectionreading.Reading{{Temperature: 4}})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("record_invalid_time", func(t *testing.T) {
+		service := sectionreading.NewService(mocks.NewRepository(t))
+
+		_, err := service.Record(context.TODO(), 3, []sectionreading.Reading{{Temperature: 4, RecordedAt: "19/10/2026"}})
+
+		assert.Equal(t, fmt.Errorf(sectionreading.ERR_INVALID_DATE), err)
+	})
+
+	t.Run("record_no_readings", func(t *testing.T) {
+		service := sectionreading.NewService(mocks.NewRepository(t))
+
+		_, err := service.Record(context.TODO(), 3, nil)
+
+		assert.Equal(t, fmt.Errorf(sectionreading.ERR_NO_READINGS), err)
+	})
+
+	t.Run("record_too_many_readings", func(t *testing.T) {
+		service := sectionreading.NewService(mocks.NewRepository(t))
+
+		_, err := service.Record(context.TODO(), 3, make([]sectionreading.Reading, sectionreading.MAX_READINGS+1))
+
+		assert.Equal(t, fmt.Errorf(sectionreading.ERR_TOO_MANY_READINGS, sectionreading.MAX_READINGS), err)
+	})
+}
+
+func TestServiceHistory(t *testing.T) {
+	t.Run("history_ok", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := sectionreading.NewService(mockRepository)
+		exp := []sectionreading.Sample{{From: "2026-10-19 10:00:00", Readings: 2, MinTemperature: -19, MaxTemperature: -18, AvgTemperature: -18.5}}
+
+		mockRepository.On("SectionExists", mock.Anything, 3).Return(true, nil)
+		mockRepository.On("History", mock.Anything, 3, "2026-10-19 00:00:00", "2026-10-19 23:59:59", 300).Return(exp, nil)
+
+		samples, err := service.History(context.TODO(), 3, sectionreading.HistoryFilter{From: "2026-10-19", To: "2026-10-19", Interval: "5m"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, exp, samples)
+	})
+
+	t.Run("history_default_period", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := sectionreading.NewService(mockRepository)
+
+		mockRepository.On("SectionExists", mock.Anything, 3).Return(true, nil)
+		mockRepository.On("History", mock.Anything, 3, "2026-10-18 12:00:00", "2026-10-19 12:00:00", 3600).
+			Return([]sectionreading.Sample{}, nil)
+
+		_, err := service.History(context.TODO(), 3, sectionreading.HistoryFilter{To: "2026-10-19 12:00:00"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("history_section_not_found", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		service := sectionreading.NewService(mockRepository)
+
+		mockRepository.On("SectionExists", mock.Anything, 9).Return(false, nil)
+
+		_, err := service.History(context.TODO(), 9, sectionreading.HistoryFilter{})
+
+		assert.Equal(t, fmt.Errorf(sectionreading.ERR_SECTION_NOT_FOUND, 9), err)
+	})
+
+	t.Run("history_invalid_filters", func(t *testing.T) {
+		service := sectionreading.NewService(mocks.NewRepository(t))
+
+		_, err := service.History(context.TODO(), 3, sectionreading.HistoryFilter{Interval: "500ms"})
+		assert.Equal(t, fmt.Errorf(sectionreading.ERR_INVALID_INTERVAL), err)
+
+		_, err = service.History(context.TODO(), 3, sectionreading.HistoryFilter{From: "yesterday"})
+		assert.Equal(t, fmt.Errorf(sectionreading.ERR_INVALID_DATE), err)
+
+		_, err = service.History(context.TODO(), 3, sectionreading.HistoryFilter{From: "2026-10-20", To: "2026-10-19"})
+		assert.Equal(t, fmt.Errorf(sectionreading.ERR_INVALID_RANGE), err)
+	})
+}
@@ -16,14 +16,14 @@ type Service interface {
 }
 
 type service struct {
-	repository   Repository
-	localityRepo l.Repository
+	repository      Repository
+	localityService l.Service
 }
 
-func NewService(r Repository, lr l.Repository) Service {
+func NewService(r Repository, ls l.Service) Service {
 	return &service{
-		repository:   r,
-		localityRepo: lr,
+		repository:      r,
+		localityService: ls,
 	}
 }
 
@@ -37,7 +37,7 @@ func (s *service) GetAll(ctx context.Context) ([]Seller, error) {
 }
 
 func (s *service) Create(ctx context.Context, cid int, companyName, address, telephone string, localityID int) (Seller, error) {
-	locality, err := s.localityRepo.GetById(ctx, localityID)
+	locality, err := s.localityService.GetById(ctx, localityID)
 
 	if err != nil {
 		return Seller{}, fmt.Errorf("locality_id does not exists")
@@ -64,7 +64,7 @@ func (s *service) Update(ctx context.Context, id, cid int, companyName, address,
 		return Seller{}, err
 	}
 
-	locality, err := s.localityRepo.GetById(ctx, localityID)
+	locality, err := s.localityService.GetById(ctx, localityID)
 
 	if err != nil {
 		return Seller{}, fmt.Errorf("locality_id does not exists")
@@ -15,7 +15,7 @@ import (
 func TestService_Delete(t *testing.T) {
 	t.Run("Se a exclusão for bem-sucedida, o item não aparecerá na lista.", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		var id int = 1
 
@@ -34,7 +34,7 @@ func TestService_Delete(t *testing.T) {
 
 	t.Run("Se o elemento a ser removido não existir, retornará null.", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		var id int = 2
 
@@ -50,7 +50,7 @@ func TestService_Delete(t *testing.T) {
 
 	t.Run("Deve retornar erro ao chamar método delete.", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		var id int = 1
 
@@ -72,7 +72,7 @@ func TestService_Update(t *testing.T) {
 	t.Run("Se os campos forem atualizados com sucesso retornará a informação do elemento atualizado", func(t *testing.T) {
 
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		sellerList := []seller.Seller{{Id: 1, CompanyId: 5, CompanyName: "TestUpdate", Address: "BR", Telephone: "5501154545454"},
 			{Id: 3, CompanyId: 6, CompanyName: "ServiceSeller", Address: "BR", Telephone: "5501154545454"}}
@@ -95,7 +95,7 @@ func TestService_Update(t *testing.T) {
 
 	t.Run("Se o elemento a ser atualizado não existir, retornar null", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		var id int = 2
 
@@ -113,7 +113,7 @@ func TestService_Update(t *testing.T) {
 
 	t.Run("Se o cid já existir, o elemento não poderá ser atualizado.", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		sellerList := []seller.Seller{{Id: 1, CompanyId: 5, CompanyName: "TestUpdate", Address: "BR", Telephone: "5501154545454", LocalityID: 1},
 			{Id: 3, CompanyId: 6, CompanyName: "ServiceSeller", Address: "BR", Telephone: "5501154545454", LocalityID: 1}}
@@ -135,7 +135,7 @@ func TestService_Update(t *testing.T) {
 	t.Run("Deve retornar erro ao chamar método Update", func(t *testing.T) {
 
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		sellerList := []seller.Seller{{Id: 1, CompanyId: 5, CompanyName: "TestUpdate", Address: "BR", Telephone: "5501154545454", LocalityID: 1},
 			{Id: 3, CompanyId: 6, CompanyName: "ServiceSeller", Address: "BR", Telephone: "5501154545454", LocalityID: 1}}
@@ -159,7 +159,7 @@ func TestService_Update(t *testing.T) {
 func TestService_GetOne(t *testing.T) {
 	t.Run("Se o elemento procurado por id existir, ele retornará as informações do elemento solicitado", func(t *testing.T) {
 		mockrepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		sellerList := []seller.Seller{{Id: 1, CompanyId: 5, CompanyName: "TestGetOne", Address: "BR", Telephone: "5501154545454", LocalityID: 1},
 			{Id: 3, CompanyId: 5, CompanyName: "ServiceSeller", Address: "BR", Telephone: "5501154545454", LocalityID: 1}}
@@ -174,7 +174,7 @@ func TestService_GetOne(t *testing.T) {
 	t.Run("Se o elemento procurado por id não existir, retorna null", func(t *testing.T) {
 
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		var id int = 2
 
@@ -193,7 +193,7 @@ func TestService_GetOne(t *testing.T) {
 func TestService_Create(t *testing.T) {
 	t.Run("Se contiver os campos necessários, o vendedor será criado", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		expected := seller.Seller{Id: 1, CompanyId: 5, CompanyName: "TestCreate", Address: "BR", Telephone: "5501154545454", LocalityID: 1}
 		input := seller.Seller{CompanyId: 5, CompanyName: "TestCreate", Address: "BR", Telephone: "5501154545454", LocalityID: 1}
@@ -211,7 +211,7 @@ func TestService_Create(t *testing.T) {
 
 	t.Run("Se o cid já existir, o vendedor não pode ser criado", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		sellerList := []seller.Seller{
 			{Id: 1, CompanyId: 5, CompanyName: "ServiceSeller", Address: "BR", Telephone: "5501154545454", LocalityID: 1},
@@ -234,7 +234,7 @@ func TestService_Create(t *testing.T) {
 
 	t.Run("Deve retornar erro ao chamar método create", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		input := seller.Seller{CompanyId: 7, CompanyName: "TestCreate", Address: "BR", Telephone: "5501154545454", LocalityID: 1}
 		localityOne := locality.Locality{Id: 1, LocalityName: "Cecap", ProvinceName: "Gru", CountryName: "SP"}
@@ -252,7 +252,7 @@ func TestService_Create(t *testing.T) {
 
 	t.Run("Deve retornar erro ao executar findByCid", func(t *testing.T) {
 		mockRepo := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		input := seller.Seller{CompanyId: 5, CompanyName: "TestCreate", Address: "BR", Telephone: "5501154545454", LocalityID: 1}
 
@@ -270,7 +270,7 @@ func TestService_GetAll(t *testing.T) {
 	t.Run("Se a lista tiver n elementos, retornará uma quantidade do total de elementos", func(t *testing.T) {
 
 		mockRepository := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		expectedResult := []seller.Seller{
 			{Id: 1, CompanyId: 5, CompanyName: "ServiceSeller", Address: "BR", Telephone: "5501154545454", LocalityID: 1},
@@ -289,7 +289,7 @@ func TestService_GetAll(t *testing.T) {
 	t.Run("Deve retornar erro", func(t *testing.T) {
 
 		mockRepository := mocks.NewRepository(t)
-		mockLocalityRepo := localityMock.NewRepository(t)
+		mockLocalityRepo := localityMock.NewService(t)
 
 		expectedError := errors.New("erro ao inicializar a lista")
 
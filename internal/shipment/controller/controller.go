@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/web"
+	"github.com/gin-gonic/gin"
+)
+
+type ShipmentCreate struct {
+	PurchaseOrderID int `json:"purchase_order_id" binding:"required"`
+	CarrierID       int `json:"carrier_id" binding:"required"`
+}
+
+type EventCreate struct {
+	Status      string `json:"status" binding:"required"`
+	Description string `json:"description"`
+}
+
+type Shipments struct {
+	service domain.Service
+}
+
+func NewShipment(s domain.Service) Shipments {
+	return Shipments{s}
+}
+
+// Create CreateShipment godoc
+// @Summary Create Shipment
+// @Tags Shipments
+// @Description ship a purchase order with a carrier, generating its tracking code
+// @Accept json
+// @Produce json
+// @Param token header string true "token"
+// @Param shipment body ShipmentCreate true "Shipment to store"
+// @Failure 401 {object} web.Response "We need token"
+// @Failure 404 {object} web.Response "Purchase order or carrier not found"
+// @Failure 422 {object} web.Response "Missing some mandatory field"
+// @Success 201 {object} web.Response
+// @Router /api/v1/shipments [POST]
+func (s *Shipments) Create(c *gin.Context) {
+	var req ShipmentCreate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(web.DecodeError(http.StatusUnprocessableEntity, "invalid body"))
+		return
+	}
+
+	shipment, err := s.service.Create(c.Request.Context(), req.PurchaseOrderID, req.CarrierID)
+	if err != nil {
+		c.JSON(errorStatus(err))
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusCreated, shipment))
+}
+
+// GetShipmentById GetShipment godoc
+// @Summary Get Shipment
+// @Tags Shipments
+// @Description get a shipment with its tracking events
+// @Accept json
+// @Produce json
+// @Param token header string true "token"
+// @Failure 401 {object} web.Response "We need token"
+// @Failure 404 {object} web.Response
+// @Success 200 {object} web.Response
+// @Router /api/v1/shipments/{id} [GET]
+func (s *Shipments) GetShipmentById(c *gin.Context) {
+	id, _ := strconv.Atoi(c.Param("id"))
+
+	shipment, err := s.service.GetById(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(errorStatus(err))
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusOK, shipment))
+}
+
+// CreateEvent CreateShipmentEvent godoc
+// @Summary Create Shipment Event
+// @Tags Shipments
+// @Description record a tracking event (picked_up, in_transit, delivered or failed) of a shipment
+// @Accept json
+// @Produce json
+// @Param token header string true "token"
+// @Param event body EventCreate true "Tracking event"
+// @Failure 401 {object} web.Response "We need token"
+// @Failure 404 {object} web.Response
+// @Failure 409 {object} web.Response "The shipment can't change to the event status"
+// @Failure 422 {object} web.Response "Missing or invalid status"
+// @Success 201 {object} web.Response
+// @Router /api/v1/shipments/{id}/events [POST]
+func (s *Shipments) CreateEvent(c *gin.Context) {
+	id, _ := strconv.Atoi(c.Param("id"))
+
+	var req EventCreate
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(web.DecodeError(http.StatusUnprocessableEntity, "invalid body"))
+		return
+	}
+
+	shipment, err := s.service.AddEvent(c.Request.Context(), id, req.Status, req.Description)
+	if err != nil {
+		c.JSON(errorStatus(err))
+		return
+	}
+
+	c.JSON(web.NewResponse(http.StatusCreated, shipment))
+}
+
+func errorStatus(err error) (int, web.Response) {
+	switch err.Error() {
+	case domain.ERROR_SHIPMENT_NOT_FOUND, domain.ERROR_PURCHASE_ORDER_NOT_FOUND, domain.ERROR_CARRIER_NOT_FOUND:
+		return web.DecodeError(http.StatusNotFound, err.Error())
+	case domain.ERROR_INVALID_EVENT_STATUS:
+		return web.DecodeError(http.StatusUnprocessableEntity, err.Error())
+	case domain.ERROR_INVALID_TRANSITION, domain.ERROR_PURCHASE_ORDER_CLOSED:
+		return web.DecodeError(http.StatusConflict, err.Error())
+	default:
+		return web.DecodeError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,226 @@
+package controller_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/controller"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	URL = "/api/v1/shipments/"
+)
+
+type responseData struct {
+	Code  int
+	Data  domain.Shipment
+	Error string
+}
+
+func createBaseData() domain.Shipment {
+	return domain.Shipment{
+		ID:              1,
+		TrackingCode:    "MF3F9A01C27BD4",
+		PurchaseOrderID: 1,
+		CarrierID:       2,
+		Status:          domain.STATUS_CREATED,
+		CreatedAt:       "2022-08-01 10:00:00",
+		Events:          []domain.Event{},
+	}
+}
+
+func createRequestTest(method string, url string, body string) (*http.Request, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, url, bytes.NewBuffer([]byte(body)))
+	req.Header.Add("Content-Type", "application/json")
+	return req, httptest.NewRecorder()
+}
+
+func setupServer(mockService *mocks.Service) *gin.Engine {
+	handler := controller.NewShipment(mockService)
+
+	server := gin.Default()
+	routerGroup := server.Group(URL)
+	routerGroup.POST("/", handler.Create)
+	routerGroup.GET("/:id", handler.GetShipmentById)
+	routerGroup.POST("/:id/events", handler.CreateEvent)
+	return server
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("create_ok", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+		expected := createBaseData()
+
+		mockService.On("Create", context.Background(), 1, 2).Return(expected, nil)
+		req, response := createRequestTest(http.MethodPost, URL, `{"purchase_order_id": 1, "carrier_id": 2}`)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.Equal(t, expected, resp.Data)
+	})
+	t.Run("create_wrong_body", func(t *testing.T) {
+		server := setupServer(mocks.NewService(t))
+
+		req, response := createRequestTest(http.MethodPost, URL, `{"purchase_order_id": 1}`)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.Equal(t, "invalid body", resp.Error)
+	})
+	t.Run("create_purchase_order_not_found", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+
+		mockService.On("Create", context.Background(), 10, 2).Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_PURCHASE_ORDER_NOT_FOUND))
+		req, response := createRequestTest(http.MethodPost, URL, `{"purchase_order_id": 10, "carrier_id": 2}`)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.Equal(t, domain.ERROR_PURCHASE_ORDER_NOT_FOUND, resp.Error)
+	})
+	t.Run("create_purchase_order_closed", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+
+		mockService.On("Create", context.Background(), 1, 2).Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_PURCHASE_ORDER_CLOSED))
+		req, response := createRequestTest(http.MethodPost, URL, `{"purchase_order_id": 1, "carrier_id": 2}`)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+	})
+	t.Run("create_carrier_not_found", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+
+		mockService.On("Create", context.Background(), 1, 20).Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_CARRIER_NOT_FOUND))
+		req, response := createRequestTest(http.MethodPost, URL, `{"purchase_order_id": 1, "carrier_id": 20}`)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+	t.Run("create_internal_error", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+
+		mockService.On("Create", context.Background(), 1, 2).Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_WHILE_SAVING))
+		req, response := createRequestTest(http.MethodPost, URL, `{"purchase_order_id": 1, "carrier_id": 2}`)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+	})
+}
+
+func TestGetShipmentById(t *testing.T) {
+	t.Run("get_by_id", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+		expected := createBaseData()
+		expected.Status = domain.STATUS_PICKED_UP
+		expected.Events = []domain.Event{{ID: 1, ShipmentID: 1, Status: domain.STATUS_PICKED_UP, OccurredAt: "2022-08-01 12:00:00"}}
+
+		mockService.On("GetById", context.Background(), 1).Return(expected, nil)
+		req, response := createRequestTest(http.MethodGet, URL+"1", "")
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, expected, resp.Data)
+	})
+	t.Run("get_by_id_not_found", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+
+		mockService.On("GetById", context.Background(), 10).Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND))
+		req, response := createRequestTest(http.MethodGet, URL+"10", "")
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.Equal(t, domain.ERROR_SHIPMENT_NOT_FOUND, resp.Error)
+	})
+}
+
+func TestCreateEvent(t *testing.T) {
+	t.Run("create_event_ok", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+		expected := createBaseData()
+		expected.Status = domain.STATUS_PICKED_UP
+		expected.Events = []domain.Event{{ID: 1, ShipmentID: 1, Status: domain.STATUS_PICKED_UP, Description: "collected", OccurredAt: "2022-08-01 12:00:00"}}
+
+		mockService.On("AddEvent", context.Background(), 1, domain.STATUS_PICKED_UP, "collected").Return(expected, nil)
+		req, response := createRequestTest(http.MethodPost, URL+"1/events", `{"status": "picked_up", "description": "collected"}`)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.Equal(t, expected, resp.Data)
+	})
+	t.Run("create_event_wrong_body", func(t *testing.T) {
+		server := setupServer(mocks.NewService(t))
+
+		req, response := createRequestTest(http.MethodPost, URL+"1/events", `{"description": "collected"}`)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+	t.Run("create_event_invalid_status", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+
+		mockService.On("AddEvent", context.Background(), 1, "lost", "").Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_INVALID_EVENT_STATUS))
+		req, response := createRequestTest(http.MethodPost, URL+"1/events", `{"status": "lost"}`)
+		server.ServeHTTP(response, req)
+
+		resp := responseData{}
+		json.Unmarshal(response.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.Equal(t, domain.ERROR_INVALID_EVENT_STATUS, resp.Error)
+	})
+	t.Run("create_event_invalid_transition", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+
+		mockService.On("AddEvent", context.Background(), 1, domain.STATUS_DELIVERED, "").Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_INVALID_TRANSITION))
+		req, response := createRequestTest(http.MethodPost, URL+"1/events", `{"status": "delivered"}`)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+	})
+	t.Run("create_event_shipment_not_found", func(t *testing.T) {
+		mockService := mocks.NewService(t)
+		server := setupServer(mockService)
+
+		mockService.On("AddEvent", context.Background(), 10, domain.STATUS_PICKED_UP, "").Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND))
+		req, response := createRequestTest(http.MethodPost, URL+"10/events", `{"status": "picked_up"}`)
+		server.ServeHTTP(response, req)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
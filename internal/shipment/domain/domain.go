@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+)
+
+const (
+	STATUS_CREATED    = "created"
+	STATUS_PICKED_UP  = "picked_up"
+	STATUS_IN_TRANSIT = "in_transit"
+	STATUS_DELIVERED  = "delivered"
+	STATUS_FAILED     = "failed"
+
+	ERROR_SHIPMENT_NOT_FOUND       = "shipment not found"
+	ERROR_PURCHASE_ORDER_NOT_FOUND = "purchase order not found"
+	ERROR_CARRIER_NOT_FOUND        = "carrier not found"
+	ERROR_PURCHASE_ORDER_CLOSED    = "cancelled or returned purchase orders can't be shipped"
+	ERROR_INVALID_EVENT_STATUS     = "status must be one of picked_up, in_transit, delivered or failed"
+	ERROR_INVALID_TRANSITION       = "the shipment can't change from its current status to the event status"
+	ERROR_WHILE_SAVING             = "Error while saving"
+)
+
+// Transitions holds, for each shipment status, the statuses a tracking event
+// can move it to. Delivered and failed shipments don't accept new events.
+var Transitions = map[string][]string{
+	STATUS_CREATED:    {STATUS_PICKED_UP, STATUS_FAILED},
+	STATUS_PICKED_UP:  {STATUS_IN_TRANSIT, STATUS_DELIVERED, STATUS_FAILED},
+	STATUS_IN_TRANSIT: {STATUS_IN_TRANSIT, STATUS_DELIVERED, STATUS_FAILED},
+	STATUS_DELIVERED:  {},
+	STATUS_FAILED:     {},
+}
+
+type Shipment struct {
+	ID              int     `json:"id"`
+	TrackingCode    string  `json:"tracking_code"`
+	PurchaseOrderID int     `json:"purchase_order_id"`
+	CarrierID       int     `json:"carrier_id"`
+	Status          string  `json:"status"`
+	CreatedAt       string  `json:"created_at"`
+	Events          []Event `json:"events"`
+}
+
+type Event struct {
+	ID          int    `json:"id"`
+	ShipmentID  int    `json:"shipment_id"`
+	Status      string `json:"status"`
+	Description string `json:"description"`
+	OccurredAt  string `json:"occurred_at"`
+}
+
+type Repository interface {
+	Create(ctx context.Context, shipment Shipment) (Shipment, error)
+	GetById(ctx context.Context, id int) (Shipment, error)
+	GetByPurchaseOrder(ctx context.Context, purchaseOrderID int) ([]Shipment, error)
+	GetEvents(ctx context.Context, shipmentID int) ([]Event, error)
+	AddEvent(ctx context.Context, event Event, fromStatus string) (Event, error)
+}
+
+type Service interface {
+	Create(ctx context.Context, purchaseOrderID, carrierID int) (Shipment, error)
+	GetById(ctx context.Context, id int) (Shipment, error)
+	GetByPurchaseOrder(ctx context.Context, purchaseOrderID int) ([]Shipment, error)
+	AddEvent(ctx context.Context, shipmentID int, status, description string) (Shipment, error)
+}
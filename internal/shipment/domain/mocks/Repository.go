@@ -0,0 +1,139 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// AddEvent provides a mock function with given fields: ctx, event, fromStatus
+func (_m *Repository) AddEvent(ctx context.Context, event domain.Event, fromStatus string) (domain.Event, error) {
+	ret := _m.Called(ctx, event, fromStatus)
+
+	var r0 domain.Event
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Event, string) domain.Event); ok {
+		r0 = rf(ctx, event, fromStatus)
+	} else {
+		r0 = ret.Get(0).(domain.Event)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Event, string) error); ok {
+		r1 = rf(ctx, event, fromStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, shipment
+func (_m *Repository) Create(ctx context.Context, shipment domain.Shipment) (domain.Shipment, error) {
+	ret := _m.Called(ctx, shipment)
+
+	var r0 domain.Shipment
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Shipment) domain.Shipment); ok {
+		r0 = rf(ctx, shipment)
+	} else {
+		r0 = ret.Get(0).(domain.Shipment)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Shipment) error); ok {
+		r1 = rf(ctx, shipment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *Repository) GetById(ctx context.Context, id int) (domain.Shipment, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.Shipment
+	if rf, ok := ret.Get(0).(func(context.Context, int) domain.Shipment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Shipment)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByPurchaseOrder provides a mock function with given fields: ctx, purchaseOrderID
+func (_m *Repository) GetByPurchaseOrder(ctx context.Context, purchaseOrderID int) ([]domain.Shipment, error) {
+	ret := _m.Called(ctx, purchaseOrderID)
+
+	var r0 []domain.Shipment
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Shipment); ok {
+		r0 = rf(ctx, purchaseOrderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Shipment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, purchaseOrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEvents provides a mock function with given fields: ctx, shipmentID
+func (_m *Repository) GetEvents(ctx context.Context, shipmentID int) ([]domain.Event, error) {
+	ret := _m.Called(ctx, shipmentID)
+
+	var r0 []domain.Event
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Event); ok {
+		r0 = rf(ctx, shipmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Event)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, shipmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,116 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// AddEvent provides a mock function with given fields: ctx, shipmentID, status, description
+func (_m *Service) AddEvent(ctx context.Context, shipmentID int, status string, description string) (domain.Shipment, error) {
+	ret := _m.Called(ctx, shipmentID, status, description)
+
+	var r0 domain.Shipment
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) domain.Shipment); ok {
+		r0 = rf(ctx, shipmentID, status, description)
+	} else {
+		r0 = ret.Get(0).(domain.Shipment)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
+		r1 = rf(ctx, shipmentID, status, description)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, purchaseOrderID, carrierID
+func (_m *Service) Create(ctx context.Context, purchaseOrderID int, carrierID int) (domain.Shipment, error) {
+	ret := _m.Called(ctx, purchaseOrderID, carrierID)
+
+	var r0 domain.Shipment
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) domain.Shipment); ok {
+		r0 = rf(ctx, purchaseOrderID, carrierID)
+	} else {
+		r0 = ret.Get(0).(domain.Shipment)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, purchaseOrderID, carrierID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetById provides a mock function with given fields: ctx, id
+func (_m *Service) GetById(ctx context.Context, id int) (domain.Shipment, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 domain.Shipment
+	if rf, ok := ret.Get(0).(func(context.Context, int) domain.Shipment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.Shipment)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByPurchaseOrder provides a mock function with given fields: ctx, purchaseOrderID
+func (_m *Service) GetByPurchaseOrder(ctx context.Context, purchaseOrderID int) ([]domain.Shipment, error) {
+	ret := _m.Called(ctx, purchaseOrderID)
+
+	var r0 []domain.Shipment
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Shipment); ok {
+		r0 = rf(ctx, purchaseOrderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Shipment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, purchaseOrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
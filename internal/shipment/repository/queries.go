@@ -0,0 +1,19 @@
+package repository
+
+const (
+	SqlGetById = "SELECT id, tracking_code, purchase_order_id, carrier_id, status, created_at FROM shipments WHERE id = ?"
+
+	SqlGetByPurchaseOrder = "SELECT id, tracking_code, purchase_order_id, carrier_id, status, created_at FROM shipments WHERE purchase_order_id = ? ORDER BY created_at, id"
+
+	SqlCreate = "INSERT INTO shipments (`tracking_code`, `purchase_order_id`, `carrier_id`, `status`, `created_at`) VALUES (?, ?, ?, ?, ?)"
+
+	SqlUpdateOrderTrackingCode = "UPDATE purchase_orders SET tracking_code = ? WHERE id = ?"
+
+	SqlGetEvents = "SELECT id, shipment_id, status, description, occurred_at FROM shipment_events WHERE shipment_id = ? ORDER BY occurred_at, id"
+
+	SqlCreateEvent = "INSERT INTO shipment_events (`shipment_id`, `status`, `description`, `occurred_at`) VALUES (?, ?, ?, ?)"
+
+	SqlLockStatus = "SELECT status FROM shipments WHERE id = ? FOR UPDATE"
+
+	SqlUpdateStatus = "UPDATE shipments SET status = ? WHERE id = ?"
+)
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) domain.Repository {
+	return &repository{db: db}
+}
+
+// Create saves the shipment and copies its tracking code to the purchase
+// order in the same transaction.
+func (r *repository) Create(ctx context.Context, shipment domain.Shipment) (domain.Shipment, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	res, err := tx.ExecContext(ctx, SqlCreate, shipment.TrackingCode, shipment.PurchaseOrderID,
+		shipment.CarrierID, shipment.Status, shipment.CreatedAt)
+	if err != nil {
+		tx.Rollback()
+		return domain.Shipment{}, err
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil || lastID < 1 {
+		tx.Rollback()
+		return domain.Shipment{}, fmt.Errorf(domain.ERROR_WHILE_SAVING)
+	}
+
+	_, err = tx.ExecContext(ctx, SqlUpdateOrderTrackingCode, shipment.TrackingCode, shipment.PurchaseOrderID)
+	if err != nil {
+		tx.Rollback()
+		return domain.Shipment{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Shipment{}, err
+	}
+
+	shipment.ID = int(lastID)
+
+	return shipment, nil
+}
+
+func (r *repository) GetById(ctx context.Context, id int) (domain.Shipment, error) {
+	var shipment domain.Shipment
+
+	err := r.db.QueryRowContext(ctx, SqlGetById, id).Scan(&shipment.ID, &shipment.TrackingCode,
+		&shipment.PurchaseOrderID, &shipment.CarrierID, &shipment.Status, &shipment.CreatedAt)
+	if err == sql.ErrNoRows {
+		return domain.Shipment{}, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND)
+	}
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+	return shipment, nil
+}
+
+func (r *repository) GetByPurchaseOrder(ctx context.Context, purchaseOrderID int) ([]domain.Shipment, error) {
+	shipments := []domain.Shipment{}
+
+	rows, err := r.db.QueryContext(ctx, SqlGetByPurchaseOrder, purchaseOrderID)
+	if err != nil {
+		return shipments, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var shipment domain.Shipment
+
+		err = rows.Scan(&shipment.ID, &shipment.TrackingCode, &shipment.PurchaseOrderID,
+			&shipment.CarrierID, &shipment.Status, &shipment.CreatedAt)
+		if err != nil {
+			return []domain.Shipment{}, err
+		}
+
+		shipments = append(shipments, shipment)
+	}
+
+	return shipments, rows.Err()
+}
+
+func (r *repository) GetEvents(ctx context.Context, shipmentID int) ([]domain.Event, error) {
+	events := []domain.Event{}
+
+	rows, err := r.db.QueryContext(ctx, SqlGetEvents, shipmentID)
+	if err != nil {
+		return events, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var event domain.Event
+
+		err = rows.Scan(&event.ID, &event.ShipmentID, &event.Status, &event.Description, &event.OccurredAt)
+		if err != nil {
+			return []domain.Event{}, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// AddEvent saves the tracking event and moves the shipment to the event
+// status in the same transaction. The shipment is locked first, and the
+// event is only saved while the shipment is still in the status it was
+// validated against.
+func (r *repository) AddEvent(ctx context.Context, event domain.Event, fromStatus string) (domain.Event, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	var status string
+	err = tx.QueryRowContext(ctx, SqlLockStatus, event.ShipmentID).Scan(&status)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return domain.Event{}, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND)
+	}
+	if err != nil {
+		tx.Rollback()
+		return domain.Event{}, err
+	}
+	if status != fromStatus {
+		tx.Rollback()
+		return domain.Event{}, fmt.Errorf(domain.ERROR_INVALID_TRANSITION)
+	}
+
+	res, err := tx.ExecContext(ctx, SqlCreateEvent, event.ShipmentID, event.Status, event.Description, event.OccurredAt)
+	if err != nil {
+		tx.Rollback()
+		return domain.Event{}, err
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil || lastID < 1 {
+		tx.Rollback()
+		return domain.Event{}, fmt.Errorf(domain.ERROR_WHILE_SAVING)
+	}
+
+	_, err = tx.ExecContext(ctx, SqlUpdateStatus, event.Status, event.ShipmentID)
+	if err != nil {
+		tx.Rollback()
+		return domain.Event{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.Event{}, err
+	}
+
+	event.ID = int(lastID)
+
+	return event, nil
+}
@@ -0,0 +1,316 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+	shipmentRepo "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func createBaseData() domain.Shipment {
+	return domain.Shipment{
+		TrackingCode:    "MF3F9A01C27BD4",
+		PurchaseOrderID: 1,
+		CarrierID:       2,
+		Status:          domain.STATUS_CREATED,
+		CreatedAt:       "2022-08-01 10:00:00",
+	}
+}
+
+func shipmentRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "tracking_code", "purchase_order_id", "carrier_id", "status", "created_at"})
+}
+
+func TestRepositoryCreate(t *testing.T) {
+	t.Run("create_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		shipment := createBaseData()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlCreate)).WithArgs(shipment.TrackingCode, shipment.PurchaseOrderID,
+			shipment.CarrierID, shipment.Status, shipment.CreatedAt).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlUpdateOrderTrackingCode)).WithArgs(shipment.TrackingCode,
+			shipment.PurchaseOrderID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.Create(context.Background(), shipment)
+		assert.NoError(t, err)
+		shipment.ID = 1
+		assert.Equal(t, shipment, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("create_fail_begin", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin().WillReturnError(fmt.Errorf("connection refused"))
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.Create(context.Background(), createBaseData())
+		assert.Error(t, err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+	t.Run("create_fail_exec", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlCreate)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.Create(context.Background(), createBaseData())
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.Equal(t, domain.Shipment{}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("create_fail_last_id", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlCreate)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.Create(context.Background(), createBaseData())
+		assert.Equal(t, fmt.Errorf(domain.ERROR_WHILE_SAVING), err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+	t.Run("create_fail_update_tracking_code", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlCreate)).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlUpdateOrderTrackingCode)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.Create(context.Background(), createBaseData())
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.Equal(t, domain.Shipment{}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepositoryGetById(t *testing.T) {
+	t.Run("find_by_id_existent", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		shipment := createBaseData()
+		shipment.ID = 1
+
+		rows := shipmentRows().AddRow(shipment.ID, shipment.TrackingCode, shipment.PurchaseOrderID,
+			shipment.CarrierID, shipment.Status, shipment.CreatedAt)
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlGetById)).WithArgs(1).WillReturnRows(rows)
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.GetById(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, shipment, result)
+	})
+	t.Run("find_by_id_non_existent", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlGetById)).WithArgs(10).WillReturnError(sql.ErrNoRows)
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.GetById(context.Background(), 10)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND), err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+	t.Run("find_by_id_fail_query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlGetById)).WithArgs(1).WillReturnError(sql.ErrConnDone)
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.GetById(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+}
+
+func TestRepositoryGetByPurchaseOrder(t *testing.T) {
+	t.Run("get_by_purchase_order", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		shipment := createBaseData()
+		shipment.ID = 1
+
+		rows := shipmentRows().AddRow(shipment.ID, shipment.TrackingCode, shipment.PurchaseOrderID,
+			shipment.CarrierID, shipment.Status, shipment.CreatedAt)
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlGetByPurchaseOrder)).WithArgs(1).WillReturnRows(rows)
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.GetByPurchaseOrder(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Shipment{shipment}, result)
+	})
+	t.Run("get_by_purchase_order_empty", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlGetByPurchaseOrder)).WithArgs(1).WillReturnRows(shipmentRows())
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.GetByPurchaseOrder(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Shipment{}, result)
+	})
+	t.Run("get_by_purchase_order_fail_scan", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlGetByPurchaseOrder)).WithArgs(1).WillReturnRows(rows)
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.GetByPurchaseOrder(context.Background(), 1)
+		assert.Error(t, err)
+		assert.Equal(t, []domain.Shipment{}, result)
+	})
+}
+
+func TestRepositoryGetEvents(t *testing.T) {
+	t.Run("get_events", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		event := domain.Event{ID: 1, ShipmentID: 1, Status: domain.STATUS_PICKED_UP, Description: "collected", OccurredAt: "2022-08-01 12:00:00"}
+
+		rows := sqlmock.NewRows([]string{"id", "shipment_id", "status", "description", "occurred_at"}).
+			AddRow(event.ID, event.ShipmentID, event.Status, event.Description, event.OccurredAt)
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlGetEvents)).WithArgs(1).WillReturnRows(rows)
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.GetEvents(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Event{event}, result)
+	})
+	t.Run("get_events_fail_query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlGetEvents)).WithArgs(1).WillReturnError(sql.ErrConnDone)
+
+		repo := shipmentRepo.NewRepository(db)
+		_, err = repo.GetEvents(context.Background(), 1)
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+}
+
+func TestRepositoryAddEvent(t *testing.T) {
+	event := domain.Event{ShipmentID: 1, Status: domain.STATUS_PICKED_UP, Description: "collected", OccurredAt: "2022-08-01 12:00:00"}
+	lockStatus := func(mock sqlmock.Sqlmock, status string) {
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlLockStatus)).WithArgs(event.ShipmentID).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(status))
+	}
+
+	t.Run("add_event_ok", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		lockStatus(mock, domain.STATUS_CREATED)
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlCreateEvent)).WithArgs(event.ShipmentID, event.Status,
+			event.Description, event.OccurredAt).WillReturnResult(sqlmock.NewResult(3, 1))
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlUpdateStatus)).WithArgs(event.Status, event.ShipmentID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.AddEvent(context.Background(), event, domain.STATUS_CREATED)
+		assert.NoError(t, err)
+		expected := event
+		expected.ID = 3
+		assert.Equal(t, expected, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("add_event_fail_exec", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		lockStatus(mock, domain.STATUS_CREATED)
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlCreateEvent)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.AddEvent(context.Background(), event, domain.STATUS_CREATED)
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.Equal(t, domain.Event{}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("add_event_fail_update_status", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		lockStatus(mock, domain.STATUS_CREATED)
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlCreateEvent)).WillReturnResult(sqlmock.NewResult(3, 1))
+		mock.ExpectExec(regexp.QuoteMeta(shipmentRepo.SqlUpdateStatus)).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.AddEvent(context.Background(), event, domain.STATUS_CREATED)
+		assert.Equal(t, sql.ErrConnDone, err)
+		assert.Equal(t, domain.Event{}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("add_event_status_changed", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		lockStatus(mock, domain.STATUS_FAILED)
+		mock.ExpectRollback()
+
+		repo := shipmentRepo.NewRepository(db)
+		result, err := repo.AddEvent(context.Background(), event, domain.STATUS_CREATED)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_TRANSITION), err)
+		assert.Equal(t, domain.Event{}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	t.Run("add_event_shipment_not_found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(shipmentRepo.SqlLockStatus)).WithArgs(event.ShipmentID).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		repo := shipmentRepo.NewRepository(db)
+		_, err = repo.AddEvent(context.Background(), event, domain.STATUS_CREATED)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
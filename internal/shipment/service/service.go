@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
+	purchaseOrders "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+)
+
+const (
+	TRACKING_CODE_PREFIX = "MF"
+
+	dateTimeLayout = "2006-01-02 15:04:05"
+)
+
+type service struct {
+	repository            domain.Repository
+	purchaseOrdersService purchaseOrders.Service
+	carryService          usecases.ServiceCarry
+}
+
+func NewService(r domain.Repository, purchaseOrdersService purchaseOrders.Service, carryService usecases.ServiceCarry) domain.Service {
+	return &service{repository: r, purchaseOrdersService: purchaseOrdersService, carryService: carryService}
+}
+
+// Create ships the purchase order with the carrier, unless the order was
+// cancelled or returned.
+func (s *service) Create(ctx context.Context, purchaseOrderID, carrierID int) (domain.Shipment, error) {
+	purchaseOrder, err := s.purchaseOrdersService.GetById(ctx, purchaseOrderID)
+	if err != nil {
+		return domain.Shipment{}, fmt.Errorf(domain.ERROR_PURCHASE_ORDER_NOT_FOUND)
+	}
+
+	switch purchaseOrder.Status {
+	case purchaseOrders.STATUS_CANCELLED, purchaseOrders.STATUS_RETURNED:
+		return domain.Shipment{}, fmt.Errorf(domain.ERROR_PURCHASE_ORDER_CLOSED)
+	}
+
+	if _, err := s.carryService.GetByID(carrierID); err != nil {
+		if err.Error() == usecases.ERR_CARRY_NOT_FOUND {
+			return domain.Shipment{}, fmt.Errorf(domain.ERROR_CARRIER_NOT_FOUND)
+		}
+		return domain.Shipment{}, err
+	}
+
+	trackingCode, err := newTrackingCode()
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	shipment := domain.Shipment{
+		TrackingCode:    trackingCode,
+		PurchaseOrderID: purchaseOrderID,
+		CarrierID:       carrierID,
+		Status:          domain.STATUS_CREATED,
+		CreatedAt:       time.Now().Format(dateTimeLayout),
+	}
+
+	shipment, err = s.repository.Create(ctx, shipment)
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	shipment.Events = []domain.Event{}
+
+	return shipment, nil
+}
+
+func (s *service) GetById(ctx context.Context, id int) (domain.Shipment, error) {
+	shipment, err := s.repository.GetById(ctx, id)
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	shipment.Events, err = s.repository.GetEvents(ctx, shipment.ID)
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	return shipment, nil
+}
+
+// GetByPurchaseOrder returns the shipments of the order with their tracking
+// events, which together make the delivery timeline of the order.
+func (s *service) GetByPurchaseOrder(ctx context.Context, purchaseOrderID int) ([]domain.Shipment, error) {
+	shipments, err := s.repository.GetByPurchaseOrder(ctx, purchaseOrderID)
+	if err != nil {
+		return []domain.Shipment{}, err
+	}
+
+	for i := range shipments {
+		shipments[i].Events, err = s.repository.GetEvents(ctx, shipments[i].ID)
+		if err != nil {
+			return []domain.Shipment{}, err
+		}
+	}
+
+	return shipments, nil
+}
+
+func (s *service) AddEvent(ctx context.Context, shipmentID int, status, description string) (domain.Shipment, error) {
+	if _, ok := domain.Transitions[status]; !ok || status == domain.STATUS_CREATED {
+		return domain.Shipment{}, fmt.Errorf(domain.ERROR_INVALID_EVENT_STATUS)
+	}
+
+	shipment, err := s.repository.GetById(ctx, shipmentID)
+	if err != nil {
+		return domain.Shipment{}, err
+	}
+
+	if !canTransition(shipment.Status, status) {
+		return domain.Shipment{}, fmt.Errorf(domain.ERROR_INVALID_TRANSITION)
+	}
+
+	event := domain.Event{
+		ShipmentID:  shipmentID,
+		Status:      status,
+		Description: description,
+		OccurredAt:  time.Now().Format(dateTimeLayout),
+	}
+
+	if _, err = s.repository.AddEvent(ctx, event, shipment.Status); err != nil {
+		return domain.Shipment{}, err
+	}
+
+	return s.GetById(ctx, shipmentID)
+}
+
+func canTransition(from, to string) bool {
+	for _, status := range domain.Transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// newTrackingCode generates a code like MF3F9A01C27BD4, random enough to
+// be unique among the shipments.
+func newTrackingCode() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%X", TRACKING_CODE_PREFIX, b), nil
+}
@@ -0,0 +1,302 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	carry "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases/mock/mock_service_carry"
+	purchaseOrders "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain"
+	purchaseOrdersMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/purchase_orders/domain/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/domain/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipment/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func createBaseData() []domain.Shipment {
+	return []domain.Shipment{
+		{
+			ID:              1,
+			TrackingCode:    "MF3F9A01C27BD4",
+			PurchaseOrderID: 1,
+			CarrierID:       2,
+			Status:          domain.STATUS_CREATED,
+			CreatedAt:       "2022-08-01 10:00:00",
+		},
+		{
+			ID:              2,
+			TrackingCode:    "MF00AA11BB22CC",
+			PurchaseOrderID: 1,
+			CarrierID:       3,
+			Status:          domain.STATUS_IN_TRANSIT,
+			CreatedAt:       "2022-08-02 10:00:00",
+		},
+	}
+}
+
+func createEvents() []domain.Event {
+	return []domain.Event{
+		{ID: 1, ShipmentID: 2, Status: domain.STATUS_PICKED_UP, OccurredAt: "2022-08-02 12:00:00"},
+		{ID: 2, ShipmentID: 2, Status: domain.STATUS_IN_TRANSIT, Description: "left the warehouse", OccurredAt: "2022-08-02 15:30:00"},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Run("create_ok", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockPurchaseOrders := purchaseOrdersMocks.NewService(t)
+		mockCarry := mock_service_carry.NewServiceCarry(t)
+		newService := service.NewService(mockRepository, mockPurchaseOrders, mockCarry)
+
+		mockPurchaseOrders.On("GetById", ctx, 1).Return(purchaseOrders.PurchaseOrders{ID: 1}, nil)
+		mockCarry.On("GetByID", 2).Return(carry.Carry{ID: 2}, nil)
+		mockRepository.On("Create", ctx, mock.MatchedBy(func(s domain.Shipment) bool {
+			return strings.HasPrefix(s.TrackingCode, service.TRACKING_CODE_PREFIX) && len(s.TrackingCode) == 14 &&
+				s.PurchaseOrderID == 1 && s.CarrierID == 2 && s.Status == domain.STATUS_CREATED && s.CreatedAt != ""
+		})).Return(func(ctx context.Context, s domain.Shipment) domain.Shipment {
+			s.ID = 1
+			return s
+		}, nil)
+
+		result, err := newService.Create(ctx, 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.ID)
+		assert.Equal(t, domain.STATUS_CREATED, result.Status)
+		assert.Equal(t, []domain.Event{}, result.Events)
+	})
+	t.Run("create_purchase_order_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockPurchaseOrders := purchaseOrdersMocks.NewService(t)
+		newService := service.NewService(mocks.NewRepository(t), mockPurchaseOrders, mock_service_carry.NewServiceCarry(t))
+
+		mockPurchaseOrders.On("GetById", ctx, 10).Return(purchaseOrders.PurchaseOrders{}, fmt.Errorf("purchase order with id (10) not founded"))
+
+		result, err := newService.Create(ctx, 10, 2)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_PURCHASE_ORDER_NOT_FOUND), err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+	t.Run("create_purchase_order_closed", func(t *testing.T) {
+		ctx := context.Background()
+		mockPurchaseOrders := purchaseOrdersMocks.NewService(t)
+		newService := service.NewService(mocks.NewRepository(t), mockPurchaseOrders, mock_service_carry.NewServiceCarry(t))
+
+		mockPurchaseOrders.On("GetById", ctx, 1).Return(purchaseOrders.PurchaseOrders{ID: 1, Status: purchaseOrders.STATUS_CANCELLED}, nil)
+		mockPurchaseOrders.On("GetById", ctx, 2).Return(purchaseOrders.PurchaseOrders{ID: 2, Status: purchaseOrders.STATUS_RETURNED}, nil)
+
+		for _, id := range []int{1, 2} {
+			result, err := newService.Create(ctx, id, 2)
+			assert.Equal(t, fmt.Errorf(domain.ERROR_PURCHASE_ORDER_CLOSED), err)
+			assert.Equal(t, domain.Shipment{}, result)
+		}
+	})
+	t.Run("create_carrier_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockPurchaseOrders := purchaseOrdersMocks.NewService(t)
+		mockCarry := mock_service_carry.NewServiceCarry(t)
+		newService := service.NewService(mocks.NewRepository(t), mockPurchaseOrders, mockCarry)
+
+		mockPurchaseOrders.On("GetById", ctx, 1).Return(purchaseOrders.PurchaseOrders{ID: 1}, nil)
+		mockCarry.On("GetByID", 20).Return(carry.Carry{}, fmt.Errorf(usecases.ERR_CARRY_NOT_FOUND))
+
+		result, err := newService.Create(ctx, 1, 20)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_CARRIER_NOT_FOUND), err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+	t.Run("create_carrier_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockPurchaseOrders := purchaseOrdersMocks.NewService(t)
+		mockCarry := mock_service_carry.NewServiceCarry(t)
+		newService := service.NewService(mocks.NewRepository(t), mockPurchaseOrders, mockCarry)
+
+		mockPurchaseOrders.On("GetById", ctx, 1).Return(purchaseOrders.PurchaseOrders{ID: 1}, nil)
+		mockCarry.On("GetByID", 2).Return(carry.Carry{}, fmt.Errorf("connection refused"))
+
+		_, err := newService.Create(ctx, 1, 2)
+		assert.Equal(t, fmt.Errorf("connection refused"), err)
+	})
+	t.Run("create_repository_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		mockPurchaseOrders := purchaseOrdersMocks.NewService(t)
+		mockCarry := mock_service_carry.NewServiceCarry(t)
+		newService := service.NewService(mockRepository, mockPurchaseOrders, mockCarry)
+
+		mockPurchaseOrders.On("GetById", ctx, 1).Return(purchaseOrders.PurchaseOrders{ID: 1}, nil)
+		mockCarry.On("GetByID", 2).Return(carry.Carry{ID: 2}, nil)
+		mockRepository.On("Create", ctx, mock.AnythingOfType("domain.Shipment")).Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_WHILE_SAVING))
+
+		result, err := newService.Create(ctx, 1, 2)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_WHILE_SAVING), err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+}
+
+func TestGetById(t *testing.T) {
+	t.Run("find_by_id_existent", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+		data := createBaseData()
+
+		mockRepository.On("GetById", ctx, 2).Return(data[1], nil)
+		mockRepository.On("GetEvents", ctx, 2).Return(createEvents(), nil)
+
+		result, err := newService.GetById(ctx, 2)
+		assert.NoError(t, err)
+		data[1].Events = createEvents()
+		assert.Equal(t, data[1], result)
+	})
+	t.Run("find_by_id_non_existent", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+
+		mockRepository.On("GetById", ctx, 10).Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND))
+
+		result, err := newService.GetById(ctx, 10)
+		assert.Equal(t, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND), err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+	t.Run("find_by_id_events_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+
+		mockRepository.On("GetById", ctx, 2).Return(createBaseData()[1], nil)
+		mockRepository.On("GetEvents", ctx, 2).Return([]domain.Event{}, fmt.Errorf("connection refused"))
+
+		result, err := newService.GetById(ctx, 2)
+		assert.Equal(t, fmt.Errorf("connection refused"), err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+}
+
+func TestGetByPurchaseOrder(t *testing.T) {
+	t.Run("get_timeline", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+		data := createBaseData()
+
+		mockRepository.On("GetByPurchaseOrder", ctx, 1).Return(data, nil)
+		mockRepository.On("GetEvents", ctx, 1).Return([]domain.Event{}, nil)
+		mockRepository.On("GetEvents", ctx, 2).Return(createEvents(), nil)
+
+		result, err := newService.GetByPurchaseOrder(ctx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, []domain.Event{}, result[0].Events)
+		assert.Equal(t, createEvents(), result[1].Events)
+	})
+	t.Run("get_timeline_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+
+		mockRepository.On("GetByPurchaseOrder", ctx, 1).Return([]domain.Shipment{}, fmt.Errorf("connection refused"))
+
+		result, err := newService.GetByPurchaseOrder(ctx, 1)
+		assert.Equal(t, fmt.Errorf("connection refused"), err)
+		assert.Equal(t, []domain.Shipment{}, result)
+	})
+	t.Run("get_timeline_events_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+
+		mockRepository.On("GetByPurchaseOrder", ctx, 1).Return(createBaseData(), nil)
+		mockRepository.On("GetEvents", ctx, 1).Return([]domain.Event{}, fmt.Errorf("connection refused"))
+
+		result, err := newService.GetByPurchaseOrder(ctx, 1)
+		assert.Equal(t, fmt.Errorf("connection refused"), err)
+		assert.Equal(t, []domain.Shipment{}, result)
+	})
+}
+
+func TestAddEvent(t *testing.T) {
+	t.Run("add_event_ok", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+		data := createBaseData()
+		delivered := data[1]
+		delivered.Status = domain.STATUS_DELIVERED
+		events := append(createEvents(), domain.Event{ID: 3, ShipmentID: 2, Status: domain.STATUS_DELIVERED, OccurredAt: "2022-08-03 09:00:00"})
+
+		mockRepository.On("GetById", ctx, 2).Return(data[1], nil).Once()
+		mockRepository.On("AddEvent", ctx, mock.MatchedBy(func(e domain.Event) bool {
+			return e.ShipmentID == 2 && e.Status == domain.STATUS_DELIVERED && e.Description == "signed by the buyer" && e.OccurredAt != ""
+		}), data[1].Status).Return(events[2], nil)
+		mockRepository.On("GetById", ctx, 2).Return(delivered, nil).Once()
+		mockRepository.On("GetEvents", ctx, 2).Return(events, nil)
+
+		result, err := newService.AddEvent(ctx, 2, domain.STATUS_DELIVERED, "signed by the buyer")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.STATUS_DELIVERED, result.Status)
+		assert.Equal(t, events, result.Events)
+	})
+	t.Run("add_event_invalid_status", func(t *testing.T) {
+		newService := service.NewService(mocks.NewRepository(t), nil, nil)
+
+		for _, status := range []string{"lost", domain.STATUS_CREATED} {
+			_, err := newService.AddEvent(context.Background(), 1, status, "")
+			assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_EVENT_STATUS), err)
+		}
+	})
+	t.Run("add_event_shipment_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+
+		mockRepository.On("GetById", ctx, 10).Return(domain.Shipment{}, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND))
+
+		_, err := newService.AddEvent(ctx, 10, domain.STATUS_PICKED_UP, "")
+		assert.Equal(t, fmt.Errorf(domain.ERROR_SHIPMENT_NOT_FOUND), err)
+	})
+	t.Run("add_event_invalid_transition", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+		data := createBaseData()
+		data[1].Status = domain.STATUS_DELIVERED
+
+		mockRepository.On("GetById", ctx, 1).Return(data[0], nil)
+		mockRepository.On("GetById", ctx, 2).Return(data[1], nil)
+
+		_, err := newService.AddEvent(ctx, 1, domain.STATUS_DELIVERED, "")
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_TRANSITION), err)
+
+		_, err = newService.AddEvent(ctx, 2, domain.STATUS_FAILED, "")
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_TRANSITION), err)
+	})
+	t.Run("add_event_status_changed_meanwhile", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+
+		mockRepository.On("GetById", ctx, 1).Return(createBaseData()[0], nil)
+		mockRepository.On("AddEvent", ctx, mock.AnythingOfType("domain.Event"), domain.STATUS_CREATED).Return(domain.Event{}, fmt.Errorf(domain.ERROR_INVALID_TRANSITION))
+
+		result, err := newService.AddEvent(ctx, 1, domain.STATUS_PICKED_UP, "")
+		assert.Equal(t, fmt.Errorf(domain.ERROR_INVALID_TRANSITION), err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+	t.Run("add_event_repository_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockRepository := mocks.NewRepository(t)
+		newService := service.NewService(mockRepository, nil, nil)
+
+		mockRepository.On("GetById", ctx, 1).Return(createBaseData()[0], nil)
+		mockRepository.On("AddEvent", ctx, mock.AnythingOfType("domain.Event"), domain.STATUS_CREATED).Return(domain.Event{}, fmt.Errorf(domain.ERROR_WHILE_SAVING))
+
+		result, err := newService.AddEvent(ctx, 1, domain.STATUS_PICKED_UP, "")
+		assert.Equal(t, fmt.Errorf(domain.ERROR_WHILE_SAVING), err)
+		assert.Equal(t, domain.Shipment{}, result)
+	})
+}
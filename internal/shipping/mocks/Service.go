@@ -0,0 +1,51 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	shipping "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipping"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// Quote provides a mock function with given fields: ctx, req
+func (_m *Service) Quote(ctx context.Context, req shipping.QuoteRequest) (shipping.Quote, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 shipping.Quote
+	if rf, ok := ret.Get(0).(func(context.Context, shipping.QuoteRequest) shipping.Quote); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(shipping.Quote)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, shipping.QuoteRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
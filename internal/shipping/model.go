@@ -0,0 +1,31 @@
+package shipping
+
+type QuoteItem struct {
+	ProductID int `json:"product_id"`
+	Quantity  int `json:"quantity"`
+}
+
+// QuoteRequest asks the price of delivering the items from a warehouse to a
+// locality. Without a warehouse the one nearest to the locality is used.
+type QuoteRequest struct {
+	LocalityID  int         `json:"locality_id"`
+	WarehouseID int         `json:"warehouse_id"`
+	Items       []QuoteItem `json:"items"`
+}
+
+type Option struct {
+	CarryID     int     `json:"carry_id"`
+	Cid         string  `json:"cid"`
+	CompanyName string  `json:"company_name"`
+	Price       float64 `json:"price"`
+}
+
+// Quote has the delivery options of the serving carries, cheapest first.
+type Quote struct {
+	WarehouseID  int      `json:"warehouse_id"`
+	LocalityID   int      `json:"locality_id"`
+	DistanceKm   float64  `json:"distance_km"`
+	WeightKg     float64  `json:"weight_kg"`
+	Refrigerated bool     `json:"refrigerated"`
+	Options      []Option `json:"options"`
+}
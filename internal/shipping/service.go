@@ -0,0 +1,154 @@
+package shipping
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	carry "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	products "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product"
+	warehouse "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/geo"
+)
+
+const (
+	ERR_NO_ITEMS            = "the quote needs at least one item"
+	ERR_INVALID_QUANTITY    = "the quantity of every item must be greater than zero"
+	ERR_PRODUCT_NOT_FOUND   = "product not found"
+	ERR_WAREHOUSE_NOT_FOUND = "warehouse not found"
+	ERR_NO_WAREHOUSE        = "there is no located warehouse to ship from"
+
+	// VOLUMETRIC_DIVISOR turns the volume of a product in cm³ into the
+	// weight in kg carriers charge for the space it takes.
+	VOLUMETRIC_DIVISOR = 5000.0
+
+	// REFRIGERATED_MAX_TEMPERATURE is the highest recommended freezing
+	// temperature, in °C, of a product that must travel refrigerated.
+	REFRIGERATED_MAX_TEMPERATURE = 8.0
+)
+
+type Service interface {
+	Quote(ctx context.Context, req QuoteRequest) (Quote, error)
+}
+
+type service struct {
+	productService   products.Service
+	warehouseService warehouse.Service
+	localityService  locality.Service
+	carryService     carry.ServiceCarry
+}
+
+func NewService(productService products.Service, warehouseService warehouse.Service,
+	localityService locality.Service, carryService carry.ServiceCarry) Service {
+	return &service{
+		productService:   productService,
+		warehouseService: warehouseService,
+		localityService:  localityService,
+		carryService:     carryService,
+	}
+}
+
+// Quote prices the delivery with every carry that serves the locality. The
+// weight of each product is the greatest of its net weight and its volumetric
+// weight, and one refrigerated product makes the whole load refrigerated.
+func (s *service) Quote(ctx context.Context, req QuoteRequest) (Quote, error) {
+	if len(req.Items) == 0 {
+		return Quote{}, fmt.Errorf(ERR_NO_ITEMS)
+	}
+
+	quote := Quote{LocalityID: req.LocalityID, Options: []Option{}}
+
+	for _, item := range req.Items {
+		if item.Quantity <= 0 {
+			return Quote{}, fmt.Errorf(ERR_INVALID_QUANTITY)
+		}
+
+		product, err := s.productService.GetById(ctx, item.ProductID)
+		if err != nil {
+			return Quote{}, fmt.Errorf(ERR_PRODUCT_NOT_FOUND)
+		}
+
+		volumetric := product.Width * product.Height * product.Length / VOLUMETRIC_DIVISOR
+		quote.WeightKg += math.Max(product.NetWeight, volumetric) * float64(item.Quantity)
+
+		if product.RecommendedFreezingTemperature <= REFRIGERATED_MAX_TEMPERATURE {
+			quote.Refrigerated = true
+		}
+	}
+
+	destination, err := s.localityService.GetById(ctx, req.LocalityID)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	if destination.Latitude == nil || destination.Longitude == nil {
+		return Quote{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_LOCATED)
+	}
+
+	quote.WarehouseID, quote.DistanceKm, err = s.origin(ctx, req, *destination.Latitude, *destination.Longitude)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	carries, err := s.carryService.GetServing(ctx, req.LocalityID)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	for _, c := range carries {
+		quote.Options = append(quote.Options, Option{
+			CarryID:     c.ID,
+			Cid:         c.Cid,
+			CompanyName: c.Name,
+			Price:       round(c.RateCard.Price(quote.WeightKg, quote.DistanceKm, quote.Refrigerated)),
+		})
+	}
+
+	sort.SliceStable(quote.Options, func(i, j int) bool {
+		return quote.Options[i].Price < quote.Options[j].Price
+	})
+
+	quote.WeightKg = round(quote.WeightKg)
+	quote.DistanceKm = round(quote.DistanceKm)
+
+	return quote, nil
+}
+
+// origin returns the warehouse the load leaves from and its distance to the
+// destination.
+func (s *service) origin(ctx context.Context, req QuoteRequest, latitude, longitude float64) (int, float64, error) {
+	if req.WarehouseID == 0 {
+		nearest, err := s.warehouseService.NearestWarehouses(ctx, req.LocalityID, 1)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if len(nearest) == 0 {
+			return 0, 0, fmt.Errorf(ERR_NO_WAREHOUSE)
+		}
+
+		return nearest[0].ID, nearest[0].DistanceKm, nil
+	}
+
+	w, err := s.warehouseService.GetByID(req.WarehouseID)
+	if err != nil {
+		return 0, 0, fmt.Errorf(ERR_WAREHOUSE_NOT_FOUND)
+	}
+
+	origin, err := s.localityService.GetById(ctx, w.LocalityID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if origin.Latitude == nil || origin.Longitude == nil {
+		return 0, 0, fmt.Errorf(locality.ERR_LOCALITY_NOT_LOCATED)
+	}
+
+	return w.ID, geo.Distance(*origin.Latitude, *origin.Longitude, latitude, longitude), nil
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
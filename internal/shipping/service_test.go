@@ -0,0 +1,187 @@
+package shipping_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	carryDomain "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/carry/usecases/mock/mock_service_carry"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	localityMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality/mocks"
+	products "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product"
+	productMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/shipping"
+	warehouseDomain "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases/mock/mock_service"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/geo"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	spLatitude, spLongitude             = -23.5505, -46.6333
+	campinasLatitude, campinasLongitude = -22.9099, -47.0626
+
+	saoPaulo = locality.Locality{Id: 1, LocalityName: "São Paulo", ProvinceID: 1, Latitude: &spLatitude, Longitude: &spLongitude}
+	campinas = locality.Locality{Id: 2, LocalityName: "Campinas", ProvinceID: 1, Latitude: &campinasLatitude, Longitude: &campinasLongitude}
+
+	// 10x10x10 cm weighs more than its volumetric 0.2 kg.
+	apple = products.Product{ID: 1, Width: 10, Height: 10, Length: 10, NetWeight: 0.5, RecommendedFreezingTemperature: 12}
+	// 40x30x50 cm has a volumetric weight of 12 kg and must travel cold.
+	milk = products.Product{ID: 2, Width: 40, Height: 30, Length: 50, NetWeight: 3, RecommendedFreezingTemperature: 4}
+
+	cheap     = carryDomain.CarryRate{Carry: carryDomain.Carry{ID: 1, Cid: "CHEAP", Name: "Cheap"}, RateCard: carryDomain.RateCard{CarryID: 1, BasePrice: 5, PricePerKg: 1, PricePerKm: 0.1, RefrigeratedSurcharge: 40}}
+	expensive = carryDomain.CarryRate{Carry: carryDomain.Carry{ID: 2, Cid: "FAST", Name: "Fast"}, RateCard: carryDomain.RateCard{CarryID: 2, BasePrice: 20, PricePerKg: 2, PricePerKm: 0.5, RefrigeratedSurcharge: 10}}
+)
+
+type serviceMocks struct {
+	products   *productMocks.Service
+	warehouses *mock_service.Service
+	localities *localityMocks.Service
+	carries    *mock_service_carry.ServiceCarry
+}
+
+func newService(t *testing.T) (shipping.Service, serviceMocks) {
+	m := serviceMocks{
+		products:   productMocks.NewService(t),
+		warehouses: mock_service.NewService(t),
+		localities: localityMocks.NewService(t),
+		carries:    mock_service_carry.NewServiceCarry(t),
+	}
+	return shipping.NewService(m.products, m.warehouses, m.localities, m.carries), m
+}
+
+func TestService_Quote(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Deve cotar o frete das carries que atendem a locality, do mais barato ao mais caro", func(t *testing.T) {
+		service, m := newService(t)
+
+		m.products.On("GetById", ctx, 1).Return(apple, nil)
+		m.products.On("GetById", ctx, 2).Return(milk, nil)
+		m.localities.On("GetById", ctx, 2).Return(campinas, nil)
+		m.warehouses.On("GetByID", 5).Return(warehouseDomain.Warehouse{ID: 5, LocalityID: 1}, nil)
+		m.localities.On("GetById", ctx, 1).Return(saoPaulo, nil)
+		m.carries.On("GetServing", ctx, 2).Return([]carryDomain.CarryRate{expensive, cheap}, nil)
+
+		quote, err := service.Quote(ctx, shipping.QuoteRequest{LocalityID: 2, WarehouseID: 5, Items: []shipping.QuoteItem{
+			{ProductID: 1, Quantity: 4},
+			{ProductID: 2, Quantity: 1},
+		}})
+
+		distance := geo.Distance(spLatitude, spLongitude, campinasLatitude, campinasLongitude)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, quote.WarehouseID)
+		assert.Equal(t, 14.0, quote.WeightKg)
+		assert.InDelta(t, distance, quote.DistanceKm, 0.01)
+		assert.True(t, quote.Refrigerated)
+		assert.Len(t, quote.Options, 2)
+		assert.Equal(t, "CHEAP", quote.Options[0].Cid)
+		assert.InDelta(t, 5+14+0.1*distance+40, quote.Options[0].Price, 0.01)
+		assert.Equal(t, "FAST", quote.Options[1].Cid)
+		assert.InDelta(t, 20+28+0.5*distance+10, quote.Options[1].Price, 0.01)
+	})
+
+	t.Run("Deve usar o warehouse mais próximo quando nenhum for informado", func(t *testing.T) {
+		service, m := newService(t)
+
+		m.products.On("GetById", ctx, 1).Return(apple, nil)
+		m.localities.On("GetById", ctx, 2).Return(campinas, nil)
+		m.warehouses.On("NearestWarehouses", ctx, 2, 1).
+			Return([]warehouseDomain.NearestWarehouse{{Warehouse: warehouseDomain.Warehouse{ID: 7}, DistanceKm: 3.456}}, nil)
+		m.carries.On("GetServing", ctx, 2).Return([]carryDomain.CarryRate{cheap}, nil)
+
+		quote, err := service.Quote(ctx, shipping.QuoteRequest{LocalityID: 2, Items: []shipping.QuoteItem{{ProductID: 1, Quantity: 1}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, quote.WarehouseID)
+		assert.Equal(t, 3.46, quote.DistanceKm)
+		assert.False(t, quote.Refrigerated)
+		assert.Equal(t, []shipping.Option{{CarryID: 1, Cid: "CHEAP", CompanyName: "Cheap", Price: 5.85}}, quote.Options)
+	})
+
+	t.Run("Deve retornar uma cotação sem opções se nenhuma carry atender a locality", func(t *testing.T) {
+		service, m := newService(t)
+
+		m.products.On("GetById", ctx, 1).Return(apple, nil)
+		m.localities.On("GetById", ctx, 2).Return(campinas, nil)
+		m.warehouses.On("NearestWarehouses", ctx, 2, 1).
+			Return([]warehouseDomain.NearestWarehouse{{Warehouse: warehouseDomain.Warehouse{ID: 7}, DistanceKm: 3}}, nil)
+		m.carries.On("GetServing", ctx, 2).Return([]carryDomain.CarryRate{}, nil)
+
+		quote, err := service.Quote(ctx, shipping.QuoteRequest{LocalityID: 2, Items: []shipping.QuoteItem{{ProductID: 1, Quantity: 1}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []shipping.Option{}, quote.Options)
+	})
+
+	t.Run("Deve retornar erro sem itens ou com quantidade inválida", func(t *testing.T) {
+		service, _ := newService(t)
+
+		_, err := service.Quote(ctx, shipping.QuoteRequest{LocalityID: 2})
+		assert.EqualError(t, err, shipping.ERR_NO_ITEMS)
+
+		_, err = service.Quote(ctx, shipping.QuoteRequest{LocalityID: 2, Items: []shipping.QuoteItem{{ProductID: 1}}})
+		assert.EqualError(t, err, shipping.ERR_INVALID_QUANTITY)
+	})
+
+	t.Run("Deve retornar erro quando o produto não existir", func(t *testing.T) {
+		service, m := newService(t)
+
+		m.products.On("GetById", ctx, 9).Return(products.Product{}, fmt.Errorf("product 9 not found"))
+
+		_, err := service.Quote(ctx, shipping.QuoteRequest{LocalityID: 2, Items: []shipping.QuoteItem{{ProductID: 9, Quantity: 1}}})
+
+		assert.EqualError(t, err, shipping.ERR_PRODUCT_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro quando a locality de destino não tiver coordenadas", func(t *testing.T) {
+		service, m := newService(t)
+
+		m.products.On("GetById", ctx, 1).Return(apple, nil)
+		m.localities.On("GetById", ctx, 3).Return(locality.Locality{Id: 3}, nil)
+
+		_, err := service.Quote(ctx, shipping.QuoteRequest{LocalityID: 3, Items: []shipping.QuoteItem{{ProductID: 1, Quantity: 1}}})
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_LOCATED)
+	})
+
+	t.Run("Deve retornar erro quando o warehouse não existir", func(t *testing.T) {
+		service, m := newService(t)
+
+		m.products.On("GetById", ctx, 1).Return(apple, nil)
+		m.localities.On("GetById", ctx, 2).Return(campinas, nil)
+		m.warehouses.On("GetByID", 9).Return(warehouseDomain.Warehouse{}, fmt.Errorf("o id: 9 não foi encontrado"))
+
+		_, err := service.Quote(ctx, shipping.QuoteRequest{LocalityID: 2, WarehouseID: 9, Items: []shipping.QuoteItem{{ProductID: 1, Quantity: 1}}})
+
+		assert.EqualError(t, err, shipping.ERR_WAREHOUSE_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro quando não houver warehouse com coordenadas", func(t *testing.T) {
+		service, m := newService(t)
+
+		m.products.On("GetById", ctx, 1).Return(apple, nil)
+		m.localities.On("GetById", ctx, 2).Return(campinas, nil)
+		m.warehouses.On("NearestWarehouses", ctx, 2, 1).Return([]warehouseDomain.NearestWarehouse{}, nil)
+
+		_, err := service.Quote(ctx, shipping.QuoteRequest{LocalityID: 2, Items: []shipping.QuoteItem{{ProductID: 1, Quantity: 1}}})
+
+		assert.EqualError(t, err, shipping.ERR_NO_WAREHOUSE)
+	})
+
+	t.Run("Deve retornar erro quando a busca das carries falhar", func(t *testing.T) {
+		service, m := newService(t)
+
+		m.products.On("GetById", ctx, 1).Return(apple, nil)
+		m.localities.On("GetById", ctx, 2).Return(campinas, nil)
+		m.warehouses.On("NearestWarehouses", ctx, 2, 1).
+			Return([]warehouseDomain.NearestWarehouse{{Warehouse: warehouseDomain.Warehouse{ID: 7}, DistanceKm: 3}}, nil)
+		m.carries.On("GetServing", ctx, 2).Return([]carryDomain.CarryRate{}, fmt.Errorf("erro ao executar a query"))
+
+		_, err := service.Quote(ctx, shipping.QuoteRequest{LocalityID: 2, Items: []shipping.QuoteItem{{ProductID: 1, Quantity: 1}}})
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
@@ -2,6 +2,7 @@ package adapters
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
@@ -21,9 +22,13 @@ const (
 
 	queryFindByWarehouseCode = "SELECT * FROM warehouse WHERE warehouse_code=?"
 
-	queryUpdateWarehouse = "UPDATE warehouse SET warehouse_code=? WHERE id=?"
+	queryUpdateAllWarehouse = "UPDATE warehouse SET warehouse_code=?, address=?, telephone=?, locality_id=? WHERE id=?"
 
 	queryDeleteWarehouse = "DELETE FROM warehouse WHERE id=?"
+
+	queryGetAllLocated = `SELECT w.id, w.warehouse_code, w.address, w.telephone, w.locality_id, l.latitude, l.longitude
+		FROM warehouse w JOIN localities l ON l.id = w.locality_id
+		WHERE l.latitude IS NOT NULL AND l.longitude IS NOT NULL`
 )
 
 func NewMySqlRepository(db *sql.DB) usecases.Repository {
@@ -62,8 +67,12 @@ func (r mysqlRepository) GetByID(id int) (domain.Warehouse, error) {
 
 	err := stmt.Scan(&warehouse.ID, &warehouse.WarehouseCode, &warehouse.Address, &warehouse.Telephone, &warehouse.LocalityID)
 
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Warehouse{}, fmt.Errorf(usecases.ERR_WAREHOUSE_ID_NOT_FOUND, id)
+	}
+
 	if err != nil {
-		return domain.Warehouse{}, fmt.Errorf("o id: %d não foi encontrado", id)
+		return domain.Warehouse{}, err
 	}
 
 	return warehouse, nil
@@ -105,14 +114,8 @@ func (r *mysqlRepository) CreateWarehouse(
 
 }
 
-func (r *mysqlRepository) UpdatedWarehouseID(id int, code string) (domain.Warehouse, error) {
-	warehouse, err := r.GetByID(id)
-
-	if err != nil {
-		return warehouse, fmt.Errorf("id inexistente no banco de dados")
-	}
-
-	stmt, err := r.db.Prepare(queryUpdateWarehouse)
+func (r *mysqlRepository) UpdateWarehouse(warehouse domain.Warehouse) (domain.Warehouse, error) {
+	stmt, err := r.db.Prepare(queryUpdateAllWarehouse)
 
 	if err != nil {
 		return domain.Warehouse{}, fmt.Errorf("erro ao preparar a query")
@@ -120,19 +123,13 @@ func (r *mysqlRepository) UpdatedWarehouseID(id int, code string) (domain.Wareho
 
 	defer stmt.Close()
 
-	_, err = stmt.Exec(code, id)
+	_, err = stmt.Exec(warehouse.WarehouseCode, warehouse.Address, warehouse.Telephone, warehouse.LocalityID, warehouse.ID)
 
 	if err != nil {
 		return domain.Warehouse{}, fmt.Errorf("erro ao executar a query")
 	}
 
-	return domain.Warehouse{
-		ID:            id,
-		WarehouseCode: code,
-		Address:       warehouse.Address,
-		Telephone:     warehouse.Telephone,
-		LocalityID:    warehouse.LocalityID,
-	}, nil
+	return warehouse, nil
 }
 
 func (r *mysqlRepository) DeleteWarehouse(id int) error {
@@ -174,3 +171,33 @@ func (r mysqlRepository) FindByWarehouseCode(code string) (domain.Warehouse, err
 	return warehouse, nil
 
 }
+
+func (r mysqlRepository) GetAllLocated() ([]domain.WarehouseLocation, error) {
+	rows, err := r.db.Query(queryGetAllLocated)
+
+	if err != nil {
+		return []domain.WarehouseLocation{}, fmt.Errorf("erro ao executar a query")
+	}
+
+	defer rows.Close()
+
+	warehouses := []domain.WarehouseLocation{}
+
+	for rows.Next() {
+		w := domain.WarehouseLocation{}
+
+		err := rows.Scan(&w.ID, &w.WarehouseCode, &w.Address, &w.Telephone, &w.LocalityID, &w.Latitude, &w.Longitude)
+
+		if err != nil {
+			return []domain.WarehouseLocation{}, err
+		}
+
+		warehouses = append(warehouses, w)
+	}
+
+	if err = rows.Err(); err != nil {
+		return []domain.WarehouseLocation{}, err
+	}
+
+	return warehouses, nil
+}
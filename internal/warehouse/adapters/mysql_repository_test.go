@@ -10,6 +10,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/adapters"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -110,6 +111,22 @@ func Test_GetByID(t *testing.T) {
 
 	})
 
+	t.Run("Deve retornar um erro de não encontrado, se o id não existir.", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM warehouse WHERE id=?`)).WillReturnError(sql.ErrNoRows)
+
+		_, err := repository.GetByID(9)
+
+		assert.EqualError(t, err, fmt.Sprintf(usecases.ERR_WAREHOUSE_ID_NOT_FOUND, 9))
+	})
+
+	t.Run("Deve retornar o erro da query, se a consulta falhar.", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM warehouse WHERE id=?`)).WillReturnError(sql.ErrConnDone)
+
+		_, err := repository.GetByID(1)
+
+		assert.Equal(t, sql.ErrConnDone, err)
+	})
+
 }
 
 func Test_CreateWarehouse(t *testing.T) {
@@ -163,8 +180,8 @@ func Test_CreateWarehouse(t *testing.T) {
 	})
 }
 
-func Test_UpdateWarehouse(t *testing.T) {
-	db, mock, err := sqlmock.New() // cria mock do banco de dados
+func Test_UpdateWarehouseFields(t *testing.T) {
+	db, mock, err := sqlmock.New()
 
 	if err != nil {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
@@ -172,71 +189,41 @@ func Test_UpdateWarehouse(t *testing.T) {
 
 	repository := adapters.NewMySqlRepository(db)
 
-	t.Run("Deve preparar uma query e executar corretamente.", func(t *testing.T) {
+	t.Run("Deve atualizar todos os campos do Warehouse.", func(t *testing.T) {
 
-		row := sqlmock.NewRows([]string{
-			"id", "warehouse_code", "address", "telephone", "locality_id",
-		}).AddRow(
-			validWarehouse.ID,
+		mock.ExpectPrepare(regexp.QuoteMeta("UPDATE warehouse SET warehouse_code=?, address=?, telephone=?, locality_id=? WHERE id=?")).ExpectExec().WithArgs(
 			validWarehouse.WarehouseCode,
 			validWarehouse.Address,
 			validWarehouse.Telephone,
 			validWarehouse.LocalityID,
-		)
-
-		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM warehouse WHERE id=?")).WillReturnRows(row)
-
-		mock.ExpectPrepare("UPDATE warehouse SET").ExpectExec().WithArgs(validWarehouse.WarehouseCode, validWarehouse.ID).WillReturnResult(sqlmock.NewResult(1, 1))
-
-		repository.UpdatedWarehouseID(validWarehouse.ID, validWarehouse.WarehouseCode)
+			validWarehouse.ID,
+		).WillReturnResult(sqlmock.NewResult(0, 1))
 
-		err = mock.ExpectationsWereMet() // Verifica se as expectativas anteriormente foram cumpridas com sucesso.
+		result, err := repository.UpdateWarehouse(validWarehouse)
 
 		assert.Nil(t, err)
+		assert.Equal(t, validWarehouse, result)
+		assert.Nil(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("Deve retornar um erro quando o prepare retornar um erro.", func(t *testing.T) {
 
-		row := sqlmock.NewRows([]string{
-			"id", "warehouse_code", "address", "telephone", "locality_id",
-		}).AddRow(
-			validWarehouse.ID,
-			validWarehouse.WarehouseCode,
-			validWarehouse.Address,
-			validWarehouse.Telephone,
-			validWarehouse.LocalityID,
-		)
-
-		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM warehouse WHERE id=?")).WillReturnRows(row)
-
 		mock.ExpectPrepare("UPDATE warehouse SET").WillReturnError(fmt.Errorf("erro ao preparar a query"))
 
-		result, err := repository.UpdatedWarehouseID(validWarehouse.ID, validWarehouse.WarehouseCode)
+		result, err := repository.UpdateWarehouse(validWarehouse)
 
-		assert.NotNil(t, err)
+		assert.EqualError(t, err, "erro ao preparar a query")
 		assert.Equal(t, domain.Warehouse{}, result)
 	})
 
 	t.Run("Deve retornar um erro ao executar a query", func(t *testing.T) {
 
-		row := sqlmock.NewRows([]string{
-			"id", "warehouse_code", "address", "telephone", "locality_id",
-		}).AddRow(
-			validWarehouse.ID,
-			validWarehouse.WarehouseCode,
-			validWarehouse.Address,
-			validWarehouse.Telephone,
-			validWarehouse.LocalityID,
-		)
-
-		mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM warehouse WHERE id=?")).WillReturnRows(row)
-
-		mock.ExpectPrepare("UPDATE warehouse SET").ExpectExec().WithArgs(validWarehouse.WarehouseCode, validWarehouse.ID).WillReturnError(fmt.Errorf("erro ao executar a query"))
+		mock.ExpectPrepare("UPDATE warehouse SET").ExpectExec().WillReturnError(fmt.Errorf("erro ao executar a query"))
 
-		_, err = repository.UpdatedWarehouseID(validWarehouse.ID, validWarehouse.WarehouseCode)
-
-		assert.Error(t, err)
+		result, err := repository.UpdateWarehouse(validWarehouse)
 
+		assert.EqualError(t, err, "erro ao executar a query")
+		assert.Equal(t, domain.Warehouse{}, result)
 	})
 }
 
@@ -293,3 +280,59 @@ func Test_DeleteWarehouse(t *testing.T) {
 		assert.EqualError(t, err, "erro ao executar query: xablau")
 	})
 }
+
+func Test_GetAllLocated(t *testing.T) {
+
+	db, mock, err := sqlmock.New()
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repository := adapters.NewMySqlRepository(db)
+
+	t.Run("Deve retornar os warehouses com as coordenadas da locality.", func(t *testing.T) {
+
+		row := sqlmock.NewRows([]string{
+			"id", "warehouse_code", "address", "telephone", "locality_id", "latitude", "longitude",
+		}).AddRow(
+			validWarehouse.ID,
+			validWarehouse.WarehouseCode,
+			validWarehouse.Address,
+			validWarehouse.Telephone,
+			validWarehouse.LocalityID,
+			-23.5505,
+			-46.6333,
+		)
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT w.id, w.warehouse_code")).WillReturnRows(row)
+
+		result, err := repository.GetAllLocated()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []domain.WarehouseLocation{{Warehouse: validWarehouse, Latitude: -23.5505, Longitude: -46.6333}}, result)
+	})
+
+	t.Run("Deve retornar um erro ao executar a query.", func(t *testing.T) {
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT w.id, w.warehouse_code")).WillReturnError(sql.ErrConnDone)
+
+		result, err := repository.GetAllLocated()
+
+		assert.EqualError(t, err, "erro ao executar a query")
+		assert.Empty(t, result)
+	})
+
+	t.Run("Deve retornar um erro no Scan.", func(t *testing.T) {
+
+		row := sqlmock.NewRows([]string{"id", "warehouse_code"}).AddRow(1, "caju")
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT w.id, w.warehouse_code")).WillReturnRows(row)
+
+		_, err := repository.GetAllLocated()
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+package domain
+
+// Summary is the state of a warehouse: the capacity and temperatures of its
+// sections, the stock of their batches, its employees and the inbound orders
+// it received from From to To, both inclusive.
+type Summary struct {
+	Warehouse     Warehouse       `json:"warehouse"`
+	From          string          `json:"from"`
+	To            string          `json:"to"`
+	Sections      SectionsSummary `json:"sections"`
+	Batches       BatchesSummary  `json:"product_batches"`
+	Employees     int             `json:"employees"`
+	InboundOrders InboundSummary  `json:"inbound_orders"`
+}
+
+// SectionsSummary adds up the sections of a warehouse. The temperature
+// ranges are nil when the warehouse has no sections.
+type SectionsSummary struct {
+	Count              int               `json:"count"`
+	TotalCapacity      int               `json:"total_capacity"`
+	UsedCapacity       int               `json:"used_capacity"`
+	Utilization        float64           `json:"utilization_percentage"`
+	MinimumTemperature *TemperatureRange `json:"minimum_temperature"`
+	CurrentTemperature *TemperatureRange `json:"current_temperature"`
+}
+
+type TemperatureRange struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// BatchesSummary adds up the product batches stored in the sections of a
+// warehouse, in total and by product.
+type BatchesSummary struct {
+	Count           int            `json:"count"`
+	CurrentQuantity int            `json:"current_quantity"`
+	InitialQuantity int            `json:"initial_quantity"`
+	ExpiredBatches  int            `json:"expired_batches"`
+	Products        []ProductStock `json:"products"`
+}
+
+type ProductStock struct {
+	ProductID       int `json:"product_id"`
+	Batches         int `json:"batches"`
+	CurrentQuantity int `json:"current_quantity"`
+	InitialQuantity int `json:"initial_quantity"`
+	ExpiredBatches  int `json:"expired_batches"`
+}
+
+// InboundSummary is what a warehouse received in the period of its summary.
+type InboundSummary struct {
+	Count          int `json:"count"`
+	Employees      int `json:"employees"`
+	ProductBatches int `json:"product_batches"`
+}
@@ -7,3 +7,16 @@ type Warehouse struct {
 	Telephone     string `json:"telephone"`
 	LocalityID    int    `json:"locality_id"`
 }
+
+// WarehouseLocation is a warehouse with the coordinates of its locality.
+type WarehouseLocation struct {
+	Warehouse
+	Latitude  float64
+	Longitude float64
+}
+
+// NearestWarehouse is a warehouse with its distance to the searched locality.
+type NearestWarehouse struct {
+	Warehouse
+	DistanceKm float64 `json:"distance_km"`
+}
@@ -84,6 +84,29 @@ func (_m *Repository) GetAll() []domain.Warehouse {
 	return r0
 }
 
+// GetAllLocated provides a mock function with given fields:
+func (_m *Repository) GetAllLocated() ([]domain.WarehouseLocation, error) {
+	ret := _m.Called()
+
+	var r0 []domain.WarehouseLocation
+	if rf, ok := ret.Get(0).(func() []domain.WarehouseLocation); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WarehouseLocation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: id
 func (_m *Repository) GetByID(id int) (domain.Warehouse, error) {
 	ret := _m.Called(id)
@@ -105,20 +128,20 @@ func (_m *Repository) GetByID(id int) (domain.Warehouse, error) {
 	return r0, r1
 }
 
-// UpdatedWarehouseID provides a mock function with given fields: id, code
-func (_m *Repository) UpdatedWarehouseID(id int, code string) (domain.Warehouse, error) {
-	ret := _m.Called(id, code)
+// UpdateWarehouse provides a mock function with given fields: warehouse
+func (_m *Repository) UpdateWarehouse(warehouse domain.Warehouse) (domain.Warehouse, error) {
+	ret := _m.Called(warehouse)
 
 	var r0 domain.Warehouse
-	if rf, ok := ret.Get(0).(func(int, string) domain.Warehouse); ok {
-		r0 = rf(id, code)
+	if rf, ok := ret.Get(0).(func(domain.Warehouse) domain.Warehouse); ok {
+		r0 = rf(warehouse)
 	} else {
 		r0 = ret.Get(0).(domain.Warehouse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, string) error); ok {
-		r1 = rf(id, code)
+	if rf, ok := ret.Get(1).(func(domain.Warehouse) error); ok {
+		r1 = rf(warehouse)
 	} else {
 		r1 = ret.Error(1)
 	}
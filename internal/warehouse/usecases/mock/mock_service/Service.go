@@ -3,6 +3,8 @@
 package mock_service
 
 import (
+	context "context"
+
 	domain "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -84,20 +86,43 @@ func (_m *Service) GetByID(id int) (domain.Warehouse, error) {
 	return r0, r1
 }
 
-// UpdatedWarehouseID provides a mock function with given fields: id, code
-func (_m *Service) UpdatedWarehouseID(id int, code string) (domain.Warehouse, error) {
-	ret := _m.Called(id, code)
+// NearestWarehouses provides a mock function with given fields: ctx, localityID, limit
+func (_m *Service) NearestWarehouses(ctx context.Context, localityID int, limit int) ([]domain.NearestWarehouse, error) {
+	ret := _m.Called(ctx, localityID, limit)
+
+	var r0 []domain.NearestWarehouse
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []domain.NearestWarehouse); ok {
+		r0 = rf(ctx, localityID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.NearestWarehouse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, localityID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWarehouse provides a mock function with given fields: warehouse
+func (_m *Service) UpdateWarehouse(warehouse domain.Warehouse) (domain.Warehouse, error) {
+	ret := _m.Called(warehouse)
 
 	var r0 domain.Warehouse
-	if rf, ok := ret.Get(0).(func(int, string) domain.Warehouse); ok {
-		r0 = rf(id, code)
+	if rf, ok := ret.Get(0).(func(domain.Warehouse) domain.Warehouse); ok {
+		r0 = rf(warehouse)
 	} else {
 		r0 = ret.Get(0).(domain.Warehouse)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int, string) error); ok {
-		r1 = rf(id, code)
+	if rf, ok := ret.Get(1).(func(domain.Warehouse) error); ok {
+		r1 = rf(warehouse)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,51 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mock_summary_service
+
+import (
+	context "context"
+
+	domain "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SummaryService is an autogenerated mock type for the SummaryService type
+type SummaryService struct {
+	mock.Mock
+}
+
+// Summary provides a mock function with given fields: ctx, id, from, to
+func (_m *SummaryService) Summary(ctx context.Context, id int, from string, to string) (domain.Summary, error) {
+	ret := _m.Called(ctx, id, from, to)
+
+	var r0 domain.Summary
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) domain.Summary); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		r0 = ret.Get(0).(domain.Summary)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
+		r1 = rf(ctx, id, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSummaryService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSummaryService creates a new instance of SummaryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSummaryService(t mockConstructorTestingTNewSummaryService) *SummaryService {
+	mock := &SummaryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,6 +2,10 @@ package usecases
 
 import "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
 
+const (
+	ERR_WAREHOUSE_ID_NOT_FOUND = "o id: %d não foi encontrado"
+)
+
 type Repository interface {
 	GetAll() []domain.Warehouse
 	GetByID(id int) (domain.Warehouse, error)
@@ -10,7 +14,8 @@ type Repository interface {
 		address,
 		tel string,
 		localityID int) (domain.Warehouse, error)
-	UpdatedWarehouseID(id int, code string) (domain.Warehouse, error)
+	UpdateWarehouse(warehouse domain.Warehouse) (domain.Warehouse, error)
 	DeleteWarehouse(id int) error
 	FindByWarehouseCode(code string) (domain.Warehouse, error)
+	GetAllLocated() ([]domain.WarehouseLocation, error)
 }
@@ -1,9 +1,13 @@
 package usecases
 
 import (
+	"context"
 	"fmt"
+	"sort"
 
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/geo"
 )
 
 type Service interface {
@@ -14,16 +18,18 @@ type Service interface {
 		address,
 		tel string,
 		localityID int) (domain.Warehouse, error)
-	UpdatedWarehouseID(id int, code string) (domain.Warehouse, error)
+	UpdateWarehouse(warehouse domain.Warehouse) (domain.Warehouse, error)
 	DeleteWarehouse(id int) error
+	NearestWarehouses(ctx context.Context, localityID, limit int) ([]domain.NearestWarehouse, error)
 }
 
 type service struct {
-	repository Repository
+	repository      Repository
+	localityService locality.Service
 }
 
-func NewService(r Repository) Service {
-	return &service{r}
+func NewService(r Repository, localityService locality.Service) Service {
+	return &service{repository: r, localityService: localityService}
 }
 
 func (s service) GetAll() []domain.Warehouse {
@@ -59,14 +65,14 @@ func (s service) CreateWarehouse(code, address, tel string, localityID int) (dom
 	return warehouse, nil
 }
 
-func (s service) UpdatedWarehouseID(id int, code string) (domain.Warehouse, error) {
-	_, err := s.repository.FindByWarehouseCode(code)
+func (s service) UpdateWarehouse(warehouse domain.Warehouse) (domain.Warehouse, error) {
+	found, err := s.repository.FindByWarehouseCode(warehouse.WarehouseCode)
 
-	if err == nil {
+	if err == nil && found.ID != warehouse.ID {
 		return domain.Warehouse{}, fmt.Errorf("o `warehouse_code` já está em uso")
 	}
 
-	warehouse, err := s.repository.UpdatedWarehouseID(id, code)
+	warehouse, err = s.repository.UpdateWarehouse(warehouse)
 
 	if err != nil {
 		return domain.Warehouse{}, err
@@ -84,3 +90,43 @@ func (s service) DeleteWarehouse(id int) error {
 
 	return nil
 }
+
+// NearestWarehouses orders the warehouses by the haversine distance between
+// their locality and the given one. Warehouses whose locality has no
+// coordinates are left out.
+func (s service) NearestWarehouses(ctx context.Context, localityID, limit int) ([]domain.NearestWarehouse, error) {
+	origin, err := s.localityService.GetById(ctx, localityID)
+
+	if err != nil {
+		return []domain.NearestWarehouse{}, err
+	}
+
+	if origin.Latitude == nil || origin.Longitude == nil {
+		return []domain.NearestWarehouse{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_LOCATED)
+	}
+
+	located, err := s.repository.GetAllLocated()
+
+	if err != nil {
+		return []domain.NearestWarehouse{}, err
+	}
+
+	nearest := make([]domain.NearestWarehouse, 0, len(located))
+
+	for _, w := range located {
+		nearest = append(nearest, domain.NearestWarehouse{
+			Warehouse:  w.Warehouse,
+			DistanceKm: geo.Distance(*origin.Latitude, *origin.Longitude, w.Latitude, w.Longitude),
+		})
+	}
+
+	sort.SliceStable(nearest, func(i, j int) bool {
+		return nearest[i].DistanceKm < nearest[j].DistanceKm
+	})
+
+	if limit > 0 && len(nearest) > limit {
+		nearest = nearest[:limit]
+	}
+
+	return nearest, nil
+}
@@ -1,9 +1,12 @@
 package usecases_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality"
+	localityMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/locality/mocks"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases"
 	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases/mock/mock_repository"
@@ -24,7 +27,7 @@ func makeValidDBWarehouse() domain.Warehouse {
 func Test_CreateWarehouse(t *testing.T) {
 	t.Run("Deve conter os campos necessários para ser criado um Warehouse.", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		data := domain.Warehouse{
 			WarehouseCode: "j753",
@@ -49,7 +52,7 @@ func Test_CreateWarehouse(t *testing.T) {
 
 	t.Run("Deve retornar um warehouse vazio se já existir um `warehouse_code`. ", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		data := domain.Warehouse{
 			WarehouseCode: "j753",
@@ -73,7 +76,7 @@ func Test_CreateWarehouse(t *testing.T) {
 
 	t.Run("Deve retornar um erro caso CreateWarehouse, retorne um error", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		data := domain.Warehouse{
 			WarehouseCode: "j753",
@@ -99,7 +102,7 @@ func Test_CreateWarehouse(t *testing.T) {
 func Test_GetAll(t *testing.T) {
 	t.Run("Deve retornar todos os elementos que estão na lista de warehouses", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		w := makeValidDBWarehouse()
 		expected := []domain.Warehouse{w}
@@ -117,7 +120,7 @@ func Test_GetAll(t *testing.T) {
 func Test_GetById(t *testing.T) {
 	t.Run("Deve retornar warehouse vazio e um erro, se um elemento com o id especifíco não existir.", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		mockRepository.On("GetByID", 1).Return(domain.Warehouse{}, fmt.Errorf("o id: %d não foi encontrado", 1))
 
@@ -131,7 +134,7 @@ func Test_GetById(t *testing.T) {
 
 	t.Run("Deve retornar um Warehouse, com o id solicitado.", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		expected := makeValidDBWarehouse()
 
@@ -145,62 +148,75 @@ func Test_GetById(t *testing.T) {
 	})
 }
 
-func Test_UpdateWarehouseID(t *testing.T) {
-	t.Run("Deve atualizar com sucesso o campo `warehouse_code` do Warehouse com o ID informado.", func(t *testing.T) {
+func Test_UpdateWarehouse(t *testing.T) {
+	t.Run("Deve atualizar com sucesso todos os campos do Warehouse.", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		expected := makeValidDBWarehouse()
 
-		mockRepository.On("FindByWarehouseCode", mock.AnythingOfType("string")).Return(domain.Warehouse{}, fmt.Errorf("o warehouse com esse `warehouse_code`: %s não foi encontrado", expected.WarehouseCode))
+		mockRepository.On("FindByWarehouseCode", expected.WarehouseCode).Return(domain.Warehouse{}, fmt.Errorf("o warehouse com esse `warehouse_code`: %s não foi encontrado", expected.WarehouseCode))
 
-		mockRepository.On("UpdatedWarehouseID", 1, "j753").Return(expected, nil)
+		mockRepository.On("UpdateWarehouse", expected).Return(expected, nil)
 
-		result, err := service.UpdatedWarehouseID(1, "j753")
+		result, err := service.UpdateWarehouse(expected)
 
 		assert.Nil(t, err)
-		assert.Equal(t, result, expected)
-		assert.NotEmpty(t, result)
+		assert.Equal(t, expected, result)
 	})
 
-	t.Run("Deve retornar um erro se já exister um Warehouse com o mesmo `warehouse_code`.", func(t *testing.T) {
+	t.Run("Deve permitir manter o mesmo `warehouse_code` do próprio Warehouse.", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		expected := makeValidDBWarehouse()
 
-		mockRepository.On("FindByWarehouseCode", mock.AnythingOfType("string")).Return(expected, nil)
+		mockRepository.On("FindByWarehouseCode", expected.WarehouseCode).Return(expected, nil)
 
-		result, err := service.UpdatedWarehouseID(1, "j753")
+		mockRepository.On("UpdateWarehouse", expected).Return(expected, nil)
 
-		assert.NotNil(t, err)
-		assert.Equal(t, err, fmt.Errorf("o `warehouse_code` já está em uso"))
-		assert.Equal(t, result, domain.Warehouse{})
+		result, err := service.UpdateWarehouse(expected)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
 	})
 
-	t.Run("Deve retornar um erro caso UpdatedWarehouseID, retorne um error", func(t *testing.T) {
+	t.Run("Deve retornar um erro se outro Warehouse já usar o mesmo `warehouse_code`.", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
+
+		other := makeValidDBWarehouse()
+		other.ID = 2
+
+		mockRepository.On("FindByWarehouseCode", other.WarehouseCode).Return(other, nil)
+
+		result, err := service.UpdateWarehouse(makeValidDBWarehouse())
+
+		assert.Equal(t, fmt.Errorf("o `warehouse_code` já está em uso"), err)
+		assert.Equal(t, domain.Warehouse{}, result)
+	})
+
+	t.Run("Deve retornar um erro caso UpdateWarehouse, retorne um error", func(t *testing.T) {
+		mockRepository := mock_repository.NewRepository(t)
+		service := usecases.NewService(mockRepository, nil)
 
 		expected := makeValidDBWarehouse()
 
-		mockRepository.On("FindByWarehouseCode", mock.AnythingOfType("string")).Return(domain.Warehouse{}, fmt.Errorf("o warehouse com esse `warehouse_code`: %s não foi encontrado", expected.WarehouseCode))
+		mockRepository.On("FindByWarehouseCode", expected.WarehouseCode).Return(domain.Warehouse{}, fmt.Errorf("o warehouse com esse `warehouse_code`: %s não foi encontrado", expected.WarehouseCode))
 
-		mockRepository.On("UpdatedWarehouseID", 1, "j753").Return(domain.Warehouse{}, fmt.Errorf("o id: %d informado não existe", expected.ID))
+		mockRepository.On("UpdateWarehouse", expected).Return(domain.Warehouse{}, fmt.Errorf("erro ao executar a query"))
 
-		result, err := service.UpdatedWarehouseID(1, expected.WarehouseCode)
+		result, err := service.UpdateWarehouse(expected)
 
-		assert.NotNil(t, err)
-		assert.Equal(t, result, domain.Warehouse{})
-		assert.Equal(t, err, fmt.Errorf("o id: %d informado não existe", expected.ID))
-		assert.Error(t, err)
+		assert.Equal(t, fmt.Errorf("erro ao executar a query"), err)
+		assert.Equal(t, domain.Warehouse{}, result)
 	})
 }
 
 func Test_DeleteWarehouse(t *testing.T) {
 	t.Run("Deve deletar um Warehouse com sucesso passando um id válido.", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		expected := makeValidDBWarehouse()
 
@@ -214,7 +230,7 @@ func Test_DeleteWarehouse(t *testing.T) {
 
 	t.Run("Deve retornar um erro se não achar um Warehouse com o id passado.", func(t *testing.T) {
 		mockRepository := mock_repository.NewRepository(t)
-		service := usecases.NewService(mockRepository)
+		service := usecases.NewService(mockRepository, nil)
 
 		expected := makeValidDBWarehouse()
 
@@ -226,3 +242,68 @@ func Test_DeleteWarehouse(t *testing.T) {
 		assert.Equal(t, err, fmt.Errorf("não foi achado warehouse com esse id: %d", expected.ID))
 	})
 }
+
+func Test_NearestWarehouses(t *testing.T) {
+	latitude, longitude := -23.5505, -46.6333
+	saoPaulo := locality.Locality{Id: 1, LocalityName: "São Paulo", Latitude: &latitude, Longitude: &longitude}
+
+	located := []domain.WarehouseLocation{
+		{Warehouse: domain.Warehouse{ID: 1, WarehouseCode: "RJ01", LocalityID: 2}, Latitude: -22.9068, Longitude: -43.1729},
+		{Warehouse: domain.Warehouse{ID: 2, WarehouseCode: "GRU1", LocalityID: 3}, Latitude: -23.4538, Longitude: -46.5333},
+		{Warehouse: domain.Warehouse{ID: 3, WarehouseCode: "CWB1", LocalityID: 4}, Latitude: -25.4284, Longitude: -49.2733},
+	}
+
+	t.Run("Deve retornar os warehouses ordenados pela distância e limitados.", func(t *testing.T) {
+		mockRepository := mock_repository.NewRepository(t)
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewService(mockRepository, mockLocalityService)
+
+		mockLocalityService.On("GetById", context.Background(), 1).Return(saoPaulo, nil)
+		mockRepository.On("GetAllLocated").Return(located, nil)
+
+		result, err := service.NearestWarehouses(context.Background(), 1, 2)
+
+		assert.Nil(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "GRU1", result[0].WarehouseCode)
+		assert.Equal(t, "CWB1", result[1].WarehouseCode)
+		assert.InDelta(t, 14.8, result[0].DistanceKm, 0.5)
+	})
+
+	t.Run("Deve retornar um erro se a locality não existir.", func(t *testing.T) {
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewService(mock_repository.NewRepository(t), mockLocalityService)
+
+		mockLocalityService.On("GetById", context.Background(), 9).Return(locality.Locality{}, fmt.Errorf(locality.ERR_LOCALITY_NOT_FOUND))
+
+		result, err := service.NearestWarehouses(context.Background(), 9, 1)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_FOUND)
+		assert.Empty(t, result)
+	})
+
+	t.Run("Deve retornar um erro se a locality não tiver coordenadas.", func(t *testing.T) {
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewService(mock_repository.NewRepository(t), mockLocalityService)
+
+		mockLocalityService.On("GetById", context.Background(), 1).Return(locality.Locality{Id: 1}, nil)
+
+		result, err := service.NearestWarehouses(context.Background(), 1, 1)
+
+		assert.EqualError(t, err, locality.ERR_LOCALITY_NOT_LOCATED)
+		assert.Empty(t, result)
+	})
+
+	t.Run("Deve retornar um erro se a consulta dos warehouses falhar.", func(t *testing.T) {
+		mockRepository := mock_repository.NewRepository(t)
+		mockLocalityService := localityMocks.NewService(t)
+		service := usecases.NewService(mockRepository, mockLocalityService)
+
+		mockLocalityService.On("GetById", context.Background(), 1).Return(saoPaulo, nil)
+		mockRepository.On("GetAllLocated").Return(nil, fmt.Errorf("erro ao executar a query"))
+
+		_, err := service.NearestWarehouses(context.Background(), 1, 1)
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
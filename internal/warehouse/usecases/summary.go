@@ -0,0 +1,187 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/employee"
+	inboundorders "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/inbound_orders"
+	productbatch "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
+)
+
+const (
+	ERR_WAREHOUSE_NOT_FOUND = "o warehouse não foi encontrado"
+	ERR_INVALID_DATE        = "as datas devem estar no formato YYYY-MM-DD"
+	ERR_INVALID_PERIOD      = "o from não pode ser depois do to"
+
+	// SUMMARY_DAYS is the length of the period of a summary without dates,
+	// ending today.
+	SUMMARY_DAYS = 30
+
+	summaryDateLayout = "2006-01-02"
+	summaryTimeLayout = "2006-01-02 15:04:05"
+)
+
+type SummaryService interface {
+	Summary(ctx context.Context, id int, from, to string) (domain.Summary, error)
+}
+
+type summaryService struct {
+	repository         Repository
+	sectionRepository  section.Repository
+	batchRepository    productbatch.Repository
+	employeeRepository employee.Repository
+	inboundRepository  inboundorders.Repository
+}
+
+func NewSummaryService(r Repository, sectionRepository section.Repository, batchRepository productbatch.Repository,
+	employeeRepository employee.Repository, inboundRepository inboundorders.Repository) SummaryService {
+	return &summaryService{
+		repository:         r,
+		sectionRepository:  sectionRepository,
+		batchRepository:    batchRepository,
+		employeeRepository: employeeRepository,
+		inboundRepository:  inboundRepository,
+	}
+}
+
+// Summary puts together the sections, batches, employees and inbound orders
+// of the warehouse. Empty dates default to the last SUMMARY_DAYS days; they
+// only narrow the inbound orders, the rest is the current state.
+func (s summaryService) Summary(ctx context.Context, id int, from, to string) (domain.Summary, error) {
+	start, end, err := summaryPeriod(from, to)
+	if err != nil {
+		return domain.Summary{}, err
+	}
+
+	warehouse, err := s.repository.GetByID(id)
+	if err != nil && err.Error() == fmt.Sprintf(ERR_WAREHOUSE_ID_NOT_FOUND, id) {
+		return domain.Summary{}, fmt.Errorf(ERR_WAREHOUSE_NOT_FOUND)
+	}
+	if err != nil {
+		return domain.Summary{}, err
+	}
+
+	summary := domain.Summary{
+		Warehouse: warehouse,
+		From:      start.Format(summaryDateLayout),
+		To:        end.Format(summaryDateLayout),
+	}
+
+	sections, err := s.sectionRepository.GetByWarehouse(id)
+	if err != nil {
+		return domain.Summary{}, err
+	}
+	summary.Sections = summarizeSections(sections)
+
+	stock, err := s.batchRepository.WarehouseStock(ctx, id)
+	if err != nil {
+		return domain.Summary{}, err
+	}
+	summary.Batches = summarizeBatches(stock)
+
+	summary.Employees, err = s.employeeRepository.CountByWarehouse(id)
+	if err != nil {
+		return domain.Summary{}, err
+	}
+
+	activity, err := s.inboundRepository.GetActivityByWarehouse(id, start.Format(summaryTimeLayout),
+		end.AddDate(0, 0, 1).Format(summaryTimeLayout))
+	if err != nil {
+		return domain.Summary{}, err
+	}
+	summary.InboundOrders = domain.InboundSummary{
+		Count:          activity.InboundOrders,
+		Employees:      activity.Employees,
+		ProductBatches: activity.ProductBatches,
+	}
+
+	return summary, nil
+}
+
+func summaryPeriod(from, to string) (time.Time, time.Time, error) {
+	now := time.Now()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var err error
+	if to != "" {
+		end, err = time.Parse(summaryDateLayout, to)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf(ERR_INVALID_DATE)
+		}
+	}
+
+	start := end.AddDate(0, 0, 1-SUMMARY_DAYS)
+	if from != "" {
+		start, err = time.Parse(summaryDateLayout, from)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf(ERR_INVALID_DATE)
+		}
+	}
+
+	if start.After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf(ERR_INVALID_PERIOD)
+	}
+
+	return start, end, nil
+}
+
+// summarizeSections adds up the capacities of the sections; the utilization
+// is the percentage of the total capacity in use.
+func summarizeSections(sections []section.Section) domain.SectionsSummary {
+	summary := domain.SectionsSummary{Count: len(sections)}
+
+	for i, sec := range sections {
+		summary.TotalCapacity += sec.MaxCapacity
+		summary.UsedCapacity += sec.CurCapacity
+
+		if i == 0 {
+			summary.MinimumTemperature = &domain.TemperatureRange{Min: sec.MinTemperature, Max: sec.MinTemperature}
+			summary.CurrentTemperature = &domain.TemperatureRange{Min: sec.CurTemperature, Max: sec.CurTemperature}
+			continue
+		}
+
+		widen(summary.MinimumTemperature, sec.MinTemperature)
+		widen(summary.CurrentTemperature, sec.CurTemperature)
+	}
+
+	if summary.TotalCapacity > 0 {
+		summary.Utilization = math.Round(float64(summary.UsedCapacity)/float64(summary.TotalCapacity)*10000) / 100
+	}
+
+	return summary
+}
+
+func widen(r *domain.TemperatureRange, temperature int) {
+	if temperature < r.Min {
+		r.Min = temperature
+	}
+	if temperature > r.Max {
+		r.Max = temperature
+	}
+}
+
+func summarizeBatches(stock []productbatch.ProductStock) domain.BatchesSummary {
+	summary := domain.BatchesSummary{Products: make([]domain.ProductStock, 0, len(stock))}
+
+	for _, product := range stock {
+		summary.Count += product.Batches
+		summary.CurrentQuantity += product.CurrentQuantity
+		summary.InitialQuantity += product.InitialQuantity
+		summary.ExpiredBatches += product.ExpiredBatches
+
+		summary.Products = append(summary.Products, domain.ProductStock{
+			ProductID:       product.ProductID,
+			Batches:         product.Batches,
+			CurrentQuantity: product.CurrentQuantity,
+			InitialQuantity: product.InitialQuantity,
+			ExpiredBatches:  product.ExpiredBatches,
+		})
+	}
+
+	return summary
+}
@@ -0,0 +1,148 @@
+package usecases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	employeeMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/employee/mocks"
+	inboundorders "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/inbound_orders"
+	inboundMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/inbound_orders/mocks"
+	productbatch "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch"
+	batchMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/product_batch/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section"
+	sectionMocks "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/section/mocks"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/domain"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/warehouse/usecases/mock/mock_repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type summaryMocks struct {
+	warehouses *mock_repository.Repository
+	sections   *sectionMocks.Repository
+	batches    *batchMocks.Repository
+	employees  *employeeMocks.Repository
+	inbound    *inboundMocks.Repository
+}
+
+func initSummary(t *testing.T) (usecases.SummaryService, summaryMocks) {
+	m := summaryMocks{
+		warehouses: mock_repository.NewRepository(t),
+		sections:   sectionMocks.NewRepository(t),
+		batches:    batchMocks.NewRepository(t),
+		employees:  employeeMocks.NewRepository(t),
+		inbound:    inboundMocks.NewRepository(t),
+	}
+
+	return usecases.NewSummaryService(m.warehouses, m.sections, m.batches, m.employees, m.inbound), m
+}
+
+func Test_Summary(t *testing.T) {
+	t.Run("Deve somar as sections, batches, employees e inbound orders do warehouse.", func(t *testing.T) {
+		service, m := initSummary(t)
+
+		m.warehouses.On("GetByID", 1).Return(makeValidDBWarehouse(), nil)
+		m.sections.On("GetByWarehouse", 1).Return([]section.Section{
+			{ID: 1, CurTemperature: -18, MinTemperature: -25, CurCapacity: 150, MaxCapacity: 500, WareHouseID: 1},
+			{ID: 2, CurTemperature: 4, MinTemperature: 0, CurCapacity: 50, MaxCapacity: 300, WareHouseID: 1},
+		}, nil)
+		m.batches.On("WarehouseStock", mock.Anything, 1).Return([]productbatch.ProductStock{
+			{ProductID: 1, Batches: 2, CurrentQuantity: 150, InitialQuantity: 300, ExpiredBatches: 1},
+			{ProductID: 3, Batches: 1, CurrentQuantity: 50, InitialQuantity: 60},
+		}, nil)
+		m.employees.On("CountByWarehouse", 1).Return(4, nil)
+		m.inbound.On("GetActivityByWarehouse", 1, "2026-10-01 00:00:00", "2026-10-20 00:00:00").
+			Return(inboundorders.WarehouseActivity{InboundOrders: 5, Employees: 2, ProductBatches: 3}, nil)
+
+		summary, err := service.Summary(context.Background(), 1, "2026-10-01", "2026-10-19")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Summary{
+			Warehouse: makeValidDBWarehouse(),
+			From:      "2026-10-01",
+			To:        "2026-10-19",
+			Sections: domain.SectionsSummary{
+				Count:              2,
+				TotalCapacity:      800,
+				UsedCapacity:       200,
+				Utilization:        25,
+				MinimumTemperature: &domain.TemperatureRange{Min: -25, Max: 0},
+				CurrentTemperature: &domain.TemperatureRange{Min: -18, Max: 4},
+			},
+			Batches: domain.BatchesSummary{
+				Count:           3,
+				CurrentQuantity: 200,
+				InitialQuantity: 360,
+				ExpiredBatches:  1,
+				Products: []domain.ProductStock{
+					{ProductID: 1, Batches: 2, CurrentQuantity: 150, InitialQuantity: 300, ExpiredBatches: 1},
+					{ProductID: 3, Batches: 1, CurrentQuantity: 50, InitialQuantity: 60},
+				},
+			},
+			Employees:     4,
+			InboundOrders: domain.InboundSummary{Count: 5, Employees: 2, ProductBatches: 3},
+		}, summary)
+	})
+
+	t.Run("Deve usar os últimos 30 dias e deixar as temperaturas vazias sem sections.", func(t *testing.T) {
+		service, m := initSummary(t)
+
+		m.warehouses.On("GetByID", 1).Return(makeValidDBWarehouse(), nil)
+		m.sections.On("GetByWarehouse", 1).Return([]section.Section{}, nil)
+		m.batches.On("WarehouseStock", mock.Anything, 1).Return([]productbatch.ProductStock{}, nil)
+		m.employees.On("CountByWarehouse", 1).Return(0, nil)
+		m.inbound.On("GetActivityByWarehouse", 1, "2026-09-01 00:00:00", "2026-10-01 00:00:00").
+			Return(inboundorders.WarehouseActivity{}, nil)
+
+		summary, err := service.Summary(context.Background(), 1, "", "2026-09-30")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2026-09-01", summary.From)
+		assert.Nil(t, summary.Sections.MinimumTemperature)
+		assert.Nil(t, summary.Sections.CurrentTemperature)
+		assert.Equal(t, []domain.ProductStock{}, summary.Batches.Products)
+	})
+
+	t.Run("Deve retornar um erro se as datas forem inválidas.", func(t *testing.T) {
+		service, _ := initSummary(t)
+
+		_, err := service.Summary(context.Background(), 1, "01/10/2026", "")
+		assert.EqualError(t, err, usecases.ERR_INVALID_DATE)
+
+		_, err = service.Summary(context.Background(), 1, "2026-10-19", "2026-10-01")
+		assert.EqualError(t, err, usecases.ERR_INVALID_PERIOD)
+	})
+
+	t.Run("Deve retornar um erro se o warehouse não existir.", func(t *testing.T) {
+		service, m := initSummary(t)
+
+		m.warehouses.On("GetByID", 9).Return(domain.Warehouse{}, fmt.Errorf("o id: 9 não foi encontrado"))
+
+		_, err := service.Summary(context.Background(), 9, "", "")
+
+		assert.EqualError(t, err, usecases.ERR_WAREHOUSE_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar um erro se a consulta do warehouse falhar.", func(t *testing.T) {
+		service, m := initSummary(t)
+
+		m.warehouses.On("GetByID", 1).Return(domain.Warehouse{}, fmt.Errorf("erro ao executar a query"))
+
+		_, err := service.Summary(context.Background(), 1, "", "")
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+
+	t.Run("Deve retornar um erro se a consulta das sections falhar.", func(t *testing.T) {
+		service, m := initSummary(t)
+
+		m.warehouses.On("GetByID", 1).Return(makeValidDBWarehouse(), nil)
+		m.sections.On("GetByWarehouse", 1).Return(nil, fmt.Errorf("erro ao executar a query"))
+
+		_, err := service.Summary(context.Background(), 1, "", "")
+
+		assert.EqualError(t, err, "erro ao executar a query")
+	})
+}
@@ -0,0 +1,47 @@
+package zipcode
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	ERR_INVALID_ZIP_CODE = "zip_code does not match the country format"
+)
+
+// formats holds the postal code pattern of each supported country, keyed by
+// the lower case country name. Zip codes are compared after Normalize.
+var formats = map[string]*regexp.Regexp{
+	"brasil":         regexp.MustCompile(`^\d{8}$`),
+	"brazil":         regexp.MustCompile(`^\d{8}$`),
+	"argentina":      regexp.MustCompile(`^([A-Z]\d{4}[A-Z]{3}|\d{4})$`),
+	"chile":          regexp.MustCompile(`^\d{7}$`),
+	"colombia":       regexp.MustCompile(`^\d{6}$`),
+	"mexico":         regexp.MustCompile(`^\d{5}$`),
+	"méxico":         regexp.MustCompile(`^\d{5}$`),
+	"uruguay":        regexp.MustCompile(`^\d{5}$`),
+	"estados unidos": regexp.MustCompile(`^\d{5}(\d{4})?$`),
+	"united states":  regexp.MustCompile(`^\d{5}(\d{4})?$`),
+}
+
+// Normalize removes the separators users usually type in a zip code.
+func Normalize(zipCode string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "", ".", "").Replace(strings.TrimSpace(zipCode)))
+}
+
+// ValidateFormat checks a normalized zip code against the country pattern.
+// Countries without a known pattern accept any zip code.
+func ValidateFormat(countryName, zipCode string) error {
+	format, ok := formats[strings.ToLower(strings.TrimSpace(countryName))]
+
+	if !ok {
+		return nil
+	}
+
+	if !format.MatchString(zipCode) {
+		return fmt.Errorf(ERR_INVALID_ZIP_CODE)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	zipcode "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// GetByZipCode provides a mock function with given fields: ctx, zipCode
+func (_m *Repository) GetByZipCode(ctx context.Context, zipCode string) ([]zipcode.ZipCode, error) {
+	ret := _m.Called(ctx, zipCode)
+
+	var r0 []zipcode.ZipCode
+	if rf, ok := ret.Get(0).(func(context.Context, string) []zipcode.ZipCode); ok {
+		r0 = rf(ctx, zipCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]zipcode.ZipCode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, zipCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Import provides a mock function with given fields: ctx, zipCodes
+func (_m *Repository) Import(ctx context.Context, zipCodes []zipcode.ZipCode) (int, error) {
+	ret := _m.Called(ctx, zipCodes)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, []zipcode.ZipCode) int); ok {
+		r0 = rf(ctx, zipCodes)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []zipcode.ZipCode) error); ok {
+		r1 = rf(ctx, zipCodes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,73 @@
+// Code generated by mockery v2.13.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	zipcode "github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// Import provides a mock function with given fields: ctx, reader
+func (_m *Service) Import(ctx context.Context, reader io.Reader) (int, error) {
+	ret := _m.Called(ctx, reader)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) int); ok {
+		r0 = rf(ctx, reader)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader) error); ok {
+		r1 = rf(ctx, reader)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Lookup provides a mock function with given fields: ctx, zipCode, countryName
+func (_m *Service) Lookup(ctx context.Context, zipCode string, countryName string) (zipcode.ZipCode, error) {
+	ret := _m.Called(ctx, zipCode, countryName)
+
+	var r0 zipcode.ZipCode
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) zipcode.ZipCode); ok {
+		r0 = rf(ctx, zipCode, countryName)
+	} else {
+		r0 = ret.Get(0).(zipcode.ZipCode)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, zipCode, countryName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t mockConstructorTestingTNewService) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
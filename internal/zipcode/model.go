@@ -0,0 +1,8 @@
+package zipcode
+
+type ZipCode struct {
+	ZipCode      string `json:"zip_code"`
+	LocalityName string `json:"locality_name"`
+	ProvinceName string `json:"province_name"`
+	CountryName  string `json:"country_name"`
+}
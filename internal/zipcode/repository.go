@@ -0,0 +1,86 @@
+package zipcode
+
+import (
+	"context"
+	"database/sql"
+)
+
+type Repository interface {
+	GetByZipCode(ctx context.Context, zipCode string) ([]ZipCode, error)
+	Import(ctx context.Context, zipCodes []ZipCode) (int, error)
+}
+
+const (
+	GETBYZIPCODE = `SELECT zip_code, locality_name, province_name, country_name
+				FROM zip_codes WHERE zip_code = ?`
+	UPSERT = `INSERT INTO zip_codes (zip_code, locality_name, province_name, country_name) VALUES (?,?,?,?)
+				ON DUPLICATE KEY UPDATE locality_name = VALUES(locality_name), province_name = VALUES(province_name)`
+)
+
+type mariaDBRepository struct {
+	db *sql.DB
+}
+
+func NewMariaDBRepository(db *sql.DB) Repository {
+	return &mariaDBRepository{db: db}
+}
+
+func (m mariaDBRepository) GetByZipCode(ctx context.Context, zipCode string) ([]ZipCode, error) {
+	var zipCodeList []ZipCode
+
+	rows, err := m.db.QueryContext(ctx, GETBYZIPCODE, zipCode)
+
+	if err != nil {
+		return zipCodeList, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var z ZipCode
+
+		err = rows.Scan(&z.ZipCode, &z.LocalityName, &z.ProvinceName, &z.CountryName)
+
+		if err != nil {
+			return zipCodeList, err
+		}
+
+		zipCodeList = append(zipCodeList, z)
+	}
+
+	return zipCodeList, nil
+}
+
+// Import saves every zip code in a single transaction, replacing the names
+// of the ones already known.
+func (m mariaDBRepository) Import(ctx context.Context, zipCodes []ZipCode) (int, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	stmt, err := tx.PrepareContext(ctx, UPSERT)
+
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	for _, z := range zipCodes {
+		_, err = stmt.ExecContext(ctx, z.ZipCode, z.LocalityName, z.ProvinceName, z.CountryName)
+
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return len(zipCodes), nil
+}
@@ -0,0 +1,112 @@
+package zipcode_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestRepository_GetByZipCode(t *testing.T) {
+	t.Run("Deve retornar os zip codes com sucesso", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expected := []zipcode.ZipCode{{ZipCode: "01310100", LocalityName: "São Paulo", ProvinceName: "São Paulo", CountryName: "Brasil"}}
+
+		rows := sqlmock.NewRows([]string{"zip_code", "locality_name", "province_name", "country_name"}).
+			AddRow("01310100", "São Paulo", "São Paulo", "Brasil")
+
+		mock.ExpectQuery(regexp.QuoteMeta(zipcode.GETBYZIPCODE)).WithArgs("01310100").WillReturnRows(rows)
+
+		result, err := zipcode.NewMariaDBRepository(db).GetByZipCode(context.Background(), "01310100")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Deve retornar erro no Scan", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"zip_code", "locality_name", "province_name"}).AddRow("01310100", "São Paulo", "São Paulo")
+
+		mock.ExpectQuery(regexp.QuoteMeta(zipcode.GETBYZIPCODE)).WithArgs("01310100").WillReturnRows(rows)
+
+		_, err = zipcode.NewMariaDBRepository(db).GetByZipCode(context.Background(), "01310100")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Deve retornar erro ao realizar o select", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(regexp.QuoteMeta(zipcode.GETBYZIPCODE)).WithArgs("01310100").WillReturnError(fmt.Errorf("error"))
+
+		_, err = zipcode.NewMariaDBRepository(db).GetByZipCode(context.Background(), "01310100")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_Import(t *testing.T) {
+	zipCodeList := []zipcode.ZipCode{
+		{ZipCode: "01310100", LocalityName: "São Paulo", ProvinceName: "São Paulo", CountryName: "Brasil"},
+		{ZipCode: "5000", LocalityName: "Córdoba", ProvinceName: "Córdoba", CountryName: "Argentina"},
+	}
+
+	t.Run("Deve importar os zip codes em uma transação", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		prepare := mock.ExpectPrepare(regexp.QuoteMeta(zipcode.UPSERT))
+		prepare.ExpectExec().WithArgs("01310100", "São Paulo", "São Paulo", "Brasil").WillReturnResult(sqlmock.NewResult(1, 1))
+		prepare.ExpectExec().WithArgs("5000", "Córdoba", "Córdoba", "Argentina").WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+
+		result, err := zipcode.NewMariaDBRepository(db).Import(context.Background(), zipCodeList)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Deve desfazer a transação quando um insert falhar", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		prepare := mock.ExpectPrepare(regexp.QuoteMeta(zipcode.UPSERT))
+		prepare.ExpectExec().WithArgs("01310100", "São Paulo", "São Paulo", "Brasil").WillReturnResult(sqlmock.NewResult(1, 1))
+		prepare.ExpectExec().WithArgs("5000", "Córdoba", "Córdoba", "Argentina").WillReturnError(fmt.Errorf("error"))
+		mock.ExpectRollback()
+
+		_, err = zipcode.NewMariaDBRepository(db).Import(context.Background(), zipCodeList)
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Deve retornar erro ao iniciar a transação", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin().WillReturnError(fmt.Errorf("error"))
+
+		_, err = zipcode.NewMariaDBRepository(db).Import(context.Background(), zipCodeList)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,95 @@
+package zipcode
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	ERR_ZIP_CODE_NOT_FOUND = "zip_code not found"
+	ERR_INVALID_CSV_HEADER = "the csv header must be zip_code,locality_name,province_name,country_name"
+	ERR_INVALID_CSV_LINE   = "line %d: %s"
+)
+
+var csvHeader = []string{"zip_code", "locality_name", "province_name", "country_name"}
+
+type Service interface {
+	Lookup(ctx context.Context, zipCode, countryName string) (ZipCode, error)
+	Import(ctx context.Context, reader io.Reader) (int, error)
+}
+
+type service struct {
+	repository Repository
+}
+
+func NewService(r Repository) Service {
+	return &service{repository: r}
+}
+
+// Lookup finds a zip code in the dataset. When the country is empty the first
+// match is returned.
+func (s service) Lookup(ctx context.Context, zipCode, countryName string) (ZipCode, error) {
+	zipCodeList, err := s.repository.GetByZipCode(ctx, Normalize(zipCode))
+
+	if err != nil {
+		return ZipCode{}, err
+	}
+
+	for _, z := range zipCodeList {
+		if countryName == "" || strings.EqualFold(z.CountryName, countryName) {
+			return z, nil
+		}
+	}
+
+	return ZipCode{}, fmt.Errorf(ERR_ZIP_CODE_NOT_FOUND)
+}
+
+// Import reads a csv with the zip_code,locality_name,province_name,country_name
+// header and saves it. Nothing is saved when a line is invalid.
+func (s service) Import(ctx context.Context, reader io.Reader) (int, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = len(csvHeader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+
+	if err != nil || strings.Join(header, ",") != strings.Join(csvHeader, ",") {
+		return 0, fmt.Errorf(ERR_INVALID_CSV_HEADER)
+	}
+
+	var zipCodeList []ZipCode
+
+	for line := 2; ; line++ {
+		record, err := csvReader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return 0, fmt.Errorf(ERR_INVALID_CSV_LINE, line, err.Error())
+		}
+
+		z := ZipCode{
+			ZipCode:      Normalize(record[0]),
+			LocalityName: strings.TrimSpace(record[1]),
+			ProvinceName: strings.TrimSpace(record[2]),
+			CountryName:  strings.TrimSpace(record[3]),
+		}
+
+		if z.ZipCode == "" || z.LocalityName == "" || z.ProvinceName == "" || z.CountryName == "" {
+			return 0, fmt.Errorf(ERR_INVALID_CSV_LINE, line, "every field is mandatory")
+		}
+
+		if err := ValidateFormat(z.CountryName, z.ZipCode); err != nil {
+			return 0, fmt.Errorf(ERR_INVALID_CSV_LINE, line, err.Error())
+		}
+
+		zipCodeList = append(zipCodeList, z)
+	}
+
+	return s.repository.Import(ctx, zipCodeList)
+}
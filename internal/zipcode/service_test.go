@@ -0,0 +1,139 @@
+package zipcode_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode"
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/internal/zipcode/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/context"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("Deve remover os separadores do zip code", func(t *testing.T) {
+		assert.Equal(t, "01310100", zipcode.Normalize(" 01310-100 "))
+		assert.Equal(t, "C1425AAA", zipcode.Normalize("c1425 aaa"))
+	})
+}
+
+func TestValidateFormat(t *testing.T) {
+	t.Run("Deve aceitar os zip codes no formato do country", func(t *testing.T) {
+		assert.NoError(t, zipcode.ValidateFormat("Brasil", "01310100"))
+		assert.NoError(t, zipcode.ValidateFormat("argentina", "C1425AAA"))
+		assert.NoError(t, zipcode.ValidateFormat("Argentina", "5000"))
+		assert.NoError(t, zipcode.ValidateFormat("México", "06000"))
+	})
+
+	t.Run("Deve recusar os zip codes fora do formato do country", func(t *testing.T) {
+		assert.EqualError(t, zipcode.ValidateFormat("Brasil", "6700"), zipcode.ERR_INVALID_ZIP_CODE)
+		assert.Error(t, zipcode.ValidateFormat("Chile", "83200"))
+	})
+
+	t.Run("Deve aceitar qualquer zip code de um country sem formato conhecido", func(t *testing.T) {
+		assert.NoError(t, zipcode.ValidateFormat("Atlântida", "X"))
+	})
+}
+
+func TestService_Lookup(t *testing.T) {
+	zipCodeList := []zipcode.ZipCode{
+		{ZipCode: "11000", LocalityName: "Montevideo", ProvinceName: "Montevideo", CountryName: "Uruguay"},
+		{ZipCode: "11000", LocalityName: "Ciudad de México", ProvinceName: "Ciudad de México", CountryName: "México"},
+	}
+
+	t.Run("Deve retornar o primeiro zip code quando o country não for informado", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetByZipCode", context.Background(), "11000").Return(zipCodeList, nil)
+
+		result, err := zipcode.NewService(mockRepo).Lookup(context.Background(), "11000", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, zipCodeList[0], result)
+	})
+
+	t.Run("Deve retornar o zip code do country informado", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetByZipCode", context.Background(), "11000").Return(zipCodeList, nil)
+
+		result, err := zipcode.NewService(mockRepo).Lookup(context.Background(), "11-000", "méxico")
+
+		assert.NoError(t, err)
+		assert.Equal(t, zipCodeList[1], result)
+	})
+
+	t.Run("Deve retornar erro quando o zip code não existir", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetByZipCode", context.Background(), "99999").Return([]zipcode.ZipCode{}, nil)
+
+		_, err := zipcode.NewService(mockRepo).Lookup(context.Background(), "99999", "")
+
+		assert.EqualError(t, err, zipcode.ERR_ZIP_CODE_NOT_FOUND)
+	})
+
+	t.Run("Deve retornar erro ao consultar o zip code", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		mockRepo.On("GetByZipCode", context.Background(), "99999").Return(nil, fmt.Errorf("error"))
+
+		_, err := zipcode.NewService(mockRepo).Lookup(context.Background(), "99999", "")
+
+		assert.EqualError(t, err, "error")
+	})
+}
+
+func TestService_Import(t *testing.T) {
+	t.Run("Deve importar o csv com sucesso", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		csv := "zip_code,locality_name,province_name,country_name\n" +
+			"01310-100,São Paulo,São Paulo,Brasil\n" +
+			"5000, Córdoba, Córdoba, Argentina\n"
+
+		expected := []zipcode.ZipCode{
+			{ZipCode: "01310100", LocalityName: "São Paulo", ProvinceName: "São Paulo", CountryName: "Brasil"},
+			{ZipCode: "5000", LocalityName: "Córdoba", ProvinceName: "Córdoba", CountryName: "Argentina"},
+		}
+		mockRepo.On("Import", context.Background(), expected).Return(2, nil)
+
+		result, err := zipcode.NewService(mockRepo).Import(context.Background(), strings.NewReader(csv))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("Deve retornar erro quando o cabeçalho for inválido", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+
+		_, err := zipcode.NewService(mockRepo).Import(context.Background(), strings.NewReader("zip,city\n"))
+
+		assert.EqualError(t, err, zipcode.ERR_INVALID_CSV_HEADER)
+	})
+
+	t.Run("Deve retornar erro quando um zip code estiver fora do formato", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		csv := "zip_code,locality_name,province_name,country_name\n6700,Guarulhos,São Paulo,Brasil\n"
+
+		_, err := zipcode.NewService(mockRepo).Import(context.Background(), strings.NewReader(csv))
+
+		assert.EqualError(t, err, "line 2: "+zipcode.ERR_INVALID_ZIP_CODE)
+	})
+
+	t.Run("Deve retornar erro quando faltar um campo", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		csv := "zip_code,locality_name,province_name,country_name\n01310100,,São Paulo,Brasil\n"
+
+		_, err := zipcode.NewService(mockRepo).Import(context.Background(), strings.NewReader(csv))
+
+		assert.EqualError(t, err, "line 2: every field is mandatory")
+	})
+
+	t.Run("Deve retornar erro quando a linha tiver colunas a menos", func(t *testing.T) {
+		mockRepo := mocks.NewRepository(t)
+		csv := "zip_code,locality_name,province_name,country_name\n01310100,São Paulo\n"
+
+		_, err := zipcode.NewService(mockRepo).Import(context.Background(), strings.NewReader(csv))
+
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "Import", mock.Anything, mock.Anything)
+	})
+}
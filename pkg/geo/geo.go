@@ -0,0 +1,28 @@
+package geo
+
+import "math"
+
+// EarthRadiusKm is the mean radius of the Earth used by Distance.
+const EarthRadiusKm = 6371.0
+
+// Distance returns the great-circle distance in kilometers between two points
+// given in decimal degrees, using the haversine formula.
+func Distance(latitude1, longitude1, latitude2, longitude2 float64) float64 {
+	dLatitude := radians(latitude2 - latitude1)
+	dLongitude := radians(longitude2 - longitude1)
+
+	a := math.Sin(dLatitude/2)*math.Sin(dLatitude/2) +
+		math.Cos(radians(latitude1))*math.Cos(radians(latitude2))*math.Sin(dLongitude/2)*math.Sin(dLongitude/2)
+
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// ValidCoordinates reports whether latitude is within [-90, 90] and longitude
+// within [-180, 180].
+func ValidCoordinates(latitude, longitude float64) bool {
+	return latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,35 @@
+package geo_test
+
+import (
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/geo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistance(t *testing.T) {
+	cases := []struct {
+		name                                         string
+		latitude1, longitude1, latitude2, longitude2 float64
+		expected                                     float64
+	}{
+		{"same_point", -23.5505, -46.6333, -23.5505, -46.6333, 0},
+		{"sao_paulo_rio_de_janeiro", -23.5505, -46.6333, -22.9068, -43.1729, 360.7},
+		{"buenos_aires_montevideo", -34.6037, -58.3816, -34.9011, -56.1645, 205.2},
+		{"antipodes", 0, 0, 0, 180, 20015.1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := geo.Distance(c.latitude1, c.longitude1, c.latitude2, c.longitude2)
+			assert.InDelta(t, c.expected, result, 0.5)
+		})
+	}
+}
+
+func TestValidCoordinates(t *testing.T) {
+	assert.True(t, geo.ValidCoordinates(-23.5505, -46.6333))
+	assert.True(t, geo.ValidCoordinates(90, 180))
+	assert.False(t, geo.ValidCoordinates(91, 0))
+	assert.False(t, geo.ValidCoordinates(0, -181))
+}
@@ -0,0 +1,68 @@
+package mergepatch
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidPatch = errors.New("the patch must be a valid JSON object")
+
+// Merge applies a JSON merge patch (RFC 7396) to the document. Fields present
+// in the patch replace the ones in the document, objects are merged
+// recursively and fields set to null are removed.
+func Merge(document, patch []byte) ([]byte, error) {
+	var patchValue interface{}
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return nil, ErrInvalidPatch
+	}
+	if _, ok := patchValue.(map[string]interface{}); !ok {
+		return nil, ErrInvalidPatch
+	}
+
+	var documentValue interface{}
+	if len(document) > 0 {
+		if err := json.Unmarshal(document, &documentValue); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(merge(documentValue, patchValue))
+}
+
+// Apply merges the patch into the JSON representation of original and decodes
+// the result into target, which must be a pointer.
+func Apply(original interface{}, patch []byte, target interface{}) error {
+	document, err := json.Marshal(original)
+	if err != nil {
+		return err
+	}
+
+	merged, err := Merge(document, patch)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(merged, target)
+}
+
+func merge(document, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	documentObject, ok := document.(map[string]interface{})
+	if !ok {
+		documentObject = map[string]interface{}{}
+	}
+
+	for field, value := range patchObject {
+		if value == nil {
+			delete(documentObject, field)
+			continue
+		}
+		documentObject[field] = merge(documentObject[field], value)
+	}
+
+	return documentObject
+}
@@ -0,0 +1,98 @@
+package mergepatch_test
+
+import (
+	"testing"
+
+	"github.com/Gopher-Rangers/mercadofresco-gopherrangers/pkg/mergepatch"
+	"github.com/stretchr/testify/assert"
+)
+
+type warehouse struct {
+	ID            int    `json:"id"`
+	WarehouseCode string `json:"warehouse_code"`
+	Address       string `json:"address"`
+	LocalityID    int    `json:"locality_id"`
+}
+
+func TestMerge(t *testing.T) {
+	// Examples from the appendix of RFC 7396.
+	cases := []struct {
+		name     string
+		document string
+		patch    string
+		expected string
+	}{
+		{"replace_field", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"add_field", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"remove_field", `{"a":"b"}`, `{"a":null}`, `{}`},
+		{"remove_one_of_many", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"replace_array", `{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"replace_with_array", `{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{"merge_nested", `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{"arrays_are_not_merged", `{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{"nested_null_is_removed", `{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{"empty_document", ``, `{"a":"b"}`, `{"a":"b"}`},
+		{"empty_patch", `{"a":"b"}`, `{}`, `{"a":"b"}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, err := mergepatch.Merge([]byte(c.document), []byte(c.patch))
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, c.expected, string(result))
+		})
+	}
+
+	t.Run("invalid_patch", func(t *testing.T) {
+		_, err := mergepatch.Merge([]byte(`{"a":"b"}`), []byte(`{"a":`))
+
+		assert.Equal(t, mergepatch.ErrInvalidPatch, err)
+	})
+
+	t.Run("patch_not_object", func(t *testing.T) {
+		_, err := mergepatch.Merge([]byte(`{"a":"b"}`), []byte(`["a"]`))
+
+		assert.Equal(t, mergepatch.ErrInvalidPatch, err)
+	})
+
+	t.Run("invalid_document", func(t *testing.T) {
+		_, err := mergepatch.Merge([]byte(`{"a":`), []byte(`{"a":"b"}`))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestApply(t *testing.T) {
+	original := warehouse{ID: 1, WarehouseCode: "j753", Address: "Rua das Margaridas", LocalityID: 1}
+
+	t.Run("apply_ok", func(t *testing.T) {
+		var result warehouse
+		err := mergepatch.Apply(original, []byte(`{"address":"Rua das Rosas"}`), &result)
+
+		assert.NoError(t, err)
+		assert.Equal(t, warehouse{ID: 1, WarehouseCode: "j753", Address: "Rua das Rosas", LocalityID: 1}, result)
+	})
+
+	t.Run("apply_null_resets_field", func(t *testing.T) {
+		var result warehouse
+		err := mergepatch.Apply(original, []byte(`{"locality_id":null}`), &result)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.LocalityID)
+	})
+
+	t.Run("apply_wrong_type", func(t *testing.T) {
+		var result warehouse
+		err := mergepatch.Apply(original, []byte(`{"locality_id":"one"}`), &result)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("apply_invalid_patch", func(t *testing.T) {
+		var result warehouse
+		err := mergepatch.Apply(original, []byte(`not json`), &result)
+
+		assert.Equal(t, mergepatch.ErrInvalidPatch, err)
+	})
+}